@@ -0,0 +1,1247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: dndgame/v1/events.proto
+
+package dndgamev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Types that are assignable to Viewer:
+	//	*WatchGameRequest_PlayerId
+	//	*WatchGameRequest_GameMasterId
+	Viewer isWatchGameRequest_Viewer `protobuf_oneof:"viewer"`
+	// The sequence number of the last event received, to resume from the retained events following it.
+	// The stream starts with the next event if unset.
+	AfterSequence *uint64 `protobuf:"varint,4,opt,name=after_sequence,json=afterSequence,proto3,oneof" json:"after_sequence,omitempty"`
+}
+
+func (x *WatchGameRequest) Reset() {
+	*x = WatchGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameRequest) ProtoMessage() {}
+
+func (x *WatchGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (m *WatchGameRequest) GetViewer() isWatchGameRequest_Viewer {
+	if m != nil {
+		return m.Viewer
+	}
+	return nil
+}
+
+func (x *WatchGameRequest) GetPlayerId() string {
+	if x, ok := x.GetViewer().(*WatchGameRequest_PlayerId); ok {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *WatchGameRequest) GetGameMasterId() string {
+	if x, ok := x.GetViewer().(*WatchGameRequest_GameMasterId); ok {
+		return x.GameMasterId
+	}
+	return ""
+}
+
+func (x *WatchGameRequest) GetAfterSequence() uint64 {
+	if x != nil && x.AfterSequence != nil {
+		return *x.AfterSequence
+	}
+	return 0
+}
+
+type isWatchGameRequest_Viewer interface {
+	isWatchGameRequest_Viewer()
+}
+
+type WatchGameRequest_PlayerId struct {
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3,oneof"`
+}
+
+type WatchGameRequest_GameMasterId struct {
+	GameMasterId string `protobuf:"bytes,3,opt,name=game_master_id,json=gameMasterId,proto3,oneof"`
+}
+
+func (*WatchGameRequest_PlayerId) isWatchGameRequest_Viewer() {}
+
+func (*WatchGameRequest_GameMasterId) isWatchGameRequest_Viewer() {}
+
+type GameEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	GameId    string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Types that are assignable to Event:
+	//	*GameEvent_ActionProposed
+	//	*GameEvent_ActionApproved
+	//	*GameEvent_ActionRejected
+	//	*GameEvent_ActionExecuted
+	//	*GameEvent_XpChanged
+	//	*GameEvent_PlayerJoined
+	//	*GameEvent_PlayerLeft
+	//	*GameEvent_GameStatusChanged
+	//	*GameEvent_NpcAdded
+	Event isGameEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *GameEvent) Reset() {
+	*x = GameEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEvent) ProtoMessage() {}
+
+func (x *GameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEvent.ProtoReflect.Descriptor instead.
+func (*GameEvent) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GameEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (m *GameEvent) GetEvent() isGameEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *GameEvent) GetActionProposed() *ActionProposed {
+	if x, ok := x.GetEvent().(*GameEvent_ActionProposed); ok {
+		return x.ActionProposed
+	}
+	return nil
+}
+
+func (x *GameEvent) GetActionApproved() *ActionApproved {
+	if x, ok := x.GetEvent().(*GameEvent_ActionApproved); ok {
+		return x.ActionApproved
+	}
+	return nil
+}
+
+func (x *GameEvent) GetActionRejected() *ActionRejected {
+	if x, ok := x.GetEvent().(*GameEvent_ActionRejected); ok {
+		return x.ActionRejected
+	}
+	return nil
+}
+
+func (x *GameEvent) GetActionExecuted() *ActionExecuted {
+	if x, ok := x.GetEvent().(*GameEvent_ActionExecuted); ok {
+		return x.ActionExecuted
+	}
+	return nil
+}
+
+func (x *GameEvent) GetXpChanged() *XPChanged {
+	if x, ok := x.GetEvent().(*GameEvent_XpChanged); ok {
+		return x.XpChanged
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerJoined() *PlayerJoined {
+	if x, ok := x.GetEvent().(*GameEvent_PlayerJoined); ok {
+		return x.PlayerJoined
+	}
+	return nil
+}
+
+func (x *GameEvent) GetPlayerLeft() *PlayerLeft {
+	if x, ok := x.GetEvent().(*GameEvent_PlayerLeft); ok {
+		return x.PlayerLeft
+	}
+	return nil
+}
+
+func (x *GameEvent) GetGameStatusChanged() *GameStatusChanged {
+	if x, ok := x.GetEvent().(*GameEvent_GameStatusChanged); ok {
+		return x.GameStatusChanged
+	}
+	return nil
+}
+
+func (x *GameEvent) GetNpcAdded() *NPCAdded {
+	if x, ok := x.GetEvent().(*GameEvent_NpcAdded); ok {
+		return x.NpcAdded
+	}
+	return nil
+}
+
+type isGameEvent_Event interface {
+	isGameEvent_Event()
+}
+
+type GameEvent_ActionProposed struct {
+	ActionProposed *ActionProposed `protobuf:"bytes,10,opt,name=action_proposed,json=actionProposed,proto3,oneof"`
+}
+
+type GameEvent_ActionApproved struct {
+	ActionApproved *ActionApproved `protobuf:"bytes,11,opt,name=action_approved,json=actionApproved,proto3,oneof"`
+}
+
+type GameEvent_ActionRejected struct {
+	ActionRejected *ActionRejected `protobuf:"bytes,12,opt,name=action_rejected,json=actionRejected,proto3,oneof"`
+}
+
+type GameEvent_ActionExecuted struct {
+	ActionExecuted *ActionExecuted `protobuf:"bytes,13,opt,name=action_executed,json=actionExecuted,proto3,oneof"`
+}
+
+type GameEvent_XpChanged struct {
+	XpChanged *XPChanged `protobuf:"bytes,14,opt,name=xp_changed,json=xpChanged,proto3,oneof"`
+}
+
+type GameEvent_PlayerJoined struct {
+	PlayerJoined *PlayerJoined `protobuf:"bytes,15,opt,name=player_joined,json=playerJoined,proto3,oneof"`
+}
+
+type GameEvent_PlayerLeft struct {
+	PlayerLeft *PlayerLeft `protobuf:"bytes,16,opt,name=player_left,json=playerLeft,proto3,oneof"`
+}
+
+type GameEvent_GameStatusChanged struct {
+	GameStatusChanged *GameStatusChanged `protobuf:"bytes,17,opt,name=game_status_changed,json=gameStatusChanged,proto3,oneof"`
+}
+
+type GameEvent_NpcAdded struct {
+	NpcAdded *NPCAdded `protobuf:"bytes,18,opt,name=npc_added,json=npcAdded,proto3,oneof"`
+}
+
+func (*GameEvent_ActionProposed) isGameEvent_Event() {}
+
+func (*GameEvent_ActionApproved) isGameEvent_Event() {}
+
+func (*GameEvent_ActionRejected) isGameEvent_Event() {}
+
+func (*GameEvent_ActionExecuted) isGameEvent_Event() {}
+
+func (*GameEvent_XpChanged) isGameEvent_Event() {}
+
+func (*GameEvent_PlayerJoined) isGameEvent_Event() {}
+
+func (*GameEvent_PlayerLeft) isGameEvent_Event() {}
+
+func (*GameEvent_GameStatusChanged) isGameEvent_Event() {}
+
+func (*GameEvent_NpcAdded) isGameEvent_Event() {}
+
+type ActionProposed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	InstanceId  string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	ActionId    string `protobuf:"bytes,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	XpCost      int32  `protobuf:"varint,4,opt,name=xp_cost,json=xpCost,proto3" json:"xp_cost,omitempty"`
+}
+
+func (x *ActionProposed) Reset() {
+	*x = ActionProposed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionProposed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionProposed) ProtoMessage() {}
+
+func (x *ActionProposed) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionProposed.ProtoReflect.Descriptor instead.
+func (*ActionProposed) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActionProposed) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActionProposed) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ActionProposed) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *ActionProposed) GetXpCost() int32 {
+	if x != nil {
+		return x.XpCost
+	}
+	return 0
+}
+
+type ActionApproved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameMasterId string `protobuf:"bytes,1,opt,name=game_master_id,json=gameMasterId,proto3" json:"game_master_id,omitempty"`
+	CharacterId  string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	InstanceId   string `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	XpCost       int32  `protobuf:"varint,4,opt,name=xp_cost,json=xpCost,proto3" json:"xp_cost,omitempty"`
+	Modified     bool   `protobuf:"varint,5,opt,name=modified,proto3" json:"modified,omitempty"`
+}
+
+func (x *ActionApproved) Reset() {
+	*x = ActionApproved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionApproved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionApproved) ProtoMessage() {}
+
+func (x *ActionApproved) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionApproved.ProtoReflect.Descriptor instead.
+func (*ActionApproved) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActionApproved) GetGameMasterId() string {
+	if x != nil {
+		return x.GameMasterId
+	}
+	return ""
+}
+
+func (x *ActionApproved) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActionApproved) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ActionApproved) GetXpCost() int32 {
+	if x != nil {
+		return x.XpCost
+	}
+	return 0
+}
+
+func (x *ActionApproved) GetModified() bool {
+	if x != nil {
+		return x.Modified
+	}
+	return false
+}
+
+type ActionRejected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	InstanceId  string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *ActionRejected) Reset() {
+	*x = ActionRejected{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionRejected) ProtoMessage() {}
+
+func (x *ActionRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionRejected.ProtoReflect.Descriptor instead.
+func (*ActionRejected) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActionRejected) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActionRejected) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+type ActionExecuted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	InstanceId  string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Outcome     string `protobuf:"bytes,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	XpChange    *int32 `protobuf:"varint,4,opt,name=xp_change,json=xpChange,proto3,oneof" json:"xp_change,omitempty"` // unset for the players not owning the character
+}
+
+func (x *ActionExecuted) Reset() {
+	*x = ActionExecuted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionExecuted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionExecuted) ProtoMessage() {}
+
+func (x *ActionExecuted) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionExecuted.ProtoReflect.Descriptor instead.
+func (*ActionExecuted) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActionExecuted) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActionExecuted) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ActionExecuted) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ActionExecuted) GetXpChange() int32 {
+	if x != nil && x.XpChange != nil {
+		return *x.XpChange
+	}
+	return 0
+}
+
+type XPChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ActorId     string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount      int32  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance     int32  `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Reason      string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *XPChanged) Reset() {
+	*x = XPChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XPChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPChanged) ProtoMessage() {}
+
+func (x *XPChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPChanged.ProtoReflect.Descriptor instead.
+func (*XPChanged) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *XPChanged) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *XPChanged) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *XPChanged) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *XPChanged) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *XPChanged) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *XPChanged) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PlayerJoined struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *PlayerJoined) Reset() {
+	*x = PlayerJoined{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerJoined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerJoined) ProtoMessage() {}
+
+func (x *PlayerJoined) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerJoined.ProtoReflect.Descriptor instead.
+func (*PlayerJoined) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlayerJoined) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type PlayerLeft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *PlayerLeft) Reset() {
+	*x = PlayerLeft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerLeft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLeft) ProtoMessage() {}
+
+func (x *PlayerLeft) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLeft.ProtoReflect.Descriptor instead.
+func (*PlayerLeft) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerLeft) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GameStatusChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GameStatusChanged) Reset() {
+	*x = GameStatusChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameStatusChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStatusChanged) ProtoMessage() {}
+
+func (x *GameStatusChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStatusChanged.ProtoReflect.Descriptor instead.
+func (*GameStatusChanged) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GameStatusChanged) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GameStatusChanged) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type NPCAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NPCAdded) Reset() {
+	*x = NPCAdded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NPCAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NPCAdded) ProtoMessage() {}
+
+func (x *NPCAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NPCAdded.ProtoReflect.Descriptor instead.
+func (*NPCAdded) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NPCAdded) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *NPCAdded) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_dndgame_v1_events_proto protoreflect.FileDescriptor
+
+var file_dndgame_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x6e, 0x64, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x67,
+	0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0xd9, 0x05, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x45, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x45, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x0a, 0x78, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x58,
+	0x50, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x78, 0x70, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64,
+	0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6e,
+	0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c,
+	0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66,
+	0x74, 0x12, 0x4f, 0x0a, 0x13, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x11, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x6e, 0x70, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x50, 0x43, 0x41, 0x64, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x6e,
+	0x70, 0x63, 0x41, 0x64, 0x64, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x78, 0x70, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x78, 0x70, 0x43, 0x6f, 0x73, 0x74, 0x22, 0xaf, 0x01,
+	0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x78, 0x70,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x78, 0x70, 0x43,
+	0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22,
+	0x54, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x78, 0x70, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x78, 0x70, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x78, 0x70, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x09, 0x58, 0x50, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x2b, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x29, 0x0a,
+	0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x11, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74,
+	0x6f, 0x22, 0x41, 0x0a, 0x08, 0x4e, 0x50, 0x43, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x32, 0x52, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x65, 0x72, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e,
+	0x2f, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dndgame_v1_events_proto_rawDescOnce sync.Once
+	file_dndgame_v1_events_proto_rawDescData = file_dndgame_v1_events_proto_rawDesc
+)
+
+func file_dndgame_v1_events_proto_rawDescGZIP() []byte {
+	file_dndgame_v1_events_proto_rawDescOnce.Do(func() {
+		file_dndgame_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_dndgame_v1_events_proto_rawDescData)
+	})
+	return file_dndgame_v1_events_proto_rawDescData
+}
+
+var file_dndgame_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_dndgame_v1_events_proto_goTypes = []any{
+	(*WatchGameRequest)(nil),      // 0: dndgame.v1.WatchGameRequest
+	(*GameEvent)(nil),             // 1: dndgame.v1.GameEvent
+	(*ActionProposed)(nil),        // 2: dndgame.v1.ActionProposed
+	(*ActionApproved)(nil),        // 3: dndgame.v1.ActionApproved
+	(*ActionRejected)(nil),        // 4: dndgame.v1.ActionRejected
+	(*ActionExecuted)(nil),        // 5: dndgame.v1.ActionExecuted
+	(*XPChanged)(nil),             // 6: dndgame.v1.XPChanged
+	(*PlayerJoined)(nil),          // 7: dndgame.v1.PlayerJoined
+	(*PlayerLeft)(nil),            // 8: dndgame.v1.PlayerLeft
+	(*GameStatusChanged)(nil),     // 9: dndgame.v1.GameStatusChanged
+	(*NPCAdded)(nil),              // 10: dndgame.v1.NPCAdded
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_dndgame_v1_events_proto_depIdxs = []int32{
+	11, // 0: dndgame.v1.GameEvent.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 1: dndgame.v1.GameEvent.action_proposed:type_name -> dndgame.v1.ActionProposed
+	3,  // 2: dndgame.v1.GameEvent.action_approved:type_name -> dndgame.v1.ActionApproved
+	4,  // 3: dndgame.v1.GameEvent.action_rejected:type_name -> dndgame.v1.ActionRejected
+	5,  // 4: dndgame.v1.GameEvent.action_executed:type_name -> dndgame.v1.ActionExecuted
+	6,  // 5: dndgame.v1.GameEvent.xp_changed:type_name -> dndgame.v1.XPChanged
+	7,  // 6: dndgame.v1.GameEvent.player_joined:type_name -> dndgame.v1.PlayerJoined
+	8,  // 7: dndgame.v1.GameEvent.player_left:type_name -> dndgame.v1.PlayerLeft
+	9,  // 8: dndgame.v1.GameEvent.game_status_changed:type_name -> dndgame.v1.GameStatusChanged
+	10, // 9: dndgame.v1.GameEvent.npc_added:type_name -> dndgame.v1.NPCAdded
+	0,  // 10: dndgame.v1.EventService.WatchGame:input_type -> dndgame.v1.WatchGameRequest
+	1,  // 11: dndgame.v1.EventService.WatchGame:output_type -> dndgame.v1.GameEvent
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_dndgame_v1_events_proto_init() }
+func file_dndgame_v1_events_proto_init() {
+	if File_dndgame_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dndgame_v1_events_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GameEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ActionProposed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ActionApproved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ActionRejected); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ActionExecuted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*XPChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*PlayerJoined); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*PlayerLeft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GameStatusChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_events_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*NPCAdded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_dndgame_v1_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*WatchGameRequest_PlayerId)(nil),
+		(*WatchGameRequest_GameMasterId)(nil),
+	}
+	file_dndgame_v1_events_proto_msgTypes[1].OneofWrappers = []any{
+		(*GameEvent_ActionProposed)(nil),
+		(*GameEvent_ActionApproved)(nil),
+		(*GameEvent_ActionRejected)(nil),
+		(*GameEvent_ActionExecuted)(nil),
+		(*GameEvent_XpChanged)(nil),
+		(*GameEvent_PlayerJoined)(nil),
+		(*GameEvent_PlayerLeft)(nil),
+		(*GameEvent_GameStatusChanged)(nil),
+		(*GameEvent_NpcAdded)(nil),
+	}
+	file_dndgame_v1_events_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dndgame_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dndgame_v1_events_proto_goTypes,
+		DependencyIndexes: file_dndgame_v1_events_proto_depIdxs,
+		MessageInfos:      file_dndgame_v1_events_proto_msgTypes,
+	}.Build()
+	File_dndgame_v1_events_proto = out.File
+	file_dndgame_v1_events_proto_rawDesc = nil
+	file_dndgame_v1_events_proto_goTypes = nil
+	file_dndgame_v1_events_proto_depIdxs = nil
+}
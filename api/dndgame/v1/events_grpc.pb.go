@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: dndgame/v1/events.proto
+
+package dndgamev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	EventService_WatchGame_FullMethodName = "/dndgame.v1.EventService/WatchGame"
+)
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventServiceClient interface {
+	// WatchGame streams the events of a game to its game master, who sees every event, or to one of its players,
+	// who sees the events of the table and the proposals, decisions and XP changes of their own characters only.
+	// The headers of the stream arrive once no later event may be missed. The stream ends with UNAVAILABLE when
+	// the watcher lags behind or the server shuts down, and OUT_OF_RANGE when resuming from events that are no
+	// longer retained.
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (EventService_WatchGameClient, error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (EventService_WatchGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_WatchGame_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventServiceWatchGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventService_WatchGameClient interface {
+	Recv() (*GameEvent, error)
+	grpc.ClientStream
+}
+
+type eventServiceWatchGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventServiceWatchGameClient) Recv() (*GameEvent, error) {
+	m := new(GameEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility
+type EventServiceServer interface {
+	// WatchGame streams the events of a game to its game master, who sees every event, or to one of its players,
+	// who sees the events of the table and the proposals, decisions and XP changes of their own characters only.
+	// The headers of the stream arrive once no later event may be missed. The stream ends with UNAVAILABLE when
+	// the watcher lags behind or the server shuts down, and OUT_OF_RANGE when resuming from events that are no
+	// longer retained.
+	WatchGame(*WatchGameRequest, EventService_WatchGameServer) error
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEventServiceServer struct {
+}
+
+func (UnimplementedEventServiceServer) WatchGame(*WatchGameRequest, EventService_WatchGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchGame(m, &eventServiceWatchGameServer{stream})
+}
+
+type EventService_WatchGameServer interface {
+	Send(*GameEvent) error
+	grpc.ServerStream
+}
+
+type eventServiceWatchGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventServiceWatchGameServer) Send(m *GameEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dndgame.v1.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _EventService_WatchGame_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dndgame/v1/events.proto",
+}
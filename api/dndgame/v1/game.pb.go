@@ -0,0 +1,1146 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: dndgame/v1/game.proto
+
+package dndgamev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // set with end_time to schedule the game
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *CreateGameRequest) Reset() {
+	*x = CreateGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type StartGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type EndGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *EndGameRequest) Reset() {
+	*x = EndGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndGameRequest) ProtoMessage() {}
+
+func (x *EndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndGameRequest.ProtoReflect.Descriptor instead.
+func (*EndGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EndGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type SetGameStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string     `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Status GameStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dndgame.v1.GameStatus" json:"status,omitempty"`
+}
+
+func (x *SetGameStatusRequest) Reset() {
+	*x = SetGameStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGameStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameStatusRequest) ProtoMessage() {}
+
+func (x *SetGameStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetGameStatusRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetGameStatusRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetGameStatusRequest) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+type AddPlayerToGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *AddPlayerToGameRequest) Reset() {
+	*x = AddPlayerToGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPlayerToGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlayerToGameRequest) ProtoMessage() {}
+
+func (x *AddPlayerToGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlayerToGameRequest.ProtoReflect.Descriptor instead.
+func (*AddPlayerToGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddPlayerToGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AddPlayerToGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type RemovePlayerFromGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+}
+
+func (x *RemovePlayerFromGameRequest) Reset() {
+	*x = RemovePlayerFromGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePlayerFromGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlayerFromGameRequest) ProtoMessage() {}
+
+func (x *RemovePlayerFromGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlayerFromGameRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlayerFromGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemovePlayerFromGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RemovePlayerFromGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type SetGameMasterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId     string      `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameMaster *GameMaster `protobuf:"bytes,2,opt,name=game_master,json=gameMaster,proto3" json:"game_master,omitempty"`
+}
+
+func (x *SetGameMasterRequest) Reset() {
+	*x = SetGameMasterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGameMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameMasterRequest) ProtoMessage() {}
+
+func (x *SetGameMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameMasterRequest.ProtoReflect.Descriptor instead.
+func (*SetGameMasterRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetGameMasterRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetGameMasterRequest) GetGameMaster() *GameMaster {
+	if x != nil {
+		return x.GameMaster
+	}
+	return nil
+}
+
+type SetRulebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string    `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Rulebook *Rulebook `protobuf:"bytes,2,opt,name=rulebook,proto3" json:"rulebook,omitempty"`
+}
+
+func (x *SetRulebookRequest) Reset() {
+	*x = SetRulebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRulebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRulebookRequest) ProtoMessage() {}
+
+func (x *SetRulebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRulebookRequest.ProtoReflect.Descriptor instead.
+func (*SetRulebookRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetRulebookRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetRulebookRequest) GetRulebook() *Rulebook {
+	if x != nil {
+		return x.Rulebook
+	}
+	return nil
+}
+
+type SetAllowLateCharactersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Allow  bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
+}
+
+func (x *SetAllowLateCharactersRequest) Reset() {
+	*x = SetAllowLateCharactersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAllowLateCharactersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowLateCharactersRequest) ProtoMessage() {}
+
+func (x *SetAllowLateCharactersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowLateCharactersRequest.ProtoReflect.Descriptor instead.
+func (*SetAllowLateCharactersRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetAllowLateCharactersRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetAllowLateCharactersRequest) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+type SetPayoutRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId      string       `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PayoutRules *PayoutRules `protobuf:"bytes,2,opt,name=payout_rules,json=payoutRules,proto3" json:"payout_rules,omitempty"`
+}
+
+func (x *SetPayoutRulesRequest) Reset() {
+	*x = SetPayoutRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPayoutRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPayoutRulesRequest) ProtoMessage() {}
+
+func (x *SetPayoutRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPayoutRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetPayoutRulesRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetPayoutRulesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetPayoutRulesRequest) GetPayoutRules() *PayoutRules {
+	if x != nil {
+		return x.PayoutRules
+	}
+	return nil
+}
+
+type SetAdventureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string     `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Adventure *Adventure `protobuf:"bytes,2,opt,name=adventure,proto3" json:"adventure,omitempty"`
+}
+
+func (x *SetAdventureRequest) Reset() {
+	*x = SetAdventureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAdventureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAdventureRequest) ProtoMessage() {}
+
+func (x *SetAdventureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAdventureRequest.ProtoReflect.Descriptor instead.
+func (*SetAdventureRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetAdventureRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetAdventureRequest) GetAdventure() *Adventure {
+	if x != nil {
+		return x.Adventure
+	}
+	return nil
+}
+
+type AddMissionToGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId  string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Mission *Mission `protobuf:"bytes,2,opt,name=mission,proto3" json:"mission,omitempty"`
+}
+
+func (x *AddMissionToGameRequest) Reset() {
+	*x = AddMissionToGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_game_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMissionToGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMissionToGameRequest) ProtoMessage() {}
+
+func (x *AddMissionToGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_game_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMissionToGameRequest.ProtoReflect.Descriptor instead.
+func (*AddMissionToGameRequest) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddMissionToGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AddMissionToGameRequest) GetMission() *Mission {
+	if x != nil {
+		return x.Mission
+	}
+	return nil
+}
+
+var File_dndgame_v1_game_proto protoreflect.FileDescriptor
+
+var file_dndgame_v1_game_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x1a, 0x16, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x29,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x10, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0e, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x5f, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54,
+	0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x53, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x22, 0x5f, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x22, 0x4e, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x22, 0x6c, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x63, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x33, 0x0a, 0x09, 0x61, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x61, 0x64, 0x76, 0x65,
+	0x6e, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xd1, 0x07, 0x0a, 0x0b, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x64, 0x6e,
+	0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64,
+	0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x47,
+	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x6f, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x6f, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x27, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x64, 0x6e,
+	0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x3f, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1e,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x55, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4c, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x6e, 0x64, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64,
+	0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x41,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41,
+	0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x49, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x64, 0x6e, 0x64,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x37, 0x5a, 0x35,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x6c, 0x69, 0x6e, 0x2f, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x6e, 0x64, 0x67,
+	0x61, 0x6d, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dndgame_v1_game_proto_rawDescOnce sync.Once
+	file_dndgame_v1_game_proto_rawDescData = file_dndgame_v1_game_proto_rawDesc
+)
+
+func file_dndgame_v1_game_proto_rawDescGZIP() []byte {
+	file_dndgame_v1_game_proto_rawDescOnce.Do(func() {
+		file_dndgame_v1_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_dndgame_v1_game_proto_rawDescData)
+	})
+	return file_dndgame_v1_game_proto_rawDescData
+}
+
+var file_dndgame_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_dndgame_v1_game_proto_goTypes = []any{
+	(*CreateGameRequest)(nil),             // 0: dndgame.v1.CreateGameRequest
+	(*GetGameRequest)(nil),                // 1: dndgame.v1.GetGameRequest
+	(*StartGameRequest)(nil),              // 2: dndgame.v1.StartGameRequest
+	(*EndGameRequest)(nil),                // 3: dndgame.v1.EndGameRequest
+	(*SetGameStatusRequest)(nil),          // 4: dndgame.v1.SetGameStatusRequest
+	(*AddPlayerToGameRequest)(nil),        // 5: dndgame.v1.AddPlayerToGameRequest
+	(*RemovePlayerFromGameRequest)(nil),   // 6: dndgame.v1.RemovePlayerFromGameRequest
+	(*SetGameMasterRequest)(nil),          // 7: dndgame.v1.SetGameMasterRequest
+	(*SetRulebookRequest)(nil),            // 8: dndgame.v1.SetRulebookRequest
+	(*SetAllowLateCharactersRequest)(nil), // 9: dndgame.v1.SetAllowLateCharactersRequest
+	(*SetPayoutRulesRequest)(nil),         // 10: dndgame.v1.SetPayoutRulesRequest
+	(*SetAdventureRequest)(nil),           // 11: dndgame.v1.SetAdventureRequest
+	(*AddMissionToGameRequest)(nil),       // 12: dndgame.v1.AddMissionToGameRequest
+	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
+	(GameStatus)(0),                       // 14: dndgame.v1.GameStatus
+	(*GameMaster)(nil),                    // 15: dndgame.v1.GameMaster
+	(*Rulebook)(nil),                      // 16: dndgame.v1.Rulebook
+	(*PayoutRules)(nil),                   // 17: dndgame.v1.PayoutRules
+	(*Adventure)(nil),                     // 18: dndgame.v1.Adventure
+	(*Mission)(nil),                       // 19: dndgame.v1.Mission
+	(*emptypb.Empty)(nil),                 // 20: google.protobuf.Empty
+	(*Game)(nil),                          // 21: dndgame.v1.Game
+}
+var file_dndgame_v1_game_proto_depIdxs = []int32{
+	13, // 0: dndgame.v1.CreateGameRequest.start_time:type_name -> google.protobuf.Timestamp
+	13, // 1: dndgame.v1.CreateGameRequest.end_time:type_name -> google.protobuf.Timestamp
+	14, // 2: dndgame.v1.SetGameStatusRequest.status:type_name -> dndgame.v1.GameStatus
+	15, // 3: dndgame.v1.SetGameMasterRequest.game_master:type_name -> dndgame.v1.GameMaster
+	16, // 4: dndgame.v1.SetRulebookRequest.rulebook:type_name -> dndgame.v1.Rulebook
+	17, // 5: dndgame.v1.SetPayoutRulesRequest.payout_rules:type_name -> dndgame.v1.PayoutRules
+	18, // 6: dndgame.v1.SetAdventureRequest.adventure:type_name -> dndgame.v1.Adventure
+	19, // 7: dndgame.v1.AddMissionToGameRequest.mission:type_name -> dndgame.v1.Mission
+	0,  // 8: dndgame.v1.GameService.CreateGame:input_type -> dndgame.v1.CreateGameRequest
+	1,  // 9: dndgame.v1.GameService.GetGame:input_type -> dndgame.v1.GetGameRequest
+	20, // 10: dndgame.v1.GameService.UpdateSchedules:input_type -> google.protobuf.Empty
+	2,  // 11: dndgame.v1.GameService.StartGame:input_type -> dndgame.v1.StartGameRequest
+	3,  // 12: dndgame.v1.GameService.EndGame:input_type -> dndgame.v1.EndGameRequest
+	4,  // 13: dndgame.v1.GameService.SetGameStatus:input_type -> dndgame.v1.SetGameStatusRequest
+	5,  // 14: dndgame.v1.GameService.AddPlayerToGame:input_type -> dndgame.v1.AddPlayerToGameRequest
+	6,  // 15: dndgame.v1.GameService.RemovePlayerFromGame:input_type -> dndgame.v1.RemovePlayerFromGameRequest
+	7,  // 16: dndgame.v1.GameService.SetGameMaster:input_type -> dndgame.v1.SetGameMasterRequest
+	8,  // 17: dndgame.v1.GameService.SetRulebook:input_type -> dndgame.v1.SetRulebookRequest
+	9,  // 18: dndgame.v1.GameService.SetAllowLateCharacters:input_type -> dndgame.v1.SetAllowLateCharactersRequest
+	10, // 19: dndgame.v1.GameService.SetPayoutRules:input_type -> dndgame.v1.SetPayoutRulesRequest
+	11, // 20: dndgame.v1.GameService.SetAdventure:input_type -> dndgame.v1.SetAdventureRequest
+	12, // 21: dndgame.v1.GameService.AddMissionToGame:input_type -> dndgame.v1.AddMissionToGameRequest
+	21, // 22: dndgame.v1.GameService.CreateGame:output_type -> dndgame.v1.Game
+	21, // 23: dndgame.v1.GameService.GetGame:output_type -> dndgame.v1.Game
+	20, // 24: dndgame.v1.GameService.UpdateSchedules:output_type -> google.protobuf.Empty
+	21, // 25: dndgame.v1.GameService.StartGame:output_type -> dndgame.v1.Game
+	21, // 26: dndgame.v1.GameService.EndGame:output_type -> dndgame.v1.Game
+	21, // 27: dndgame.v1.GameService.SetGameStatus:output_type -> dndgame.v1.Game
+	21, // 28: dndgame.v1.GameService.AddPlayerToGame:output_type -> dndgame.v1.Game
+	21, // 29: dndgame.v1.GameService.RemovePlayerFromGame:output_type -> dndgame.v1.Game
+	21, // 30: dndgame.v1.GameService.SetGameMaster:output_type -> dndgame.v1.Game
+	21, // 31: dndgame.v1.GameService.SetRulebook:output_type -> dndgame.v1.Game
+	21, // 32: dndgame.v1.GameService.SetAllowLateCharacters:output_type -> dndgame.v1.Game
+	21, // 33: dndgame.v1.GameService.SetPayoutRules:output_type -> dndgame.v1.Game
+	21, // 34: dndgame.v1.GameService.SetAdventure:output_type -> dndgame.v1.Game
+	21, // 35: dndgame.v1.GameService.AddMissionToGame:output_type -> dndgame.v1.Game
+	22, // [22:36] is the sub-list for method output_type
+	8,  // [8:22] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_dndgame_v1_game_proto_init() }
+func file_dndgame_v1_game_proto_init() {
+	if File_dndgame_v1_game_proto != nil {
+		return
+	}
+	file_dndgame_v1_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_dndgame_v1_game_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*StartGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*EndGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SetGameStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AddPlayerToGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*RemovePlayerFromGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SetGameMasterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRulebookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAllowLateCharactersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SetPayoutRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAdventureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_game_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*AddMissionToGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dndgame_v1_game_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dndgame_v1_game_proto_goTypes,
+		DependencyIndexes: file_dndgame_v1_game_proto_depIdxs,
+		MessageInfos:      file_dndgame_v1_game_proto_msgTypes,
+	}.Build()
+	File_dndgame_v1_game_proto = out.File
+	file_dndgame_v1_game_proto_rawDesc = nil
+	file_dndgame_v1_game_proto_goTypes = nil
+	file_dndgame_v1_game_proto_depIdxs = nil
+}
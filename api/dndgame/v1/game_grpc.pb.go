@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: dndgame/v1/game.proto
+
+package dndgamev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GameService_CreateGame_FullMethodName             = "/dndgame.v1.GameService/CreateGame"
+	GameService_GetGame_FullMethodName                = "/dndgame.v1.GameService/GetGame"
+	GameService_UpdateSchedules_FullMethodName        = "/dndgame.v1.GameService/UpdateSchedules"
+	GameService_StartGame_FullMethodName              = "/dndgame.v1.GameService/StartGame"
+	GameService_EndGame_FullMethodName                = "/dndgame.v1.GameService/EndGame"
+	GameService_SetGameStatus_FullMethodName          = "/dndgame.v1.GameService/SetGameStatus"
+	GameService_AddPlayerToGame_FullMethodName        = "/dndgame.v1.GameService/AddPlayerToGame"
+	GameService_RemovePlayerFromGame_FullMethodName   = "/dndgame.v1.GameService/RemovePlayerFromGame"
+	GameService_SetGameMaster_FullMethodName          = "/dndgame.v1.GameService/SetGameMaster"
+	GameService_SetRulebook_FullMethodName            = "/dndgame.v1.GameService/SetRulebook"
+	GameService_SetAllowLateCharacters_FullMethodName = "/dndgame.v1.GameService/SetAllowLateCharacters"
+	GameService_SetPayoutRules_FullMethodName         = "/dndgame.v1.GameService/SetPayoutRules"
+	GameService_SetAdventure_FullMethodName           = "/dndgame.v1.GameService/SetAdventure"
+	GameService_AddMissionToGame_FullMethodName       = "/dndgame.v1.GameService/AddMissionToGame"
+)
+
+// GameServiceClient is the client API for GameService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameServiceClient interface {
+	// CreateGame creates a game in the Draft status, or a scheduled one if its start and end times are given.
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*Game, error)
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error)
+	// UpdateSchedules brings the status of the scheduled games up to date with the current time.
+	UpdateSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*Game, error)
+	EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*Game, error)
+	SetGameStatus(ctx context.Context, in *SetGameStatusRequest, opts ...grpc.CallOption) (*Game, error)
+	AddPlayerToGame(ctx context.Context, in *AddPlayerToGameRequest, opts ...grpc.CallOption) (*Game, error)
+	RemovePlayerFromGame(ctx context.Context, in *RemovePlayerFromGameRequest, opts ...grpc.CallOption) (*Game, error)
+	SetGameMaster(ctx context.Context, in *SetGameMasterRequest, opts ...grpc.CallOption) (*Game, error)
+	SetRulebook(ctx context.Context, in *SetRulebookRequest, opts ...grpc.CallOption) (*Game, error)
+	SetAllowLateCharacters(ctx context.Context, in *SetAllowLateCharactersRequest, opts ...grpc.CallOption) (*Game, error)
+	SetPayoutRules(ctx context.Context, in *SetPayoutRulesRequest, opts ...grpc.CallOption) (*Game, error)
+	SetAdventure(ctx context.Context, in *SetAdventureRequest, opts ...grpc.CallOption) (*Game, error)
+	AddMissionToGame(ctx context.Context, in *AddMissionToGameRequest, opts ...grpc.CallOption) (*Game, error)
+}
+
+type gameServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameServiceClient(cc grpc.ClientConnInterface) GameServiceClient {
+	return &gameServiceClient{cc}
+}
+
+func (c *gameServiceClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_CreateGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_GetGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) UpdateSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GameService_UpdateSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_StartGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) EndGame(ctx context.Context, in *EndGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_EndGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetGameStatus(ctx context.Context, in *SetGameStatusRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_SetGameStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AddPlayerToGame(ctx context.Context, in *AddPlayerToGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_AddPlayerToGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RemovePlayerFromGame(ctx context.Context, in *RemovePlayerFromGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_RemovePlayerFromGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetGameMaster(ctx context.Context, in *SetGameMasterRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_SetGameMaster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetRulebook(ctx context.Context, in *SetRulebookRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_SetRulebook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetAllowLateCharacters(ctx context.Context, in *SetAllowLateCharactersRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_SetAllowLateCharacters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetPayoutRules(ctx context.Context, in *SetPayoutRulesRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_SetPayoutRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetAdventure(ctx context.Context, in *SetAdventureRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_SetAdventure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AddMissionToGame(ctx context.Context, in *AddMissionToGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameService_AddMissionToGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameServiceServer is the server API for GameService service.
+// All implementations must embed UnimplementedGameServiceServer
+// for forward compatibility
+type GameServiceServer interface {
+	// CreateGame creates a game in the Draft status, or a scheduled one if its start and end times are given.
+	CreateGame(context.Context, *CreateGameRequest) (*Game, error)
+	GetGame(context.Context, *GetGameRequest) (*Game, error)
+	// UpdateSchedules brings the status of the scheduled games up to date with the current time.
+	UpdateSchedules(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	StartGame(context.Context, *StartGameRequest) (*Game, error)
+	EndGame(context.Context, *EndGameRequest) (*Game, error)
+	SetGameStatus(context.Context, *SetGameStatusRequest) (*Game, error)
+	AddPlayerToGame(context.Context, *AddPlayerToGameRequest) (*Game, error)
+	RemovePlayerFromGame(context.Context, *RemovePlayerFromGameRequest) (*Game, error)
+	SetGameMaster(context.Context, *SetGameMasterRequest) (*Game, error)
+	SetRulebook(context.Context, *SetRulebookRequest) (*Game, error)
+	SetAllowLateCharacters(context.Context, *SetAllowLateCharactersRequest) (*Game, error)
+	SetPayoutRules(context.Context, *SetPayoutRulesRequest) (*Game, error)
+	SetAdventure(context.Context, *SetAdventureRequest) (*Game, error)
+	AddMissionToGame(context.Context, *AddMissionToGameRequest) (*Game, error)
+	mustEmbedUnimplementedGameServiceServer()
+}
+
+// UnimplementedGameServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGameServiceServer struct {
+}
+
+func (UnimplementedGameServiceServer) CreateGame(context.Context, *CreateGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedGameServiceServer) GetGame(context.Context, *GetGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedGameServiceServer) UpdateSchedules(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedules not implemented")
+}
+func (UnimplementedGameServiceServer) StartGame(context.Context, *StartGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedGameServiceServer) EndGame(context.Context, *EndGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
+}
+func (UnimplementedGameServiceServer) SetGameStatus(context.Context, *SetGameStatusRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameStatus not implemented")
+}
+func (UnimplementedGameServiceServer) AddPlayerToGame(context.Context, *AddPlayerToGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlayerToGame not implemented")
+}
+func (UnimplementedGameServiceServer) RemovePlayerFromGame(context.Context, *RemovePlayerFromGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlayerFromGame not implemented")
+}
+func (UnimplementedGameServiceServer) SetGameMaster(context.Context, *SetGameMasterRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGameMaster not implemented")
+}
+func (UnimplementedGameServiceServer) SetRulebook(context.Context, *SetRulebookRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRulebook not implemented")
+}
+func (UnimplementedGameServiceServer) SetAllowLateCharacters(context.Context, *SetAllowLateCharactersRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowLateCharacters not implemented")
+}
+func (UnimplementedGameServiceServer) SetPayoutRules(context.Context, *SetPayoutRulesRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPayoutRules not implemented")
+}
+func (UnimplementedGameServiceServer) SetAdventure(context.Context, *SetAdventureRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdventure not implemented")
+}
+func (UnimplementedGameServiceServer) AddMissionToGame(context.Context, *AddMissionToGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMissionToGame not implemented")
+}
+func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
+
+// UnsafeGameServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameServiceServer will
+// result in compilation errors.
+type UnsafeGameServiceServer interface {
+	mustEmbedUnimplementedGameServiceServer()
+}
+
+func RegisterGameServiceServer(s grpc.ServiceRegistrar, srv GameServiceServer) {
+	s.RegisterService(&GameService_ServiceDesc, srv)
+}
+
+func _GameService_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_UpdateSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).UpdateSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_UpdateSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).UpdateSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).EndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_EndGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).EndGame(ctx, req.(*EndGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetGameStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGameStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetGameStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetGameStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetGameStatus(ctx, req.(*SetGameStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AddPlayerToGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlayerToGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AddPlayerToGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AddPlayerToGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AddPlayerToGame(ctx, req.(*AddPlayerToGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RemovePlayerFromGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlayerFromGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RemovePlayerFromGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RemovePlayerFromGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RemovePlayerFromGame(ctx, req.(*RemovePlayerFromGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetGameMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGameMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetGameMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetGameMaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetGameMaster(ctx, req.(*SetGameMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetRulebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRulebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetRulebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetRulebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetRulebook(ctx, req.(*SetRulebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetAllowLateCharacters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowLateCharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetAllowLateCharacters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetAllowLateCharacters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetAllowLateCharacters(ctx, req.(*SetAllowLateCharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetPayoutRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPayoutRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetPayoutRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetPayoutRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetPayoutRules(ctx, req.(*SetPayoutRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetAdventure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdventureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetAdventure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetAdventure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetAdventure(ctx, req.(*SetAdventureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AddMissionToGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMissionToGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AddMissionToGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AddMissionToGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AddMissionToGame(ctx, req.(*AddMissionToGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dndgame.v1.GameService",
+	HandlerType: (*GameServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGame",
+			Handler:    _GameService_CreateGame_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _GameService_GetGame_Handler,
+		},
+		{
+			MethodName: "UpdateSchedules",
+			Handler:    _GameService_UpdateSchedules_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _GameService_StartGame_Handler,
+		},
+		{
+			MethodName: "EndGame",
+			Handler:    _GameService_EndGame_Handler,
+		},
+		{
+			MethodName: "SetGameStatus",
+			Handler:    _GameService_SetGameStatus_Handler,
+		},
+		{
+			MethodName: "AddPlayerToGame",
+			Handler:    _GameService_AddPlayerToGame_Handler,
+		},
+		{
+			MethodName: "RemovePlayerFromGame",
+			Handler:    _GameService_RemovePlayerFromGame_Handler,
+		},
+		{
+			MethodName: "SetGameMaster",
+			Handler:    _GameService_SetGameMaster_Handler,
+		},
+		{
+			MethodName: "SetRulebook",
+			Handler:    _GameService_SetRulebook_Handler,
+		},
+		{
+			MethodName: "SetAllowLateCharacters",
+			Handler:    _GameService_SetAllowLateCharacters_Handler,
+		},
+		{
+			MethodName: "SetPayoutRules",
+			Handler:    _GameService_SetPayoutRules_Handler,
+		},
+		{
+			MethodName: "SetAdventure",
+			Handler:    _GameService_SetAdventure_Handler,
+		},
+		{
+			MethodName: "AddMissionToGame",
+			Handler:    _GameService_AddMissionToGame_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dndgame/v1/game.proto",
+}
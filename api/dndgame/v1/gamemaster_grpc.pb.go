@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: dndgame/v1/gamemaster.proto
+
+package dndgamev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GameMasterService_ListPendingActionInstances_FullMethodName = "/dndgame.v1.GameMasterService/ListPendingActionInstances"
+	GameMasterService_ApproveActionInstance_FullMethodName      = "/dndgame.v1.GameMasterService/ApproveActionInstance"
+	GameMasterService_RejectActionInstance_FullMethodName       = "/dndgame.v1.GameMasterService/RejectActionInstance"
+	GameMasterService_ListActions_FullMethodName                = "/dndgame.v1.GameMasterService/ListActions"
+	GameMasterService_CreateAction_FullMethodName               = "/dndgame.v1.GameMasterService/CreateAction"
+	GameMasterService_ModifyAction_FullMethodName               = "/dndgame.v1.GameMasterService/ModifyAction"
+	GameMasterService_ListCharacters_FullMethodName             = "/dndgame.v1.GameMasterService/ListCharacters"
+	GameMasterService_GetCharacter_FullMethodName               = "/dndgame.v1.GameMasterService/GetCharacter"
+	GameMasterService_UpdateCharacter_FullMethodName            = "/dndgame.v1.GameMasterService/UpdateCharacter"
+	GameMasterService_UpdateCharacterXP_FullMethodName          = "/dndgame.v1.GameMasterService/UpdateCharacterXP"
+	GameMasterService_ReviewMissionProgress_FullMethodName      = "/dndgame.v1.GameMasterService/ReviewMissionProgress"
+	GameMasterService_StartMission_FullMethodName               = "/dndgame.v1.GameMasterService/StartMission"
+	GameMasterService_CompleteObjective_FullMethodName          = "/dndgame.v1.GameMasterService/CompleteObjective"
+	GameMasterService_FailMission_FullMethodName                = "/dndgame.v1.GameMasterService/FailMission"
+	GameMasterService_SetAdventureOutcome_FullMethodName        = "/dndgame.v1.GameMasterService/SetAdventureOutcome"
+	GameMasterService_EndAdventure_FullMethodName               = "/dndgame.v1.GameMasterService/EndAdventure"
+)
+
+// GameMasterServiceClient is the client API for GameMasterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameMasterServiceClient interface {
+	ListPendingActionInstances(ctx context.Context, in *ListPendingActionInstancesRequest, opts ...grpc.CallOption) (*ListPendingActionInstancesResponse, error)
+	// ApproveActionInstance approves an action instance as proposed, or modified with a justification.
+	ApproveActionInstance(ctx context.Context, in *ApproveActionInstanceRequest, opts ...grpc.CallOption) (*ActionInstance, error)
+	RejectActionInstance(ctx context.Context, in *RejectActionInstanceRequest, opts ...grpc.CallOption) (*ActionInstance, error)
+	ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error)
+	// CreateAction adds an action template to the repertoire of the game.
+	CreateAction(ctx context.Context, in *CreateActionRequest, opts ...grpc.CallOption) (*Action, error)
+	ModifyAction(ctx context.Context, in *ModifyActionRequest, opts ...grpc.CallOption) (*Action, error)
+	ListCharacters(ctx context.Context, in *ListCharactersRequest, opts ...grpc.CallOption) (*ListCharactersResponse, error)
+	GetCharacter(ctx context.Context, in *GetCharacterRequest, opts ...grpc.CallOption) (*Character, error)
+	// UpdateCharacter replaces a character, unless it was changed since the version given.
+	UpdateCharacter(ctx context.Context, in *UpdateCharacterRequest, opts ...grpc.CallOption) (*Character, error)
+	// UpdateCharacterXP gives or takes XP from a character, independently of actions.
+	UpdateCharacterXP(ctx context.Context, in *UpdateCharacterXPRequest, opts ...grpc.CallOption) (*Character, error)
+	ReviewMissionProgress(ctx context.Context, in *ReviewMissionProgressRequest, opts ...grpc.CallOption) (*Mission, error)
+	StartMission(ctx context.Context, in *StartMissionRequest, opts ...grpc.CallOption) (*Mission, error)
+	CompleteObjective(ctx context.Context, in *CompleteObjectiveRequest, opts ...grpc.CallOption) (*Mission, error)
+	FailMission(ctx context.Context, in *FailMissionRequest, opts ...grpc.CallOption) (*Mission, error)
+	SetAdventureOutcome(ctx context.Context, in *SetAdventureOutcomeRequest, opts ...grpc.CallOption) (*Game, error)
+	// EndAdventure pays out the characters of the current adventure and returns its report.
+	EndAdventure(ctx context.Context, in *EndAdventureRequest, opts ...grpc.CallOption) (*AdventureReport, error)
+}
+
+type gameMasterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameMasterServiceClient(cc grpc.ClientConnInterface) GameMasterServiceClient {
+	return &gameMasterServiceClient{cc}
+}
+
+func (c *gameMasterServiceClient) ListPendingActionInstances(ctx context.Context, in *ListPendingActionInstancesRequest, opts ...grpc.CallOption) (*ListPendingActionInstancesResponse, error) {
+	out := new(ListPendingActionInstancesResponse)
+	err := c.cc.Invoke(ctx, GameMasterService_ListPendingActionInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) ApproveActionInstance(ctx context.Context, in *ApproveActionInstanceRequest, opts ...grpc.CallOption) (*ActionInstance, error) {
+	out := new(ActionInstance)
+	err := c.cc.Invoke(ctx, GameMasterService_ApproveActionInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) RejectActionInstance(ctx context.Context, in *RejectActionInstanceRequest, opts ...grpc.CallOption) (*ActionInstance, error) {
+	out := new(ActionInstance)
+	err := c.cc.Invoke(ctx, GameMasterService_RejectActionInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error) {
+	out := new(ListActionsResponse)
+	err := c.cc.Invoke(ctx, GameMasterService_ListActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) CreateAction(ctx context.Context, in *CreateActionRequest, opts ...grpc.CallOption) (*Action, error) {
+	out := new(Action)
+	err := c.cc.Invoke(ctx, GameMasterService_CreateAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) ModifyAction(ctx context.Context, in *ModifyActionRequest, opts ...grpc.CallOption) (*Action, error) {
+	out := new(Action)
+	err := c.cc.Invoke(ctx, GameMasterService_ModifyAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) ListCharacters(ctx context.Context, in *ListCharactersRequest, opts ...grpc.CallOption) (*ListCharactersResponse, error) {
+	out := new(ListCharactersResponse)
+	err := c.cc.Invoke(ctx, GameMasterService_ListCharacters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) GetCharacter(ctx context.Context, in *GetCharacterRequest, opts ...grpc.CallOption) (*Character, error) {
+	out := new(Character)
+	err := c.cc.Invoke(ctx, GameMasterService_GetCharacter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) UpdateCharacter(ctx context.Context, in *UpdateCharacterRequest, opts ...grpc.CallOption) (*Character, error) {
+	out := new(Character)
+	err := c.cc.Invoke(ctx, GameMasterService_UpdateCharacter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) UpdateCharacterXP(ctx context.Context, in *UpdateCharacterXPRequest, opts ...grpc.CallOption) (*Character, error) {
+	out := new(Character)
+	err := c.cc.Invoke(ctx, GameMasterService_UpdateCharacterXP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) ReviewMissionProgress(ctx context.Context, in *ReviewMissionProgressRequest, opts ...grpc.CallOption) (*Mission, error) {
+	out := new(Mission)
+	err := c.cc.Invoke(ctx, GameMasterService_ReviewMissionProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) StartMission(ctx context.Context, in *StartMissionRequest, opts ...grpc.CallOption) (*Mission, error) {
+	out := new(Mission)
+	err := c.cc.Invoke(ctx, GameMasterService_StartMission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) CompleteObjective(ctx context.Context, in *CompleteObjectiveRequest, opts ...grpc.CallOption) (*Mission, error) {
+	out := new(Mission)
+	err := c.cc.Invoke(ctx, GameMasterService_CompleteObjective_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) FailMission(ctx context.Context, in *FailMissionRequest, opts ...grpc.CallOption) (*Mission, error) {
+	out := new(Mission)
+	err := c.cc.Invoke(ctx, GameMasterService_FailMission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) SetAdventureOutcome(ctx context.Context, in *SetAdventureOutcomeRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, GameMasterService_SetAdventureOutcome_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameMasterServiceClient) EndAdventure(ctx context.Context, in *EndAdventureRequest, opts ...grpc.CallOption) (*AdventureReport, error) {
+	out := new(AdventureReport)
+	err := c.cc.Invoke(ctx, GameMasterService_EndAdventure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameMasterServiceServer is the server API for GameMasterService service.
+// All implementations must embed UnimplementedGameMasterServiceServer
+// for forward compatibility
+type GameMasterServiceServer interface {
+	ListPendingActionInstances(context.Context, *ListPendingActionInstancesRequest) (*ListPendingActionInstancesResponse, error)
+	// ApproveActionInstance approves an action instance as proposed, or modified with a justification.
+	ApproveActionInstance(context.Context, *ApproveActionInstanceRequest) (*ActionInstance, error)
+	RejectActionInstance(context.Context, *RejectActionInstanceRequest) (*ActionInstance, error)
+	ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error)
+	// CreateAction adds an action template to the repertoire of the game.
+	CreateAction(context.Context, *CreateActionRequest) (*Action, error)
+	ModifyAction(context.Context, *ModifyActionRequest) (*Action, error)
+	ListCharacters(context.Context, *ListCharactersRequest) (*ListCharactersResponse, error)
+	GetCharacter(context.Context, *GetCharacterRequest) (*Character, error)
+	// UpdateCharacter replaces a character, unless it was changed since the version given.
+	UpdateCharacter(context.Context, *UpdateCharacterRequest) (*Character, error)
+	// UpdateCharacterXP gives or takes XP from a character, independently of actions.
+	UpdateCharacterXP(context.Context, *UpdateCharacterXPRequest) (*Character, error)
+	ReviewMissionProgress(context.Context, *ReviewMissionProgressRequest) (*Mission, error)
+	StartMission(context.Context, *StartMissionRequest) (*Mission, error)
+	CompleteObjective(context.Context, *CompleteObjectiveRequest) (*Mission, error)
+	FailMission(context.Context, *FailMissionRequest) (*Mission, error)
+	SetAdventureOutcome(context.Context, *SetAdventureOutcomeRequest) (*Game, error)
+	// EndAdventure pays out the characters of the current adventure and returns its report.
+	EndAdventure(context.Context, *EndAdventureRequest) (*AdventureReport, error)
+	mustEmbedUnimplementedGameMasterServiceServer()
+}
+
+// UnimplementedGameMasterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGameMasterServiceServer struct {
+}
+
+func (UnimplementedGameMasterServiceServer) ListPendingActionInstances(context.Context, *ListPendingActionInstancesRequest) (*ListPendingActionInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingActionInstances not implemented")
+}
+func (UnimplementedGameMasterServiceServer) ApproveActionInstance(context.Context, *ApproveActionInstanceRequest) (*ActionInstance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveActionInstance not implemented")
+}
+func (UnimplementedGameMasterServiceServer) RejectActionInstance(context.Context, *RejectActionInstanceRequest) (*ActionInstance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectActionInstance not implemented")
+}
+func (UnimplementedGameMasterServiceServer) ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActions not implemented")
+}
+func (UnimplementedGameMasterServiceServer) CreateAction(context.Context, *CreateActionRequest) (*Action, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAction not implemented")
+}
+func (UnimplementedGameMasterServiceServer) ModifyAction(context.Context, *ModifyActionRequest) (*Action, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyAction not implemented")
+}
+func (UnimplementedGameMasterServiceServer) ListCharacters(context.Context, *ListCharactersRequest) (*ListCharactersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCharacters not implemented")
+}
+func (UnimplementedGameMasterServiceServer) GetCharacter(context.Context, *GetCharacterRequest) (*Character, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacter not implemented")
+}
+func (UnimplementedGameMasterServiceServer) UpdateCharacter(context.Context, *UpdateCharacterRequest) (*Character, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCharacter not implemented")
+}
+func (UnimplementedGameMasterServiceServer) UpdateCharacterXP(context.Context, *UpdateCharacterXPRequest) (*Character, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCharacterXP not implemented")
+}
+func (UnimplementedGameMasterServiceServer) ReviewMissionProgress(context.Context, *ReviewMissionProgressRequest) (*Mission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewMissionProgress not implemented")
+}
+func (UnimplementedGameMasterServiceServer) StartMission(context.Context, *StartMissionRequest) (*Mission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMission not implemented")
+}
+func (UnimplementedGameMasterServiceServer) CompleteObjective(context.Context, *CompleteObjectiveRequest) (*Mission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteObjective not implemented")
+}
+func (UnimplementedGameMasterServiceServer) FailMission(context.Context, *FailMissionRequest) (*Mission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailMission not implemented")
+}
+func (UnimplementedGameMasterServiceServer) SetAdventureOutcome(context.Context, *SetAdventureOutcomeRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdventureOutcome not implemented")
+}
+func (UnimplementedGameMasterServiceServer) EndAdventure(context.Context, *EndAdventureRequest) (*AdventureReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndAdventure not implemented")
+}
+func (UnimplementedGameMasterServiceServer) mustEmbedUnimplementedGameMasterServiceServer() {}
+
+// UnsafeGameMasterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameMasterServiceServer will
+// result in compilation errors.
+type UnsafeGameMasterServiceServer interface {
+	mustEmbedUnimplementedGameMasterServiceServer()
+}
+
+func RegisterGameMasterServiceServer(s grpc.ServiceRegistrar, srv GameMasterServiceServer) {
+	s.RegisterService(&GameMasterService_ServiceDesc, srv)
+}
+
+func _GameMasterService_ListPendingActionInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingActionInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).ListPendingActionInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_ListPendingActionInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).ListPendingActionInstances(ctx, req.(*ListPendingActionInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_ApproveActionInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveActionInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).ApproveActionInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_ApproveActionInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).ApproveActionInstance(ctx, req.(*ApproveActionInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_RejectActionInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectActionInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).RejectActionInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_RejectActionInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).RejectActionInstance(ctx, req.(*RejectActionInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_ListActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).ListActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_ListActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).ListActions(ctx, req.(*ListActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_CreateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).CreateAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_CreateAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).CreateAction(ctx, req.(*CreateActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_ModifyAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).ModifyAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_ModifyAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).ModifyAction(ctx, req.(*ModifyActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_ListCharacters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).ListCharacters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_ListCharacters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).ListCharacters(ctx, req.(*ListCharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_GetCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).GetCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_GetCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).GetCharacter(ctx, req.(*GetCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_UpdateCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).UpdateCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_UpdateCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).UpdateCharacter(ctx, req.(*UpdateCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_UpdateCharacterXP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCharacterXPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).UpdateCharacterXP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_UpdateCharacterXP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).UpdateCharacterXP(ctx, req.(*UpdateCharacterXPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_ReviewMissionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMissionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).ReviewMissionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_ReviewMissionProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).ReviewMissionProgress(ctx, req.(*ReviewMissionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_StartMission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).StartMission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_StartMission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).StartMission(ctx, req.(*StartMissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_CompleteObjective_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteObjectiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).CompleteObjective(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_CompleteObjective_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).CompleteObjective(ctx, req.(*CompleteObjectiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_FailMission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailMissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).FailMission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_FailMission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).FailMission(ctx, req.(*FailMissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_SetAdventureOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdventureOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).SetAdventureOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_SetAdventureOutcome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).SetAdventureOutcome(ctx, req.(*SetAdventureOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameMasterService_EndAdventure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndAdventureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameMasterServiceServer).EndAdventure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameMasterService_EndAdventure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameMasterServiceServer).EndAdventure(ctx, req.(*EndAdventureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameMasterService_ServiceDesc is the grpc.ServiceDesc for GameMasterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameMasterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dndgame.v1.GameMasterService",
+	HandlerType: (*GameMasterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPendingActionInstances",
+			Handler:    _GameMasterService_ListPendingActionInstances_Handler,
+		},
+		{
+			MethodName: "ApproveActionInstance",
+			Handler:    _GameMasterService_ApproveActionInstance_Handler,
+		},
+		{
+			MethodName: "RejectActionInstance",
+			Handler:    _GameMasterService_RejectActionInstance_Handler,
+		},
+		{
+			MethodName: "ListActions",
+			Handler:    _GameMasterService_ListActions_Handler,
+		},
+		{
+			MethodName: "CreateAction",
+			Handler:    _GameMasterService_CreateAction_Handler,
+		},
+		{
+			MethodName: "ModifyAction",
+			Handler:    _GameMasterService_ModifyAction_Handler,
+		},
+		{
+			MethodName: "ListCharacters",
+			Handler:    _GameMasterService_ListCharacters_Handler,
+		},
+		{
+			MethodName: "GetCharacter",
+			Handler:    _GameMasterService_GetCharacter_Handler,
+		},
+		{
+			MethodName: "UpdateCharacter",
+			Handler:    _GameMasterService_UpdateCharacter_Handler,
+		},
+		{
+			MethodName: "UpdateCharacterXP",
+			Handler:    _GameMasterService_UpdateCharacterXP_Handler,
+		},
+		{
+			MethodName: "ReviewMissionProgress",
+			Handler:    _GameMasterService_ReviewMissionProgress_Handler,
+		},
+		{
+			MethodName: "StartMission",
+			Handler:    _GameMasterService_StartMission_Handler,
+		},
+		{
+			MethodName: "CompleteObjective",
+			Handler:    _GameMasterService_CompleteObjective_Handler,
+		},
+		{
+			MethodName: "FailMission",
+			Handler:    _GameMasterService_FailMission_Handler,
+		},
+		{
+			MethodName: "SetAdventureOutcome",
+			Handler:    _GameMasterService_SetAdventureOutcome_Handler,
+		},
+		{
+			MethodName: "EndAdventure",
+			Handler:    _GameMasterService_EndAdventure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dndgame/v1/gamemaster.proto",
+}
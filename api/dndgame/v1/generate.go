@@ -0,0 +1,5 @@
+// Package dndgamev1 holds the protobuf messages and the gRPC services of the game, generated from the .proto
+// files of this directory. The server is in internal/grpcapi.
+package dndgamev1
+
+//go:generate protoc -I ../.. --go_out=../.. --go_opt=paths=source_relative --go-grpc_out=../.. --go-grpc_opt=paths=source_relative dndgame/v1/types.proto dndgame/v1/game.proto dndgame/v1/player.proto dndgame/v1/gamemaster.proto dndgame/v1/events.proto
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: dndgame/v1/player.proto
+
+package dndgamev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PlayerService_CreatePlayer_FullMethodName              = "/dndgame.v1.PlayerService/CreatePlayer"
+	PlayerService_DeletePlayer_FullMethodName              = "/dndgame.v1.PlayerService/DeletePlayer"
+	PlayerService_GetPlayer_FullMethodName                 = "/dndgame.v1.PlayerService/GetPlayer"
+	PlayerService_CreateCharacter_FullMethodName           = "/dndgame.v1.PlayerService/CreateCharacter"
+	PlayerService_AddCharacterToPlayer_FullMethodName      = "/dndgame.v1.PlayerService/AddCharacterToPlayer"
+	PlayerService_RemoveCharacterFromPlayer_FullMethodName = "/dndgame.v1.PlayerService/RemoveCharacterFromPlayer"
+	PlayerService_ProposeAction_FullMethodName             = "/dndgame.v1.PlayerService/ProposeAction"
+	PlayerService_ExecuteActionInstance_FullMethodName     = "/dndgame.v1.PlayerService/ExecuteActionInstance"
+)
+
+// PlayerServiceClient is the client API for PlayerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlayerServiceClient interface {
+	CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*Player, error)
+	DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*Player, error)
+	// CreateCharacter creates a character of a player for a game, with its attributes rolled by the server or
+	// assigned by the player, depending on the generation method.
+	CreateCharacter(ctx context.Context, in *CreateCharacterRequest, opts ...grpc.CallOption) (*CreateCharacterResponse, error)
+	AddCharacterToPlayer(ctx context.Context, in *AddCharacterToPlayerRequest, opts ...grpc.CallOption) (*Player, error)
+	RemoveCharacterFromPlayer(ctx context.Context, in *RemoveCharacterFromPlayerRequest, opts ...grpc.CallOption) (*Player, error)
+	// ProposeAction proposes an action template for a character; the game master approves the new instance.
+	ProposeAction(ctx context.Context, in *ProposeActionRequest, opts ...grpc.CallOption) (*ActionInstance, error)
+	// ExecuteActionInstance executes an approved action instance, with the outcome rolled by the server.
+	ExecuteActionInstance(ctx context.Context, in *ExecuteActionInstanceRequest, opts ...grpc.CallOption) (*ActionInstance, error)
+}
+
+type playerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerServiceClient(cc grpc.ClientConnInterface) PlayerServiceClient {
+	return &playerServiceClient{cc}
+}
+
+func (c *playerServiceClient) CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*Player, error) {
+	out := new(Player)
+	err := c.cc.Invoke(ctx, PlayerService_CreatePlayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) DeletePlayer(ctx context.Context, in *DeletePlayerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PlayerService_DeletePlayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*Player, error) {
+	out := new(Player)
+	err := c.cc.Invoke(ctx, PlayerService_GetPlayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) CreateCharacter(ctx context.Context, in *CreateCharacterRequest, opts ...grpc.CallOption) (*CreateCharacterResponse, error) {
+	out := new(CreateCharacterResponse)
+	err := c.cc.Invoke(ctx, PlayerService_CreateCharacter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) AddCharacterToPlayer(ctx context.Context, in *AddCharacterToPlayerRequest, opts ...grpc.CallOption) (*Player, error) {
+	out := new(Player)
+	err := c.cc.Invoke(ctx, PlayerService_AddCharacterToPlayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) RemoveCharacterFromPlayer(ctx context.Context, in *RemoveCharacterFromPlayerRequest, opts ...grpc.CallOption) (*Player, error) {
+	out := new(Player)
+	err := c.cc.Invoke(ctx, PlayerService_RemoveCharacterFromPlayer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) ProposeAction(ctx context.Context, in *ProposeActionRequest, opts ...grpc.CallOption) (*ActionInstance, error) {
+	out := new(ActionInstance)
+	err := c.cc.Invoke(ctx, PlayerService_ProposeAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerServiceClient) ExecuteActionInstance(ctx context.Context, in *ExecuteActionInstanceRequest, opts ...grpc.CallOption) (*ActionInstance, error) {
+	out := new(ActionInstance)
+	err := c.cc.Invoke(ctx, PlayerService_ExecuteActionInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerServiceServer is the server API for PlayerService service.
+// All implementations must embed UnimplementedPlayerServiceServer
+// for forward compatibility
+type PlayerServiceServer interface {
+	CreatePlayer(context.Context, *CreatePlayerRequest) (*Player, error)
+	DeletePlayer(context.Context, *DeletePlayerRequest) (*emptypb.Empty, error)
+	GetPlayer(context.Context, *GetPlayerRequest) (*Player, error)
+	// CreateCharacter creates a character of a player for a game, with its attributes rolled by the server or
+	// assigned by the player, depending on the generation method.
+	CreateCharacter(context.Context, *CreateCharacterRequest) (*CreateCharacterResponse, error)
+	AddCharacterToPlayer(context.Context, *AddCharacterToPlayerRequest) (*Player, error)
+	RemoveCharacterFromPlayer(context.Context, *RemoveCharacterFromPlayerRequest) (*Player, error)
+	// ProposeAction proposes an action template for a character; the game master approves the new instance.
+	ProposeAction(context.Context, *ProposeActionRequest) (*ActionInstance, error)
+	// ExecuteActionInstance executes an approved action instance, with the outcome rolled by the server.
+	ExecuteActionInstance(context.Context, *ExecuteActionInstanceRequest) (*ActionInstance, error)
+	mustEmbedUnimplementedPlayerServiceServer()
+}
+
+// UnimplementedPlayerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPlayerServiceServer struct {
+}
+
+func (UnimplementedPlayerServiceServer) CreatePlayer(context.Context, *CreatePlayerRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) DeletePlayer(context.Context, *DeletePlayerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) GetPlayer(context.Context, *GetPlayerRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) CreateCharacter(context.Context, *CreateCharacterRequest) (*CreateCharacterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCharacter not implemented")
+}
+func (UnimplementedPlayerServiceServer) AddCharacterToPlayer(context.Context, *AddCharacterToPlayerRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCharacterToPlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) RemoveCharacterFromPlayer(context.Context, *RemoveCharacterFromPlayerRequest) (*Player, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCharacterFromPlayer not implemented")
+}
+func (UnimplementedPlayerServiceServer) ProposeAction(context.Context, *ProposeActionRequest) (*ActionInstance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAction not implemented")
+}
+func (UnimplementedPlayerServiceServer) ExecuteActionInstance(context.Context, *ExecuteActionInstanceRequest) (*ActionInstance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteActionInstance not implemented")
+}
+func (UnimplementedPlayerServiceServer) mustEmbedUnimplementedPlayerServiceServer() {}
+
+// UnsafePlayerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerServiceServer will
+// result in compilation errors.
+type UnsafePlayerServiceServer interface {
+	mustEmbedUnimplementedPlayerServiceServer()
+}
+
+func RegisterPlayerServiceServer(s grpc.ServiceRegistrar, srv PlayerServiceServer) {
+	s.RegisterService(&PlayerService_ServiceDesc, srv)
+}
+
+func _PlayerService_CreatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).CreatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_CreatePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).CreatePlayer(ctx, req.(*CreatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_DeletePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).DeletePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_DeletePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).DeletePlayer(ctx, req.(*DeletePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).GetPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_GetPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).GetPlayer(ctx, req.(*GetPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_CreateCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).CreateCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_CreateCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).CreateCharacter(ctx, req.(*CreateCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_AddCharacterToPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCharacterToPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).AddCharacterToPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_AddCharacterToPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).AddCharacterToPlayer(ctx, req.(*AddCharacterToPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_RemoveCharacterFromPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCharacterFromPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).RemoveCharacterFromPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_RemoveCharacterFromPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).RemoveCharacterFromPlayer(ctx, req.(*RemoveCharacterFromPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_ProposeAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).ProposeAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_ProposeAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).ProposeAction(ctx, req.(*ProposeActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerService_ExecuteActionInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteActionInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServiceServer).ExecuteActionInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlayerService_ExecuteActionInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServiceServer).ExecuteActionInstance(ctx, req.(*ExecuteActionInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerService_ServiceDesc is the grpc.ServiceDesc for PlayerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dndgame.v1.PlayerService",
+	HandlerType: (*PlayerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlayer",
+			Handler:    _PlayerService_CreatePlayer_Handler,
+		},
+		{
+			MethodName: "DeletePlayer",
+			Handler:    _PlayerService_DeletePlayer_Handler,
+		},
+		{
+			MethodName: "GetPlayer",
+			Handler:    _PlayerService_GetPlayer_Handler,
+		},
+		{
+			MethodName: "CreateCharacter",
+			Handler:    _PlayerService_CreateCharacter_Handler,
+		},
+		{
+			MethodName: "AddCharacterToPlayer",
+			Handler:    _PlayerService_AddCharacterToPlayer_Handler,
+		},
+		{
+			MethodName: "RemoveCharacterFromPlayer",
+			Handler:    _PlayerService_RemoveCharacterFromPlayer_Handler,
+		},
+		{
+			MethodName: "ProposeAction",
+			Handler:    _PlayerService_ProposeAction_Handler,
+		},
+		{
+			MethodName: "ExecuteActionInstance",
+			Handler:    _PlayerService_ExecuteActionInstance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dndgame/v1/player.proto",
+}
@@ -0,0 +1,2570 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.25.3
+// source: dndgame/v1/types.proto
+
+package dndgamev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameStatus int32
+
+const (
+	GameStatus_GAME_STATUS_UNSPECIFIED GameStatus = 0
+	GameStatus_GAME_STATUS_DRAFT       GameStatus = 1 // the game master is configuring the game
+	GameStatus_GAME_STATUS_LOBBY       GameStatus = 2 // players join and create characters, waiting for the start
+	GameStatus_GAME_STATUS_ACTIVE      GameStatus = 3 // the game is being played
+	GameStatus_GAME_STATUS_PAUSED      GameStatus = 4 // the game is interrupted and will be resumed
+	GameStatus_GAME_STATUS_ENDED       GameStatus = 5 // its end time has passed, or it was ended by the game master
+	GameStatus_GAME_STATUS_ARCHIVED    GameStatus = 6 // kept for the record only
+)
+
+// Enum value maps for GameStatus.
+var (
+	GameStatus_name = map[int32]string{
+		0: "GAME_STATUS_UNSPECIFIED",
+		1: "GAME_STATUS_DRAFT",
+		2: "GAME_STATUS_LOBBY",
+		3: "GAME_STATUS_ACTIVE",
+		4: "GAME_STATUS_PAUSED",
+		5: "GAME_STATUS_ENDED",
+		6: "GAME_STATUS_ARCHIVED",
+	}
+	GameStatus_value = map[string]int32{
+		"GAME_STATUS_UNSPECIFIED": 0,
+		"GAME_STATUS_DRAFT":       1,
+		"GAME_STATUS_LOBBY":       2,
+		"GAME_STATUS_ACTIVE":      3,
+		"GAME_STATUS_PAUSED":      4,
+		"GAME_STATUS_ENDED":       5,
+		"GAME_STATUS_ARCHIVED":    6,
+	}
+)
+
+func (x GameStatus) Enum() *GameStatus {
+	p := new(GameStatus)
+	*p = x
+	return p
+}
+
+func (x GameStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (GameStatus) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[0]
+}
+
+func (x GameStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameStatus.Descriptor instead.
+func (GameStatus) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+type AdventureType int32
+
+const (
+	AdventureType_ADVENTURE_TYPE_UNSPECIFIED    AdventureType = 0
+	AdventureType_ADVENTURE_TYPE_DUNGEON_CRAWLS AdventureType = 1
+	AdventureType_ADVENTURE_TYPE_QUESTS         AdventureType = 2
+	AdventureType_ADVENTURE_TYPE_CAMPAIGNS      AdventureType = 3
+)
+
+// Enum value maps for AdventureType.
+var (
+	AdventureType_name = map[int32]string{
+		0: "ADVENTURE_TYPE_UNSPECIFIED",
+		1: "ADVENTURE_TYPE_DUNGEON_CRAWLS",
+		2: "ADVENTURE_TYPE_QUESTS",
+		3: "ADVENTURE_TYPE_CAMPAIGNS",
+	}
+	AdventureType_value = map[string]int32{
+		"ADVENTURE_TYPE_UNSPECIFIED":    0,
+		"ADVENTURE_TYPE_DUNGEON_CRAWLS": 1,
+		"ADVENTURE_TYPE_QUESTS":         2,
+		"ADVENTURE_TYPE_CAMPAIGNS":      3,
+	}
+)
+
+func (x AdventureType) Enum() *AdventureType {
+	p := new(AdventureType)
+	*p = x
+	return p
+}
+
+func (x AdventureType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdventureType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (AdventureType) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[1]
+}
+
+func (x AdventureType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdventureType.Descriptor instead.
+func (AdventureType) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+type AdventureOutcome int32
+
+const (
+	AdventureOutcome_ADVENTURE_OUTCOME_UNSPECIFIED     AdventureOutcome = 0
+	AdventureOutcome_ADVENTURE_OUTCOME_UNDECIDED       AdventureOutcome = 1
+	AdventureOutcome_ADVENTURE_OUTCOME_VICTORY         AdventureOutcome = 2
+	AdventureOutcome_ADVENTURE_OUTCOME_DEFEAT          AdventureOutcome = 3
+	AdventureOutcome_ADVENTURE_OUTCOME_PARTIAL_VICTORY AdventureOutcome = 4
+	AdventureOutcome_ADVENTURE_OUTCOME_ABANDONED       AdventureOutcome = 5
+)
+
+// Enum value maps for AdventureOutcome.
+var (
+	AdventureOutcome_name = map[int32]string{
+		0: "ADVENTURE_OUTCOME_UNSPECIFIED",
+		1: "ADVENTURE_OUTCOME_UNDECIDED",
+		2: "ADVENTURE_OUTCOME_VICTORY",
+		3: "ADVENTURE_OUTCOME_DEFEAT",
+		4: "ADVENTURE_OUTCOME_PARTIAL_VICTORY",
+		5: "ADVENTURE_OUTCOME_ABANDONED",
+	}
+	AdventureOutcome_value = map[string]int32{
+		"ADVENTURE_OUTCOME_UNSPECIFIED":     0,
+		"ADVENTURE_OUTCOME_UNDECIDED":       1,
+		"ADVENTURE_OUTCOME_VICTORY":         2,
+		"ADVENTURE_OUTCOME_DEFEAT":          3,
+		"ADVENTURE_OUTCOME_PARTIAL_VICTORY": 4,
+		"ADVENTURE_OUTCOME_ABANDONED":       5,
+	}
+)
+
+func (x AdventureOutcome) Enum() *AdventureOutcome {
+	p := new(AdventureOutcome)
+	*p = x
+	return p
+}
+
+func (x AdventureOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdventureOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (AdventureOutcome) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[2]
+}
+
+func (x AdventureOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdventureOutcome.Descriptor instead.
+func (AdventureOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+type MissionStatus int32
+
+const (
+	MissionStatus_MISSION_STATUS_UNSPECIFIED MissionStatus = 0
+	MissionStatus_MISSION_STATUS_LOCKED      MissionStatus = 1
+	MissionStatus_MISSION_STATUS_AVAILABLE   MissionStatus = 2
+	MissionStatus_MISSION_STATUS_IN_PROGRESS MissionStatus = 3
+	MissionStatus_MISSION_STATUS_COMPLETED   MissionStatus = 4
+	MissionStatus_MISSION_STATUS_FAILED      MissionStatus = 5
+)
+
+// Enum value maps for MissionStatus.
+var (
+	MissionStatus_name = map[int32]string{
+		0: "MISSION_STATUS_UNSPECIFIED",
+		1: "MISSION_STATUS_LOCKED",
+		2: "MISSION_STATUS_AVAILABLE",
+		3: "MISSION_STATUS_IN_PROGRESS",
+		4: "MISSION_STATUS_COMPLETED",
+		5: "MISSION_STATUS_FAILED",
+	}
+	MissionStatus_value = map[string]int32{
+		"MISSION_STATUS_UNSPECIFIED": 0,
+		"MISSION_STATUS_LOCKED":      1,
+		"MISSION_STATUS_AVAILABLE":   2,
+		"MISSION_STATUS_IN_PROGRESS": 3,
+		"MISSION_STATUS_COMPLETED":   4,
+		"MISSION_STATUS_FAILED":      5,
+	}
+)
+
+func (x MissionStatus) Enum() *MissionStatus {
+	p := new(MissionStatus)
+	*p = x
+	return p
+}
+
+func (x MissionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (MissionStatus) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[3]
+}
+
+func (x MissionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissionStatus.Descriptor instead.
+func (MissionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+type CharacterClass int32
+
+const (
+	CharacterClass_CHARACTER_CLASS_UNSPECIFIED CharacterClass = 0
+	CharacterClass_CHARACTER_CLASS_WIZARD      CharacterClass = 1
+	CharacterClass_CHARACTER_CLASS_WARRIOR     CharacterClass = 2
+	CharacterClass_CHARACTER_CLASS_CLERIC      CharacterClass = 3
+	CharacterClass_CHARACTER_CLASS_RANGER      CharacterClass = 4
+)
+
+// Enum value maps for CharacterClass.
+var (
+	CharacterClass_name = map[int32]string{
+		0: "CHARACTER_CLASS_UNSPECIFIED",
+		1: "CHARACTER_CLASS_WIZARD",
+		2: "CHARACTER_CLASS_WARRIOR",
+		3: "CHARACTER_CLASS_CLERIC",
+		4: "CHARACTER_CLASS_RANGER",
+	}
+	CharacterClass_value = map[string]int32{
+		"CHARACTER_CLASS_UNSPECIFIED": 0,
+		"CHARACTER_CLASS_WIZARD":      1,
+		"CHARACTER_CLASS_WARRIOR":     2,
+		"CHARACTER_CLASS_CLERIC":      3,
+		"CHARACTER_CLASS_RANGER":      4,
+	}
+)
+
+func (x CharacterClass) Enum() *CharacterClass {
+	p := new(CharacterClass)
+	*p = x
+	return p
+}
+
+func (x CharacterClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CharacterClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[4].Descriptor()
+}
+
+func (CharacterClass) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[4]
+}
+
+func (x CharacterClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CharacterClass.Descriptor instead.
+func (CharacterClass) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+type CharacterRace int32
+
+const (
+	CharacterRace_CHARACTER_RACE_UNSPECIFIED CharacterRace = 0
+	CharacterRace_CHARACTER_RACE_HUMAN       CharacterRace = 1
+	CharacterRace_CHARACTER_RACE_ELF         CharacterRace = 2
+	CharacterRace_CHARACTER_RACE_DWARF       CharacterRace = 3
+	CharacterRace_CHARACTER_RACE_ORC         CharacterRace = 4
+	CharacterRace_CHARACTER_RACE_GHOST       CharacterRace = 5
+)
+
+// Enum value maps for CharacterRace.
+var (
+	CharacterRace_name = map[int32]string{
+		0: "CHARACTER_RACE_UNSPECIFIED",
+		1: "CHARACTER_RACE_HUMAN",
+		2: "CHARACTER_RACE_ELF",
+		3: "CHARACTER_RACE_DWARF",
+		4: "CHARACTER_RACE_ORC",
+		5: "CHARACTER_RACE_GHOST",
+	}
+	CharacterRace_value = map[string]int32{
+		"CHARACTER_RACE_UNSPECIFIED": 0,
+		"CHARACTER_RACE_HUMAN":       1,
+		"CHARACTER_RACE_ELF":         2,
+		"CHARACTER_RACE_DWARF":       3,
+		"CHARACTER_RACE_ORC":         4,
+		"CHARACTER_RACE_GHOST":       5,
+	}
+)
+
+func (x CharacterRace) Enum() *CharacterRace {
+	p := new(CharacterRace)
+	*p = x
+	return p
+}
+
+func (x CharacterRace) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CharacterRace) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[5].Descriptor()
+}
+
+func (CharacterRace) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[5]
+}
+
+func (x CharacterRace) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CharacterRace.Descriptor instead.
+func (CharacterRace) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+type GenerationMethod int32
+
+const (
+	GenerationMethod_GENERATION_METHOD_UNSPECIFIED          GenerationMethod = 0
+	GenerationMethod_GENERATION_METHOD_ROLL_3D6_IN_ORDER    GenerationMethod = 1 // rolled by the server
+	GenerationMethod_GENERATION_METHOD_ROLL_4D6_DROP_LOWEST GenerationMethod = 2 // rolled by the server
+	GenerationMethod_GENERATION_METHOD_STANDARD_ARRAY       GenerationMethod = 3 // assigned by the player
+	GenerationMethod_GENERATION_METHOD_POINT_BUY            GenerationMethod = 4 // assigned by the player
+)
+
+// Enum value maps for GenerationMethod.
+var (
+	GenerationMethod_name = map[int32]string{
+		0: "GENERATION_METHOD_UNSPECIFIED",
+		1: "GENERATION_METHOD_ROLL_3D6_IN_ORDER",
+		2: "GENERATION_METHOD_ROLL_4D6_DROP_LOWEST",
+		3: "GENERATION_METHOD_STANDARD_ARRAY",
+		4: "GENERATION_METHOD_POINT_BUY",
+	}
+	GenerationMethod_value = map[string]int32{
+		"GENERATION_METHOD_UNSPECIFIED":          0,
+		"GENERATION_METHOD_ROLL_3D6_IN_ORDER":    1,
+		"GENERATION_METHOD_ROLL_4D6_DROP_LOWEST": 2,
+		"GENERATION_METHOD_STANDARD_ARRAY":       3,
+		"GENERATION_METHOD_POINT_BUY":            4,
+	}
+)
+
+func (x GenerationMethod) Enum() *GenerationMethod {
+	p := new(GenerationMethod)
+	*p = x
+	return p
+}
+
+func (x GenerationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenerationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[6].Descriptor()
+}
+
+func (GenerationMethod) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[6]
+}
+
+func (x GenerationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenerationMethod.Descriptor instead.
+func (GenerationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+type ActionState int32
+
+const (
+	ActionState_ACTION_STATE_UNSPECIFIED ActionState = 0
+	ActionState_ACTION_STATE_PROPOSED    ActionState = 1
+	ActionState_ACTION_STATE_MODIFIED    ActionState = 2
+	ActionState_ACTION_STATE_APPROVED    ActionState = 3
+	ActionState_ACTION_STATE_REJECTED    ActionState = 4
+	ActionState_ACTION_STATE_WITHDRAWN   ActionState = 5
+	ActionState_ACTION_STATE_EXECUTED    ActionState = 6
+	ActionState_ACTION_STATE_RESOLVED    ActionState = 7
+	ActionState_ACTION_STATE_EXPIRED     ActionState = 8
+)
+
+// Enum value maps for ActionState.
+var (
+	ActionState_name = map[int32]string{
+		0: "ACTION_STATE_UNSPECIFIED",
+		1: "ACTION_STATE_PROPOSED",
+		2: "ACTION_STATE_MODIFIED",
+		3: "ACTION_STATE_APPROVED",
+		4: "ACTION_STATE_REJECTED",
+		5: "ACTION_STATE_WITHDRAWN",
+		6: "ACTION_STATE_EXECUTED",
+		7: "ACTION_STATE_RESOLVED",
+		8: "ACTION_STATE_EXPIRED",
+	}
+	ActionState_value = map[string]int32{
+		"ACTION_STATE_UNSPECIFIED": 0,
+		"ACTION_STATE_PROPOSED":    1,
+		"ACTION_STATE_MODIFIED":    2,
+		"ACTION_STATE_APPROVED":    3,
+		"ACTION_STATE_REJECTED":    4,
+		"ACTION_STATE_WITHDRAWN":   5,
+		"ACTION_STATE_EXECUTED":    6,
+		"ACTION_STATE_RESOLVED":    7,
+		"ACTION_STATE_EXPIRED":     8,
+	}
+)
+
+func (x ActionState) Enum() *ActionState {
+	p := new(ActionState)
+	*p = x
+	return p
+}
+
+func (x ActionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[7].Descriptor()
+}
+
+func (ActionState) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[7]
+}
+
+func (x ActionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionState.Descriptor instead.
+func (ActionState) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+type Outcome int32
+
+const (
+	Outcome_OUTCOME_UNSPECIFIED Outcome = 0
+	Outcome_OUTCOME_NONE        Outcome = 1 // not resolved yet
+	Outcome_OUTCOME_SUCCESS     Outcome = 2 // the full reward is earned
+	Outcome_OUTCOME_PARTIAL     Outcome = 3 // half of the reward is earned, no penalty
+	Outcome_OUTCOME_FAILURE     Outcome = 4 // the penalty is lost
+)
+
+// Enum value maps for Outcome.
+var (
+	Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "OUTCOME_NONE",
+		2: "OUTCOME_SUCCESS",
+		3: "OUTCOME_PARTIAL",
+		4: "OUTCOME_FAILURE",
+	}
+	Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"OUTCOME_NONE":        1,
+		"OUTCOME_SUCCESS":     2,
+		"OUTCOME_PARTIAL":     3,
+		"OUTCOME_FAILURE":     4,
+	}
+)
+
+func (x Outcome) Enum() *Outcome {
+	p := new(Outcome)
+	*p = x
+	return p
+}
+
+func (x Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_dndgame_v1_types_proto_enumTypes[8].Descriptor()
+}
+
+func (Outcome) Type() protoreflect.EnumType {
+	return &file_dndgame_v1_types_proto_enumTypes[8]
+}
+
+func (x Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Outcome.Descriptor instead.
+func (Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+type Game struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status              GameStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=dndgame.v1.GameStatus" json:"status,omitempty"`
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // unset until the game is scheduled or started
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	AllowLateCharacters bool                   `protobuf:"varint,6,opt,name=allow_late_characters,json=allowLateCharacters,proto3" json:"allow_late_characters,omitempty"`
+	GameMaster          *GameMaster            `protobuf:"bytes,7,opt,name=game_master,json=gameMaster,proto3" json:"game_master,omitempty"` // unset until a game master is set to direct the game
+	PlayerIds           []string               `protobuf:"bytes,8,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	Rulebook            *Rulebook              `protobuf:"bytes,9,opt,name=rulebook,proto3" json:"rulebook,omitempty"`
+	Adventure           *Adventure             `protobuf:"bytes,10,opt,name=adventure,proto3" json:"adventure,omitempty"`
+	PayoutRules         *PayoutRules           `protobuf:"bytes,11,opt,name=payout_rules,json=payoutRules,proto3" json:"payout_rules,omitempty"`
+	Reports             []*AdventureReport     `protobuf:"bytes,12,rep,name=reports,proto3" json:"reports,omitempty"`
+	Version             int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Game) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Game) GetStatus() GameStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GameStatus_GAME_STATUS_UNSPECIFIED
+}
+
+func (x *Game) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Game) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Game) GetAllowLateCharacters() bool {
+	if x != nil {
+		return x.AllowLateCharacters
+	}
+	return false
+}
+
+func (x *Game) GetGameMaster() *GameMaster {
+	if x != nil {
+		return x.GameMaster
+	}
+	return nil
+}
+
+func (x *Game) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *Game) GetRulebook() *Rulebook {
+	if x != nil {
+		return x.Rulebook
+	}
+	return nil
+}
+
+func (x *Game) GetAdventure() *Adventure {
+	if x != nil {
+		return x.Adventure
+	}
+	return nil
+}
+
+func (x *Game) GetPayoutRules() *PayoutRules {
+	if x != nil {
+		return x.PayoutRules
+	}
+	return nil
+}
+
+func (x *Game) GetReports() []*AdventureReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *Game) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GameMaster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GameMaster) Reset() {
+	*x = GameMaster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameMaster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMaster) ProtoMessage() {}
+
+func (x *GameMaster) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMaster.ProtoReflect.Descriptor instead.
+func (*GameMaster) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GameMaster) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GameMaster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Rulebook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxXpGrantPerCharacter int32   `protobuf:"varint,1,opt,name=max_xp_grant_per_character,json=maxXpGrantPerCharacter,proto3" json:"max_xp_grant_per_character,omitempty"`
+	MinCostMultiplier      float64 `protobuf:"fixed64,2,opt,name=min_cost_multiplier,json=minCostMultiplier,proto3" json:"min_cost_multiplier,omitempty"`
+	MaxCostMultiplier      float64 `protobuf:"fixed64,3,opt,name=max_cost_multiplier,json=maxCostMultiplier,proto3" json:"max_cost_multiplier,omitempty"`
+	AllowNegativeBalance   bool    `protobuf:"varint,4,opt,name=allow_negative_balance,json=allowNegativeBalance,proto3" json:"allow_negative_balance,omitempty"`
+}
+
+func (x *Rulebook) Reset() {
+	*x = Rulebook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rulebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rulebook) ProtoMessage() {}
+
+func (x *Rulebook) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rulebook.ProtoReflect.Descriptor instead.
+func (*Rulebook) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Rulebook) GetMaxXpGrantPerCharacter() int32 {
+	if x != nil {
+		return x.MaxXpGrantPerCharacter
+	}
+	return 0
+}
+
+func (x *Rulebook) GetMinCostMultiplier() float64 {
+	if x != nil {
+		return x.MinCostMultiplier
+	}
+	return 0
+}
+
+func (x *Rulebook) GetMaxCostMultiplier() float64 {
+	if x != nil {
+		return x.MaxCostMultiplier
+	}
+	return 0
+}
+
+func (x *Rulebook) GetAllowNegativeBalance() bool {
+	if x != nil {
+		return x.AllowNegativeBalance
+	}
+	return false
+}
+
+type Adventure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     AdventureType    `protobuf:"varint,2,opt,name=type,proto3,enum=dndgame.v1.AdventureType" json:"type,omitempty"`
+	Outcome  AdventureOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=dndgame.v1.AdventureOutcome" json:"outcome,omitempty"` // ignored when the adventure is set
+	Missions []*Mission       `protobuf:"bytes,4,rep,name=missions,proto3" json:"missions,omitempty"`
+}
+
+func (x *Adventure) Reset() {
+	*x = Adventure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Adventure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adventure) ProtoMessage() {}
+
+func (x *Adventure) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adventure.ProtoReflect.Descriptor instead.
+func (*Adventure) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Adventure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Adventure) GetType() AdventureType {
+	if x != nil {
+		return x.Type
+	}
+	return AdventureType_ADVENTURE_TYPE_UNSPECIFIED
+}
+
+func (x *Adventure) GetOutcome() AdventureOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return AdventureOutcome_ADVENTURE_OUTCOME_UNSPECIFIED
+}
+
+func (x *Adventure) GetMissions() []*Mission {
+	if x != nil {
+		return x.Missions
+	}
+	return nil
+}
+
+type Mission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status        MissionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=dndgame.v1.MissionStatus" json:"status,omitempty"` // ignored when the mission is added
+	Objectives    []*Objective  `protobuf:"bytes,5,rep,name=objectives,proto3" json:"objectives,omitempty"`
+	Prerequisites []string      `protobuf:"bytes,6,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+}
+
+func (x *Mission) Reset() {
+	*x = Mission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mission) ProtoMessage() {}
+
+func (x *Mission) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mission.ProtoReflect.Descriptor instead.
+func (*Mission) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Mission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Mission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Mission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Mission) GetStatus() MissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MissionStatus_MISSION_STATUS_UNSPECIFIED
+}
+
+func (x *Mission) GetObjectives() []*Objective {
+	if x != nil {
+		return x.Objectives
+	}
+	return nil
+}
+
+func (x *Mission) GetPrerequisites() []string {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+type Objective struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Criteria    string `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	Optional    bool   `protobuf:"varint,4,opt,name=optional,proto3" json:"optional,omitempty"`
+	Completed   bool   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"` // ignored when the mission is added
+}
+
+func (x *Objective) Reset() {
+	*x = Objective{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Objective) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Objective) ProtoMessage() {}
+
+func (x *Objective) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Objective.ProtoReflect.Descriptor instead.
+func (*Objective) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Objective) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Objective) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Objective) GetCriteria() string {
+	if x != nil {
+		return x.Criteria
+	}
+	return ""
+}
+
+func (x *Objective) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Objective) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type PayoutRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XpPerCompletedMission int32           `protobuf:"varint,1,opt,name=xp_per_completed_mission,json=xpPerCompletedMission,proto3" json:"xp_per_completed_mission,omitempty"`
+	OutcomeBonuses        []*OutcomeBonus `protobuf:"bytes,2,rep,name=outcome_bonuses,json=outcomeBonuses,proto3" json:"outcome_bonuses,omitempty"`
+}
+
+func (x *PayoutRules) Reset() {
+	*x = PayoutRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutRules) ProtoMessage() {}
+
+func (x *PayoutRules) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutRules.ProtoReflect.Descriptor instead.
+func (*PayoutRules) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PayoutRules) GetXpPerCompletedMission() int32 {
+	if x != nil {
+		return x.XpPerCompletedMission
+	}
+	return 0
+}
+
+func (x *PayoutRules) GetOutcomeBonuses() []*OutcomeBonus {
+	if x != nil {
+		return x.OutcomeBonuses
+	}
+	return nil
+}
+
+type OutcomeBonus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome AdventureOutcome `protobuf:"varint,1,opt,name=outcome,proto3,enum=dndgame.v1.AdventureOutcome" json:"outcome,omitempty"`
+	Bonus   int32            `protobuf:"varint,2,opt,name=bonus,proto3" json:"bonus,omitempty"`
+}
+
+func (x *OutcomeBonus) Reset() {
+	*x = OutcomeBonus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutcomeBonus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutcomeBonus) ProtoMessage() {}
+
+func (x *OutcomeBonus) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutcomeBonus.ProtoReflect.Descriptor instead.
+func (*OutcomeBonus) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OutcomeBonus) GetOutcome() AdventureOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return AdventureOutcome_ADVENTURE_OUTCOME_UNSPECIFIED
+}
+
+func (x *OutcomeBonus) GetBonus() int32 {
+	if x != nil {
+		return x.Bonus
+	}
+	return 0
+}
+
+type AdventureReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdventureId string                 `protobuf:"bytes,1,opt,name=adventure_id,json=adventureId,proto3" json:"adventure_id,omitempty"`
+	Type        AdventureType          `protobuf:"varint,2,opt,name=type,proto3,enum=dndgame.v1.AdventureType" json:"type,omitempty"`
+	Outcome     AdventureOutcome       `protobuf:"varint,3,opt,name=outcome,proto3,enum=dndgame.v1.AdventureOutcome" json:"outcome,omitempty"`
+	EndedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Missions    []*MissionResult       `protobuf:"bytes,5,rep,name=missions,proto3" json:"missions,omitempty"`
+	Standings   []*Standing            `protobuf:"bytes,6,rep,name=standings,proto3" json:"standings,omitempty"` // ordered by final XP, highest first
+}
+
+func (x *AdventureReport) Reset() {
+	*x = AdventureReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdventureReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdventureReport) ProtoMessage() {}
+
+func (x *AdventureReport) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdventureReport.ProtoReflect.Descriptor instead.
+func (*AdventureReport) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdventureReport) GetAdventureId() string {
+	if x != nil {
+		return x.AdventureId
+	}
+	return ""
+}
+
+func (x *AdventureReport) GetType() AdventureType {
+	if x != nil {
+		return x.Type
+	}
+	return AdventureType_ADVENTURE_TYPE_UNSPECIFIED
+}
+
+func (x *AdventureReport) GetOutcome() AdventureOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return AdventureOutcome_ADVENTURE_OUTCOME_UNSPECIFIED
+}
+
+func (x *AdventureReport) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *AdventureReport) GetMissions() []*MissionResult {
+	if x != nil {
+		return x.Missions
+	}
+	return nil
+}
+
+func (x *AdventureReport) GetStandings() []*Standing {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+type MissionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MissionId string        `protobuf:"bytes,1,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	Name      string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    MissionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=dndgame.v1.MissionStatus" json:"status,omitempty"`
+}
+
+func (x *MissionResult) Reset() {
+	*x = MissionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionResult) ProtoMessage() {}
+
+func (x *MissionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionResult.ProtoReflect.Descriptor instead.
+func (*MissionResult) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MissionResult) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *MissionResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MissionResult) GetStatus() MissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MissionStatus_MISSION_STATUS_UNSPECIFIED
+}
+
+type Standing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PayoutXp    int32  `protobuf:"varint,3,opt,name=payout_xp,json=payoutXp,proto3" json:"payout_xp,omitempty"`
+	FinalXp     int32  `protobuf:"varint,4,opt,name=final_xp,json=finalXp,proto3" json:"final_xp,omitempty"`
+}
+
+func (x *Standing) Reset() {
+	*x = Standing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Standing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Standing) ProtoMessage() {}
+
+func (x *Standing) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Standing.ProtoReflect.Descriptor instead.
+func (*Standing) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Standing) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *Standing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Standing) GetPayoutXp() int32 {
+	if x != nil {
+		return x.PayoutXp
+	}
+	return 0
+}
+
+func (x *Standing) GetFinalXp() int32 {
+	if x != nil {
+		return x.FinalXp
+	}
+	return 0
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Active       bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	CharacterIds []string `protobuf:"bytes,4,rep,name=character_ids,json=characterIds,proto3" json:"character_ids,omitempty"`
+	Version      int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Player) GetCharacterIds() []string {
+	if x != nil {
+		return x.CharacterIds
+	}
+	return nil
+}
+
+func (x *Player) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Character struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Class       CharacterClass `protobuf:"varint,3,opt,name=class,proto3,enum=dndgame.v1.CharacterClass" json:"class,omitempty"`
+	Race        CharacterRace  `protobuf:"varint,4,opt,name=race,proto3,enum=dndgame.v1.CharacterRace" json:"race,omitempty"`
+	Description string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Attributes  *Attributes    `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Xp          int32          `protobuf:"varint,7,opt,name=xp,proto3" json:"xp,omitempty"`
+	Active      bool           `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Version     int64          `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Character) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Character) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Character) GetClass() CharacterClass {
+	if x != nil {
+		return x.Class
+	}
+	return CharacterClass_CHARACTER_CLASS_UNSPECIFIED
+}
+
+func (x *Character) GetRace() CharacterRace {
+	if x != nil {
+		return x.Race
+	}
+	return CharacterRace_CHARACTER_RACE_UNSPECIFIED
+}
+
+func (x *Character) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Character) GetAttributes() *Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Character) GetXp() int32 {
+	if x != nil {
+		return x.Xp
+	}
+	return 0
+}
+
+func (x *Character) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Character) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Attributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strength     int32 `protobuf:"varint,1,opt,name=strength,proto3" json:"strength,omitempty"`
+	Dexterity    int32 `protobuf:"varint,2,opt,name=dexterity,proto3" json:"dexterity,omitempty"`
+	Constitution int32 `protobuf:"varint,3,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	Intelligence int32 `protobuf:"varint,4,opt,name=intelligence,proto3" json:"intelligence,omitempty"`
+	Wisdom       int32 `protobuf:"varint,5,opt,name=wisdom,proto3" json:"wisdom,omitempty"`
+	Charisma     int32 `protobuf:"varint,6,opt,name=charisma,proto3" json:"charisma,omitempty"`
+}
+
+func (x *Attributes) Reset() {
+	*x = Attributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attributes) ProtoMessage() {}
+
+func (x *Attributes) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attributes.ProtoReflect.Descriptor instead.
+func (*Attributes) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Attributes) GetStrength() int32 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+func (x *Attributes) GetDexterity() int32 {
+	if x != nil {
+		return x.Dexterity
+	}
+	return 0
+}
+
+func (x *Attributes) GetConstitution() int32 {
+	if x != nil {
+		return x.Constitution
+	}
+	return 0
+}
+
+func (x *Attributes) GetIntelligence() int32 {
+	if x != nil {
+		return x.Intelligence
+	}
+	return 0
+}
+
+func (x *Attributes) GetWisdom() int32 {
+	if x != nil {
+		return x.Wisdom
+	}
+	return 0
+}
+
+func (x *Attributes) GetCharisma() int32 {
+	if x != nil {
+		return x.Charisma
+	}
+	return 0
+}
+
+// Roll is the roll of the dice for an attribute.
+type Roll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notation string  `protobuf:"bytes,1,opt,name=notation,proto3" json:"notation,omitempty"`
+	Dice     []int32 `protobuf:"varint,2,rep,packed,name=dice,proto3" json:"dice,omitempty"` // every die rolled, in roll order
+	Kept     []int32 `protobuf:"varint,3,rep,packed,name=kept,proto3" json:"kept,omitempty"` // the dice counting towards the total
+	Total    int32   `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Roll) Reset() {
+	*x = Roll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Roll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Roll) ProtoMessage() {}
+
+func (x *Roll) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Roll.ProtoReflect.Descriptor instead.
+func (*Roll) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Roll) GetNotation() string {
+	if x != nil {
+		return x.Notation
+	}
+	return ""
+}
+
+func (x *Roll) GetDice() []int32 {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *Roll) GetKept() []int32 {
+	if x != nil {
+		return x.Kept
+	}
+	return nil
+}
+
+func (x *Roll) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Action is an action template of the game.
+type Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BaseXpCost int32  `protobuf:"varint,3,opt,name=base_xp_cost,json=baseXpCost,proto3" json:"base_xp_cost,omitempty"`
+	RewardXp   int32  `protobuf:"varint,4,opt,name=reward_xp,json=rewardXp,proto3" json:"reward_xp,omitempty"`
+	PenaltyXp  int32  `protobuf:"varint,5,opt,name=penalty_xp,json=penaltyXp,proto3" json:"penalty_xp,omitempty"`
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Action) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Action) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Action) GetBaseXpCost() int32 {
+	if x != nil {
+		return x.BaseXpCost
+	}
+	return 0
+}
+
+func (x *Action) GetRewardXp() int32 {
+	if x != nil {
+		return x.RewardXp
+	}
+	return 0
+}
+
+func (x *Action) GetPenaltyXp() int32 {
+	if x != nil {
+		return x.PenaltyXp
+	}
+	return 0
+}
+
+type ActionInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameId       string      `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	CharacterId  string      `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Action       *Action     `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	CustomXpCost int32       `protobuf:"varint,5,opt,name=custom_xp_cost,json=customXpCost,proto3" json:"custom_xp_cost,omitempty"`
+	RewardXp     int32       `protobuf:"varint,6,opt,name=reward_xp,json=rewardXp,proto3" json:"reward_xp,omitempty"`
+	PenaltyXp    int32       `protobuf:"varint,7,opt,name=penalty_xp,json=penaltyXp,proto3" json:"penalty_xp,omitempty"`
+	State        ActionState `protobuf:"varint,8,opt,name=state,proto3,enum=dndgame.v1.ActionState" json:"state,omitempty"`
+	Outcome      Outcome     `protobuf:"varint,9,opt,name=outcome,proto3,enum=dndgame.v1.Outcome" json:"outcome,omitempty"`
+	Version      int64       `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ActionInstance) Reset() {
+	*x = ActionInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dndgame_v1_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionInstance) ProtoMessage() {}
+
+func (x *ActionInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_dndgame_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionInstance.ProtoReflect.Descriptor instead.
+func (*ActionInstance) Descriptor() ([]byte, []int) {
+	return file_dndgame_v1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ActionInstance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActionInstance) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ActionInstance) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ActionInstance) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ActionInstance) GetCustomXpCost() int32 {
+	if x != nil {
+		return x.CustomXpCost
+	}
+	return 0
+}
+
+func (x *ActionInstance) GetRewardXp() int32 {
+	if x != nil {
+		return x.RewardXp
+	}
+	return 0
+}
+
+func (x *ActionInstance) GetPenaltyXp() int32 {
+	if x != nil {
+		return x.PenaltyXp
+	}
+	return 0
+}
+
+func (x *ActionInstance) GetState() ActionState {
+	if x != nil {
+		return x.State
+	}
+	return ActionState_ACTION_STATE_UNSPECIFIED
+}
+
+func (x *ActionInstance) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ActionInstance) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_dndgame_v1_types_proto protoreflect.FileDescriptor
+
+var file_dndgame_v1_types_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x04, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x30, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x62, 0x6f,
+	0x6f, 0x6b, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x61, 0x64,
+	0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x78, 0x70, 0x5f, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x58, 0x70, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x73, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64,
+	0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x07,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x35, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0a, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0x93, 0x01,
+	0x0a, 0x09, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x78, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x78, 0x70, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0f,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x52,
+	0x0e, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x22,
+	0x5c, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12,
+	0x36, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x22, 0xbd, 0x02,
+	0x0a, 0x0f, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75,
+	0x72, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x08, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64,
+	0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x75, 0x0a,
+	0x0d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x79, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x5f, 0x78, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x58, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x78, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x58, 0x70, 0x22,
+	0x83, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x02, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x61, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x61,
+	0x63, 0x65, 0x52, 0x04, 0x72, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x78, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x78, 0x74, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x64, 0x65, 0x78, 0x74, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x6c, 0x6c, 0x69,
+	0x67, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x73, 0x64, 0x6f, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x69, 0x73, 0x64, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x72, 0x69, 0x73, 0x6d, 0x61, 0x22, 0x60, 0x0a, 0x04, 0x52, 0x6f, 0x6c,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x64, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x04, 0x6b, 0x65, 0x70, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8a, 0x01, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x78, 0x70, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x58, 0x70, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x78, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x58, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x5f, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x58, 0x70, 0x22, 0xe2, 0x02, 0x0a, 0x0e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x78, 0x70,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x58, 0x70, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x78, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x58, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x5f, 0x78, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x58, 0x70, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0xb8, 0x01,
+	0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17,
+	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18,
+	0x0a, 0x14, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x8b, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x76,
+	0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x44,
+	0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x44,
+	0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x55, 0x4e,
+	0x47, 0x45, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x41, 0x57, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x19, 0x0a,
+	0x15, 0x41, 0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x44, 0x56, 0x45,
+	0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4d, 0x50, 0x41,
+	0x49, 0x47, 0x4e, 0x53, 0x10, 0x03, 0x2a, 0xdb, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x76, 0x65, 0x6e,
+	0x74, 0x75, 0x72, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x41,
+	0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f,
+	0x0a, 0x1b, 0x41, 0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x43, 0x49, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x55, 0x54,
+	0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x41, 0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x41, 0x54, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21,
+	0x41, 0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d,
+	0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x43, 0x54, 0x4f, 0x52,
+	0x59, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x56, 0x45, 0x4e, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0xc1, 0x01, 0x0a, 0x0d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12,
+	0x1e, 0x0a, 0x1a, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a,
+	0x15, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x43,
+	0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x57, 0x49, 0x5a, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x52,
+	0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x57, 0x41, 0x52, 0x52,
+	0x49, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54,
+	0x45, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x43, 0x4c, 0x45, 0x52, 0x49, 0x43, 0x10,
+	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x43,
+	0x4c, 0x41, 0x53, 0x53, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x52, 0x10, 0x04, 0x2a, 0xad, 0x01,
+	0x0a, 0x0d, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x61, 0x63, 0x65, 0x12,
+	0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x43,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x43,
+	0x45, 0x5f, 0x48, 0x55, 0x4d, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41,
+	0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x45, 0x4c, 0x46, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52,
+	0x41, 0x43, 0x45, 0x5f, 0x44, 0x57, 0x41, 0x52, 0x46, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43,
+	0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x4f, 0x52,
+	0x43, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52,
+	0x5f, 0x52, 0x41, 0x43, 0x45, 0x5f, 0x47, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x05, 0x2a, 0xd1, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f,
+	0x33, 0x44, 0x36, 0x5f, 0x49, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x2a,
+	0x0a, 0x26, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x34, 0x44, 0x36, 0x5f, 0x44, 0x52, 0x4f,
+	0x50, 0x5f, 0x4c, 0x4f, 0x57, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x47, 0x45,
+	0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
+	0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x03,
+	0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x59, 0x10,
+	0x04, 0x2a, 0x83, 0x02, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x07, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x73, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f,
+	0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x04, 0x42, 0x37, 0x5a, 0x35,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x65, 0x72, 0x62, 0x65,
+	0x72, 0x6c, 0x69, 0x6e, 0x2f, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x6e, 0x64, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x6e, 0x64, 0x67,
+	0x61, 0x6d, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dndgame_v1_types_proto_rawDescOnce sync.Once
+	file_dndgame_v1_types_proto_rawDescData = file_dndgame_v1_types_proto_rawDesc
+)
+
+func file_dndgame_v1_types_proto_rawDescGZIP() []byte {
+	file_dndgame_v1_types_proto_rawDescOnce.Do(func() {
+		file_dndgame_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_dndgame_v1_types_proto_rawDescData)
+	})
+	return file_dndgame_v1_types_proto_rawDescData
+}
+
+var file_dndgame_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_dndgame_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_dndgame_v1_types_proto_goTypes = []any{
+	(GameStatus)(0),               // 0: dndgame.v1.GameStatus
+	(AdventureType)(0),            // 1: dndgame.v1.AdventureType
+	(AdventureOutcome)(0),         // 2: dndgame.v1.AdventureOutcome
+	(MissionStatus)(0),            // 3: dndgame.v1.MissionStatus
+	(CharacterClass)(0),           // 4: dndgame.v1.CharacterClass
+	(CharacterRace)(0),            // 5: dndgame.v1.CharacterRace
+	(GenerationMethod)(0),         // 6: dndgame.v1.GenerationMethod
+	(ActionState)(0),              // 7: dndgame.v1.ActionState
+	(Outcome)(0),                  // 8: dndgame.v1.Outcome
+	(*Game)(nil),                  // 9: dndgame.v1.Game
+	(*GameMaster)(nil),            // 10: dndgame.v1.GameMaster
+	(*Rulebook)(nil),              // 11: dndgame.v1.Rulebook
+	(*Adventure)(nil),             // 12: dndgame.v1.Adventure
+	(*Mission)(nil),               // 13: dndgame.v1.Mission
+	(*Objective)(nil),             // 14: dndgame.v1.Objective
+	(*PayoutRules)(nil),           // 15: dndgame.v1.PayoutRules
+	(*OutcomeBonus)(nil),          // 16: dndgame.v1.OutcomeBonus
+	(*AdventureReport)(nil),       // 17: dndgame.v1.AdventureReport
+	(*MissionResult)(nil),         // 18: dndgame.v1.MissionResult
+	(*Standing)(nil),              // 19: dndgame.v1.Standing
+	(*Player)(nil),                // 20: dndgame.v1.Player
+	(*Character)(nil),             // 21: dndgame.v1.Character
+	(*Attributes)(nil),            // 22: dndgame.v1.Attributes
+	(*Roll)(nil),                  // 23: dndgame.v1.Roll
+	(*Action)(nil),                // 24: dndgame.v1.Action
+	(*ActionInstance)(nil),        // 25: dndgame.v1.ActionInstance
+	(*timestamppb.Timestamp)(nil), // 26: google.protobuf.Timestamp
+}
+var file_dndgame_v1_types_proto_depIdxs = []int32{
+	0,  // 0: dndgame.v1.Game.status:type_name -> dndgame.v1.GameStatus
+	26, // 1: dndgame.v1.Game.start_time:type_name -> google.protobuf.Timestamp
+	26, // 2: dndgame.v1.Game.end_time:type_name -> google.protobuf.Timestamp
+	10, // 3: dndgame.v1.Game.game_master:type_name -> dndgame.v1.GameMaster
+	11, // 4: dndgame.v1.Game.rulebook:type_name -> dndgame.v1.Rulebook
+	12, // 5: dndgame.v1.Game.adventure:type_name -> dndgame.v1.Adventure
+	15, // 6: dndgame.v1.Game.payout_rules:type_name -> dndgame.v1.PayoutRules
+	17, // 7: dndgame.v1.Game.reports:type_name -> dndgame.v1.AdventureReport
+	1,  // 8: dndgame.v1.Adventure.type:type_name -> dndgame.v1.AdventureType
+	2,  // 9: dndgame.v1.Adventure.outcome:type_name -> dndgame.v1.AdventureOutcome
+	13, // 10: dndgame.v1.Adventure.missions:type_name -> dndgame.v1.Mission
+	3,  // 11: dndgame.v1.Mission.status:type_name -> dndgame.v1.MissionStatus
+	14, // 12: dndgame.v1.Mission.objectives:type_name -> dndgame.v1.Objective
+	16, // 13: dndgame.v1.PayoutRules.outcome_bonuses:type_name -> dndgame.v1.OutcomeBonus
+	2,  // 14: dndgame.v1.OutcomeBonus.outcome:type_name -> dndgame.v1.AdventureOutcome
+	1,  // 15: dndgame.v1.AdventureReport.type:type_name -> dndgame.v1.AdventureType
+	2,  // 16: dndgame.v1.AdventureReport.outcome:type_name -> dndgame.v1.AdventureOutcome
+	26, // 17: dndgame.v1.AdventureReport.ended_at:type_name -> google.protobuf.Timestamp
+	18, // 18: dndgame.v1.AdventureReport.missions:type_name -> dndgame.v1.MissionResult
+	19, // 19: dndgame.v1.AdventureReport.standings:type_name -> dndgame.v1.Standing
+	3,  // 20: dndgame.v1.MissionResult.status:type_name -> dndgame.v1.MissionStatus
+	4,  // 21: dndgame.v1.Character.class:type_name -> dndgame.v1.CharacterClass
+	5,  // 22: dndgame.v1.Character.race:type_name -> dndgame.v1.CharacterRace
+	22, // 23: dndgame.v1.Character.attributes:type_name -> dndgame.v1.Attributes
+	24, // 24: dndgame.v1.ActionInstance.action:type_name -> dndgame.v1.Action
+	7,  // 25: dndgame.v1.ActionInstance.state:type_name -> dndgame.v1.ActionState
+	8,  // 26: dndgame.v1.ActionInstance.outcome:type_name -> dndgame.v1.Outcome
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_dndgame_v1_types_proto_init() }
+func file_dndgame_v1_types_proto_init() {
+	if File_dndgame_v1_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dndgame_v1_types_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Game); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GameMaster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Rulebook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Adventure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Mission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Objective); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*PayoutRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*OutcomeBonus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AdventureReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*MissionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Standing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Attributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*Roll); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dndgame_v1_types_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ActionInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dndgame_v1_types_proto_rawDesc,
+			NumEnums:      9,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dndgame_v1_types_proto_goTypes,
+		DependencyIndexes: file_dndgame_v1_types_proto_depIdxs,
+		EnumInfos:         file_dndgame_v1_types_proto_enumTypes,
+		MessageInfos:      file_dndgame_v1_types_proto_msgTypes,
+	}.Build()
+	File_dndgame_v1_types_proto = out.File
+	file_dndgame_v1_types_proto_rawDesc = nil
+	file_dndgame_v1_types_proto_goTypes = nil
+	file_dndgame_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/httpapi"
+	"github.com/jerberlin/dndgame/internal/repo/jsonfile"
+)
+
+// client sends the requests of the commands to the REST API, served by a remote server or in the process on
+// a local data directory.
+type client struct {
+	base  string // the URL of the server, without the version prefix
+	http  *http.Client
+	close func() error
+}
+
+// newRemoteClient creates a client of the server at a URL, e.g. http://localhost:8080.
+func newRemoteClient(url string) *client {
+	return &client{
+		base:  strings.TrimSuffix(url, "/"),
+		http:  &http.Client{Timeout: 30 * time.Second},
+		close: func() error { return nil },
+	}
+}
+
+// newLocalClient creates a client serving the requests in the process on the jsonfile store of a data
+// directory, which it holds until closed. The internal errors are logged to logger.
+func newLocalClient(dir string, logger *log.Logger) (*client, error) {
+	store, err := jsonfile.Open(dir)
+	if err != nil {
+		return nil, err
+	}
+	a := app.New(store.Repositories(), store, clock.Real{})
+	return &client{
+		base: "http://local",
+		http: &http.Client{Transport: handlerTransport{httpapi.NewServer(a, logger)}},
+		close: func() error {
+			a.Close()
+			return store.Close()
+		},
+	}, nil
+}
+
+// handlerTransport serves the requests with a handler of the process instead of sending them.
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	if r.Body == nil {
+		r.Body = http.NoBody
+	}
+	rec := httptest.NewRecorder()
+	t.handler.ServeHTTP(rec, r)
+	return rec.Result(), nil
+}
+
+// apiError is the error of a failed request, as described by the API.
+type apiError struct {
+	body httpapi.Error
+}
+
+func (e *apiError) Error() string {
+	msg := e.body.Message
+	for _, v := range e.body.Violations {
+		msg += "\n  - " + v
+	}
+	return msg
+}
+
+// do sends a request with body encoded as JSON, unless nil, and decodes the body of its response into result,
+// unless nil.
+func (c *client) do(method, path string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.base+httpapi.Version+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		var e httpapi.ErrorBody
+		if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
+			return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+		}
+		return &apiError{body: e.Error}
+	}
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,479 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/httpapi"
+)
+
+// attributeNames are the short names of the attributes, in the order of httpapi.Attributes.
+var attributeNames = []string{"STR", "DEX", "CON", "INT", "WIS", "CHA"}
+
+// cli runs the commands with a client, and writes their results with its printer.
+type cli struct {
+	client *client
+	printer
+	stderr io.Writer
+}
+
+// command is a subcommand of dndgame, e.g. "game create".
+type command struct {
+	name  string
+	usage string // the flags of the command
+	help  string
+	run   func(c *cli, args []string) error
+}
+
+// usageError is the error of a command used wrongly.
+type usageError string
+
+func (e usageError) Error() string { return string(e) }
+
+// errReported is the usage error the flag set of a command has already reported.
+var errReported = usageError("")
+
+var commands = []command{
+	{"game create", "-id ID -name NAME [-start TIME -end TIME]", "create a game, scheduled if it has a start and an end time (RFC 3339)", gameCreate},
+	{"game show", "-id ID", "show a game", gameShow},
+	{"game master", "-id ID -gm GM_ID -name NAME", "set the game master directing a game", gameMaster},
+	{"game status", "-id ID -status STATUS", "move a game to a status, e.g. Lobby to let the players join", gameStatus},
+	{"game start", "-id ID", "start a game", gameStart},
+	{"game end", "-id ID", "end a game", gameEnd},
+	{"game late-characters", "-id ID [-allow=false]", "allow the characters created once a game is active", gameLateCharacters},
+	{"game join", "-id ID -player PLAYER_ID", "add a player to a game", gameJoin},
+	{"game leave", "-id ID -player PLAYER_ID", "remove a player from a game", gameLeave},
+	{"player create", "-id ID -name NAME", "register a player", playerCreate},
+	{"player show", "-id ID", "show a player", playerShow},
+	{"character create", "-player PLAYER_ID -game GAME_ID -id ID -name NAME -class CLASS -race RACE [-method METHOD] [-attributes STR,DEX,CON,INT,WIS,CHA] [-description TEXT]",
+		"create a character, rolling its attributes with the Roll3d6InOrder and Roll4d6DropLowest methods", characterCreate},
+	{"character show", "-id ID", "show a character", characterShow},
+	{"character list", "", "list the characters", characterList},
+	{"action create", "-id ID -name NAME -cost XP -reward XP -penalty XP", "create an action template", actionCreate},
+	{"action list", "", "list the action templates", actionList},
+	{"action propose", "-game GAME_ID -character CHARACTER_ID -action ACTION_ID [-cost XP]", "propose an action for a character", actionPropose},
+	{"action pending", "", "list the action instances waiting for the game master", actionPending},
+	{"action show", "-game GAME_ID -instance INSTANCE_ID", "show an action instance", actionShow},
+	{"action approve", "-game GAME_ID -gm GM_ID -instance INSTANCE_ID [-cost XP] [-reward XP] [-penalty XP] [-justification TEXT]",
+		"approve an action instance as game master, modified if any of its XP is given, which needs a justification", actionApprove},
+	{"action reject", "-game GAME_ID -gm GM_ID -instance INSTANCE_ID", "reject an action instance as game master", actionReject},
+	{"action execute", "-game GAME_ID -character CHARACTER_ID -instance INSTANCE_ID", "execute an approved action instance, with an outcome rolled by the dice", actionExecute},
+}
+
+// find returns the command named by the first words of args, and the arguments following its name.
+func find(args []string) (*command, []string) {
+	for i := range commands {
+		words := strings.Fields(commands[i].name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == commands[i].name {
+			return &commands[i], args[len(words):]
+		}
+	}
+	return nil, nil
+}
+
+// flags creates the flag set of a command, with -json.
+func (c *cli) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.BoolVar(&c.json, "json", c.json, "write the result as JSON")
+	return fs
+}
+
+// parse parses the arguments of a command, and checks that its required flags are given.
+func parse(fs *flag.FlagSet, args []string, required ...string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errReported
+	}
+	if fs.NArg() > 0 {
+		return usageError(fmt.Sprintf("%s: unexpected argument %q", fs.Name(), fs.Arg(0)))
+	}
+	var missing []string
+	for _, name := range required {
+		if fs.Lookup(name).Value.String() == "" {
+			missing = append(missing, "-"+name)
+		}
+	}
+	if len(missing) > 0 {
+		return usageError(fmt.Sprintf("%s: %s required", fs.Name(), strings.Join(missing, ", ")))
+	}
+	return nil
+}
+
+// given reports whether a flag was set on the command line.
+func given(fs *flag.FlagSet, name string) bool {
+	found := false
+	fs.Visit(func(f *flag.Flag) { found = found || f.Name == name })
+	return found
+}
+
+func path(segments ...string) string {
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func (c *cli) showGame(method, p string, body any) error {
+	var g httpapi.Game
+	if err := c.client.do(method, p, body, &g); err != nil {
+		return err
+	}
+	return c.game(g)
+}
+
+func gameCreate(c *cli, args []string) error {
+	fs := c.flags("game create")
+	id := fs.String("id", "", "ID of the game")
+	name := fs.String("name", "", "name of the game")
+	start := fs.String("start", "", "start time of a scheduled game, RFC 3339")
+	end := fs.String("end", "", "end time of a scheduled game, RFC 3339")
+	if err := parse(fs, args, "id", "name"); err != nil {
+		return err
+	}
+	req := httpapi.CreateGameRequest{ID: *id, Name: *name}
+	for _, t := range []struct {
+		value  string
+		target **time.Time
+	}{{*start, &req.StartTime}, {*end, &req.EndTime}} {
+		if t.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, t.value)
+		if err != nil {
+			return usageError(fmt.Sprintf("game create: %v", err))
+		}
+		*t.target = &parsed
+	}
+	return c.showGame(http.MethodPost, "/games", req)
+}
+
+func gameShow(c *cli, args []string) error {
+	fs := c.flags("game show")
+	id := fs.String("id", "", "ID of the game")
+	if err := parse(fs, args, "id"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodGet, path("games", *id), nil)
+}
+
+func gameMaster(c *cli, args []string) error {
+	fs := c.flags("game master")
+	id := fs.String("id", "", "ID of the game")
+	gm := fs.String("gm", "", "ID of the game master")
+	name := fs.String("name", "", "name of the game master")
+	if err := parse(fs, args, "id", "gm", "name"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodPut, path("games", *id, "gamemaster"), httpapi.GameMaster{ID: *gm, Name: *name})
+}
+
+func gameStatus(c *cli, args []string) error {
+	fs := c.flags("game status")
+	id := fs.String("id", "", "ID of the game")
+	status := fs.String("status", "", "status of the game: Draft, Lobby, Active, Paused, Ended or Archived")
+	if err := parse(fs, args, "id", "status"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodPut, path("games", *id, "status"), httpapi.SetGameStatusRequest{Status: *status})
+}
+
+func gameStart(c *cli, args []string) error {
+	fs := c.flags("game start")
+	id := fs.String("id", "", "ID of the game")
+	if err := parse(fs, args, "id"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodPost, path("games", *id, "start"), nil)
+}
+
+func gameEnd(c *cli, args []string) error {
+	fs := c.flags("game end")
+	id := fs.String("id", "", "ID of the game")
+	if err := parse(fs, args, "id"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodPost, path("games", *id, "end"), nil)
+}
+
+func gameLateCharacters(c *cli, args []string) error {
+	fs := c.flags("game late-characters")
+	id := fs.String("id", "", "ID of the game")
+	allow := fs.Bool("allow", true, "whether the characters may be created once the game is active")
+	if err := parse(fs, args, "id"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodPut, path("games", *id, "late-characters"), httpapi.SetLateCharactersRequest{Allow: *allow})
+}
+
+func gameJoin(c *cli, args []string) error {
+	fs := c.flags("game join")
+	id := fs.String("id", "", "ID of the game")
+	playerID := fs.String("player", "", "ID of the player")
+	if err := parse(fs, args, "id", "player"); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodPost, path("games", *id, "players"), httpapi.JoinGameRequest{PlayerID: *playerID})
+}
+
+func gameLeave(c *cli, args []string) error {
+	fs := c.flags("game leave")
+	id := fs.String("id", "", "ID of the game")
+	playerID := fs.String("player", "", "ID of the player")
+	if err := parse(fs, args, "id", "player"); err != nil {
+		return err
+	}
+	if err := c.client.do(http.MethodDelete, path("games", *id, "players", *playerID), nil, nil); err != nil {
+		return err
+	}
+	return c.showGame(http.MethodGet, path("games", *id), nil)
+}
+
+func playerCreate(c *cli, args []string) error {
+	fs := c.flags("player create")
+	id := fs.String("id", "", "ID of the player")
+	name := fs.String("name", "", "name of the player")
+	if err := parse(fs, args, "id", "name"); err != nil {
+		return err
+	}
+	var pl httpapi.Player
+	if err := c.client.do(http.MethodPost, "/players", httpapi.CreatePlayerRequest{ID: *id, Name: *name}, &pl); err != nil {
+		return err
+	}
+	return c.player(pl)
+}
+
+func playerShow(c *cli, args []string) error {
+	fs := c.flags("player show")
+	id := fs.String("id", "", "ID of the player")
+	if err := parse(fs, args, "id"); err != nil {
+		return err
+	}
+	var pl httpapi.Player
+	if err := c.client.do(http.MethodGet, path("players", *id), nil, &pl); err != nil {
+		return err
+	}
+	return c.player(pl)
+}
+
+// parseAttributes parses the six attributes, separated by commas, in the order of attributeNames.
+func parseAttributes(s string) (*httpapi.Attributes, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != len(attributeNames) {
+		return nil, usageError(fmt.Sprintf("-attributes: want %d values, %s, got %q", len(attributeNames), strings.Join(attributeNames, ","), s))
+	}
+	values := make([]int, len(parts))
+	for i, p := range parts {
+		v, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return nil, usageError(fmt.Sprintf("-attributes: %s %q is not a number", attributeNames[i], p))
+		}
+		values[i] = v
+	}
+	return &httpapi.Attributes{
+		Strength: values[0], Dexterity: values[1], Constitution: values[2],
+		Intelligence: values[3], Wisdom: values[4], Charisma: values[5],
+	}, nil
+}
+
+func characterCreate(c *cli, args []string) error {
+	fs := c.flags("character create")
+	playerID := fs.String("player", "", "ID of the player")
+	req := httpapi.CreateCharacterRequest{}
+	fs.StringVar(&req.GameID, "game", "", "ID of the game")
+	fs.StringVar(&req.ID, "id", "", "ID of the character")
+	fs.StringVar(&req.Name, "name", "", "name of the character")
+	fs.StringVar(&req.Class, "class", "", "class of the character: Wizard, Warrior, Cleric or Ranger")
+	fs.StringVar(&req.Race, "race", "", "race of the character: Human, Elf, Dwarf, Orc or Ghost")
+	fs.StringVar(&req.Description, "description", "", "description of the character")
+	fs.StringVar(&req.Method, "method", "Roll4d6DropLowest", "generation of the attributes: Roll3d6InOrder, Roll4d6DropLowest, StandardArray or PointBuy")
+	attributes := fs.String("attributes", "", "attributes assigned with the StandardArray and PointBuy methods, as "+strings.Join(attributeNames, ","))
+	if err := parse(fs, args, "player", "game", "id", "name", "class", "race"); err != nil {
+		return err
+	}
+	if *attributes != "" {
+		var err error
+		if req.Attributes, err = parseAttributes(*attributes); err != nil {
+			return err
+		}
+	}
+	var created httpapi.CreatedCharacter
+	if err := c.client.do(http.MethodPost, path("players", *playerID, "characters"), req, &created); err != nil {
+		return err
+	}
+	return c.createdCharacter(created)
+}
+
+func characterShow(c *cli, args []string) error {
+	fs := c.flags("character show")
+	id := fs.String("id", "", "ID of the character")
+	if err := parse(fs, args, "id"); err != nil {
+		return err
+	}
+	var char httpapi.Character
+	if err := c.client.do(http.MethodGet, path("characters", *id), nil, &char); err != nil {
+		return err
+	}
+	return c.character(char)
+}
+
+func characterList(c *cli, args []string) error {
+	if err := parse(c.flags("character list"), args); err != nil {
+		return err
+	}
+	var chars []httpapi.Character
+	if err := c.client.do(http.MethodGet, "/characters", nil, &chars); err != nil {
+		return err
+	}
+	return c.characters(chars)
+}
+
+func actionCreate(c *cli, args []string) error {
+	fs := c.flags("action create")
+	var a httpapi.Action
+	fs.StringVar(&a.ID, "id", "", "ID of the action")
+	fs.StringVar(&a.Name, "name", "", "name of the action")
+	fs.IntVar(&a.BaseXPCost, "cost", 0, "XP spent to execute the action")
+	fs.IntVar(&a.RewardXP, "reward", 0, "XP earned on success")
+	fs.IntVar(&a.PenaltyXP, "penalty", 0, "XP lost on failure")
+	if err := parse(fs, args, "id", "name"); err != nil {
+		return err
+	}
+	if err := c.client.do(http.MethodPost, "/actions", a, &a); err != nil {
+		return err
+	}
+	return c.actions([]httpapi.Action{a})
+}
+
+func actionList(c *cli, args []string) error {
+	if err := parse(c.flags("action list"), args); err != nil {
+		return err
+	}
+	var actions []httpapi.Action
+	if err := c.client.do(http.MethodGet, "/actions", nil, &actions); err != nil {
+		return err
+	}
+	return c.actions(actions)
+}
+
+func actionPropose(c *cli, args []string) error {
+	fs := c.flags("action propose")
+	gameID := fs.String("game", "", "ID of the game")
+	var req httpapi.ProposeActionRequest
+	fs.StringVar(&req.CharacterID, "character", "", "ID of the character")
+	fs.StringVar(&req.ActionID, "action", "", "ID of the action template")
+	fs.IntVar(&req.CustomXPCost, "cost", 0, "XP cost proposed, the base cost of the action if unset")
+	if err := parse(fs, args, "game", "character", "action"); err != nil {
+		return err
+	}
+	var ai httpapi.ActionInstance
+	if err := c.client.do(http.MethodPost, path("games", *gameID, "instances"), req, &ai); err != nil {
+		return err
+	}
+	return c.instance(ai)
+}
+
+func actionPending(c *cli, args []string) error {
+	if err := parse(c.flags("action pending"), args); err != nil {
+		return err
+	}
+	var instances []httpapi.ActionInstance
+	if err := c.client.do(http.MethodGet, "/instances/pending", nil, &instances); err != nil {
+		return err
+	}
+	return c.instances(instances)
+}
+
+func actionShow(c *cli, args []string) error {
+	fs := c.flags("action show")
+	gameID := fs.String("game", "", "ID of the game")
+	instanceID := fs.String("instance", "", "ID of the action instance")
+	if err := parse(fs, args, "game", "instance"); err != nil {
+		return err
+	}
+	var ai httpapi.ActionInstance
+	if err := c.client.do(http.MethodGet, path("games", *gameID, "instances", *instanceID), nil, &ai); err != nil {
+		return err
+	}
+	return c.instance(ai)
+}
+
+// actionApprove approves an action instance, modified if any of its XP is given. The XP not given are kept.
+func actionApprove(c *cli, args []string) error {
+	fs := c.flags("action approve")
+	gameID := fs.String("game", "", "ID of the game")
+	instanceID := fs.String("instance", "", "ID of the action instance")
+	var req httpapi.ApproveActionRequest
+	fs.StringVar(&req.GameMasterID, "gm", "", "ID of the game master")
+	fs.StringVar(&req.Justification, "justification", "", "why the action instance is modified")
+	cost := fs.Int("cost", 0, "XP cost set by the game master")
+	reward := fs.Int("reward", 0, "XP reward set by the game master")
+	penalty := fs.Int("penalty", 0, "XP penalty set by the game master")
+	if err := parse(fs, args, "game", "gm", "instance"); err != nil {
+		return err
+	}
+	instancePath := path("games", *gameID, "instances", *instanceID)
+	if given(fs, "cost") || given(fs, "reward") || given(fs, "penalty") {
+		var current httpapi.ActionInstance
+		if err := c.client.do(http.MethodGet, instancePath, nil, &current); err != nil {
+			return err
+		}
+		m := httpapi.Modification{CustomXPCost: current.CustomXPCost, RewardXP: current.RewardXP, PenaltyXP: current.PenaltyXP}
+		for _, f := range []struct {
+			name   string
+			value  int
+			target *int
+		}{{"cost", *cost, &m.CustomXPCost}, {"reward", *reward, &m.RewardXP}, {"penalty", *penalty, &m.PenaltyXP}} {
+			if given(fs, f.name) {
+				*f.target = f.value
+			}
+		}
+		req.Modification = &m
+	}
+	var ai httpapi.ActionInstance
+	if err := c.client.do(http.MethodPost, instancePath+"/approve", req, &ai); err != nil {
+		return err
+	}
+	return c.instance(ai)
+}
+
+func actionReject(c *cli, args []string) error {
+	fs := c.flags("action reject")
+	gameID := fs.String("game", "", "ID of the game")
+	gm := fs.String("gm", "", "ID of the game master")
+	instanceID := fs.String("instance", "", "ID of the action instance")
+	if err := parse(fs, args, "game", "gm", "instance"); err != nil {
+		return err
+	}
+	req := httpapi.GameMasterRequest{GameMasterID: *gm}
+	var ai httpapi.ActionInstance
+	if err := c.client.do(http.MethodPost, path("games", *gameID, "instances", *instanceID, "reject"), req, &ai); err != nil {
+		return err
+	}
+	return c.instance(ai)
+}
+
+func actionExecute(c *cli, args []string) error {
+	fs := c.flags("action execute")
+	gameID := fs.String("game", "", "ID of the game")
+	instanceID := fs.String("instance", "", "ID of the action instance")
+	var req httpapi.ExecuteActionRequest
+	fs.StringVar(&req.CharacterID, "character", "", "ID of the character")
+	if err := parse(fs, args, "game", "character", "instance"); err != nil {
+		return err
+	}
+	var ai httpapi.ActionInstance
+	if err := c.client.do(http.MethodPost, path("games", *gameID, "instances", *instanceID, "execute"), req, &ai); err != nil {
+		return err
+	}
+	return c.instance(ai)
+}
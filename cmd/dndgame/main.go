@@ -0,0 +1,199 @@
+// Command dndgame runs games from a terminal, on a local data directory or against a server of the REST API,
+// see package httpapi.
+//
+// Usage:
+//
+//	dndgame [-data DIR | -api URL] [-json] COMMAND [FLAGS]
+//	dndgame [-data DIR | -api URL] [-json] shell
+//
+// The games are kept in the data directory, ./dndgame-data or $DNDGAME_DATA by default, which one process at a
+// time may use; with -api, or $DNDGAME_API, they are kept by the server instead, e.g. http://localhost:8080.
+// The results are written as tables, or as the JSON of the API with -json, which every command accepts.
+//
+// A table session, e.g.:
+//
+//	dndgame game create -id g1 -name "The Sunless Citadel"
+//	dndgame game master -id g1 -gm gm1 -name Dana
+//	dndgame game status -id g1 -status Lobby
+//	dndgame player create -id p1 -name Alex
+//	dndgame game join -id g1 -player p1
+//	dndgame character create -player p1 -game g1 -id c1 -name Merric -class Warrior -race Dwarf
+//	dndgame game start -id g1
+//	dndgame action create -id a1 -name Climb -cost 10 -reward 20 -penalty 5
+//	dndgame action propose -game g1 -character c1 -action a1
+//	dndgame action approve -game g1 -gm gm1 -instance INSTANCE_ID -cost 8 -justification "slick with rain"
+//	dndgame action execute -game g1 -character c1 -instance INSTANCE_ID
+//
+// The shell reads the commands from the standard input, one per line, holding the data directory until it
+// ends, with "exit" or at the end of the input.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+// Exit codes.
+const (
+	exitOK    = 0
+	exitError = 1 // a request failed
+	exitUsage = 2 // a command was used wrongly
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs dndgame with the arguments following its name, and returns its exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("dndgame", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(stderr) }
+	dir := fs.String("data", envOr("DNDGAME_DATA", "dndgame-data"), "data directory of the games")
+	apiURL := fs.String("api", os.Getenv("DNDGAME_API"), "URL of a server of the REST API, instead of the data directory")
+	asJSON := fs.Bool("json", false, "write the results as JSON")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	args = fs.Args()
+	if len(args) == 0 || args[0] == "help" {
+		usage(stdout)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+
+	var c *client
+	if *apiURL != "" {
+		c = newRemoteClient(*apiURL)
+	} else {
+		var err error
+		if c, err = newLocalClient(*dir, log.New(stderr, "dndgame: ", 0)); err != nil {
+			fmt.Fprintf(stderr, "dndgame: %v\n", err)
+			return exitError
+		}
+	}
+	defer func() {
+		if err := c.close(); err != nil {
+			fmt.Fprintf(stderr, "dndgame: %v\n", err)
+		}
+	}()
+	cl := &cli{client: c, printer: printer{out: stdout, json: *asJSON}, stderr: stderr}
+	if len(args) == 1 && args[0] == "shell" {
+		cl.shell(stdin)
+		return exitOK
+	}
+	return cl.exec(args)
+}
+
+// exec runs a command, reports its error, and returns its exit code. The -json of the command applies to it
+// only.
+func (c *cli) exec(args []string) int {
+	cmd, rest := find(args)
+	if cmd == nil {
+		fmt.Fprintf(c.stderr, "dndgame: unknown command %q, see dndgame help\n", strings.Join(args, " "))
+		return exitUsage
+	}
+	defer func(asJSON bool) { c.json = asJSON }(c.json)
+	err := cmd.run(c, rest)
+	var u usageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &u):
+		if u != errReported {
+			fmt.Fprintf(c.stderr, "dndgame: %v\nusage: dndgame %s %s\n", err, cmd.name, cmd.usage)
+		}
+		return exitUsage
+	default:
+		fmt.Fprintf(c.stderr, "dndgame: %v\n", err)
+		return exitError
+	}
+}
+
+// shell runs the commands read from in, one per line, until "exit" or the end of the input.
+func (c *cli) shell(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(c.stderr, "dndgame> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(c.stderr)
+			return
+		}
+		args, err := splitLine(scanner.Text())
+		switch {
+		case err != nil:
+			fmt.Fprintf(c.stderr, "dndgame: %v\n", err)
+		case len(args) == 0:
+		case args[0] == "exit" || args[0] == "quit":
+			return
+		case args[0] == "help":
+			usage(c.out)
+		default:
+			c.exec(args)
+		}
+	}
+}
+
+// splitLine splits a line of the shell into words separated by spaces, keeping the spaces quoted with single
+// or double quotes.
+func splitLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	for _, r := range line {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %c", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func usage(out io.Writer) {
+	fmt.Fprintf(out, "usage: dndgame [-data DIR | -api URL] [-json] COMMAND [FLAGS]\n\ncommands:\n")
+	t := newTable(out)
+	for _, cmd := range commands {
+		t.row("  "+cmd.name, cmd.help)
+	}
+	t.row("  shell", "read the commands from the standard input, one per line")
+	t.flush()
+	fmt.Fprintf(out, "\nRun dndgame COMMAND -h for the flags of a command.\n")
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
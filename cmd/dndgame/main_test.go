@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/httpapi"
+)
+
+// session runs dndgame commands with the same global flags.
+type session struct {
+	t      *testing.T
+	global []string
+}
+
+// run runs a command, checks its exit code, and returns its output.
+func (s *session) run(wantCode int, args ...string) string {
+	s.t.Helper()
+	var stdout, stderr bytes.Buffer
+	if code := run(append(append([]string(nil), s.global...), args...), strings.NewReader(""), &stdout, &stderr); code != wantCode {
+		s.t.Fatalf("dndgame %s exit code = %d, want %d, stderr %s", strings.Join(args, " "), code, wantCode, stderr.String())
+	}
+	if wantCode != exitOK {
+		return stderr.String()
+	}
+	return stdout.String()
+}
+
+// runJSON runs a command with -json, and decodes its output into result.
+func (s *session) runJSON(result any, args ...string) {
+	s.t.Helper()
+	out := s.run(exitOK, append(args, "-json")...)
+	if err := json.Unmarshal([]byte(out), result); err != nil {
+		s.t.Fatalf("dndgame %s output = %s, error = %v", strings.Join(args, " "), out, err)
+	}
+}
+
+// setUpTable creates game g1, directed by gm1, with player p1 playing character c1, and action a1.
+func (s *session) setUpTable() {
+	s.t.Helper()
+	s.run(exitOK, "game", "create", "-id", "g1", "-name", "The Sunless Citadel")
+	s.run(exitOK, "game", "master", "-id", "g1", "-gm", "gm1", "-name", "Dana")
+	s.run(exitOK, "game", "status", "-id", "g1", "-status", "Lobby")
+	s.run(exitOK, "player", "create", "-id", "p1", "-name", "Alex")
+	s.run(exitOK, "game", "join", "-id", "g1", "-player", "p1")
+	s.run(exitOK, "character", "create", "-player", "p1", "-game", "g1", "-id", "c1", "-name", "Merric",
+		"-class", "Warrior", "-race", "Dwarf", "-method", "StandardArray", "-attributes", "15,14,13,12,10,8")
+	s.run(exitOK, "game", "start", "-id", "g1")
+	s.run(exitOK, "action", "create", "-id", "a1", "-name", "Climb", "-cost", "10", "-reward", "20", "-penalty", "5")
+}
+
+func TestTableSessionLocal(t *testing.T) {
+	s := &session{t: t, global: []string{"-data", t.TempDir()}}
+	s.setUpTable()
+
+	if out := s.run(exitOK, "action", "list"); !strings.Contains(out, "Climb") || !strings.HasPrefix(out, "ID") {
+		t.Errorf("action list = %q, want a table of action a1", out)
+	}
+	var ai httpapi.ActionInstance
+	s.runJSON(&ai, "action", "propose", "-game", "g1", "-character", "c1", "-action", "a1")
+	if ai.State != "Proposed" {
+		t.Fatalf("State = %q, want Proposed", ai.State)
+	}
+
+	if out := s.run(exitError, "action", "approve", "-game", "g1", "-gm", "gm1", "-instance", ai.ID, "-cost", "8"); !strings.Contains(out, "justification") {
+		t.Errorf("stderr = %q, want the justification required", out)
+	}
+	s.runJSON(&ai, "action", "approve", "-game", "g1", "-gm", "gm1", "-instance", ai.ID, "-cost", "8", "-justification", "slick with rain")
+	if ai.State != "Approved" || ai.CustomXPCost != 8 || ai.RewardXP != 20 {
+		t.Errorf("instance = %+v, want Approved with cost 8 and the reward kept", ai)
+	}
+
+	var before, after httpapi.Character
+	s.runJSON(&before, "character", "show", "-id", "c1")
+	s.runJSON(&ai, "action", "execute", "-game", "g1", "-character", "c1", "-instance", ai.ID)
+	outcomeXP, ok := map[string]int{"Success": ai.RewardXP, "Partial": ai.RewardXP / 2, "Failure": -ai.PenaltyXP}[ai.Outcome]
+	if ai.State != "Resolved" || !ok {
+		t.Fatalf("instance = %+v, want Resolved with a rolled outcome", ai)
+	}
+	s.runJSON(&after, "character", "show", "-id", "c1")
+	if want := before.XP - 8 + outcomeXP; after.XP != want {
+		t.Errorf("XP = %d, want %d after a %s", after.XP, want, ai.Outcome)
+	}
+}
+
+func TestTableSessionRemote(t *testing.T) {
+	a := app.NewInMemory(clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+	a.Dice = dice.NewSeededRoller(42)
+	t.Cleanup(a.Close)
+	server := httptest.NewServer(httpapi.NewServer(a, log.New(io.Discard, "", 0)))
+	t.Cleanup(server.Close)
+	s := &session{t: t, global: []string{"-api", server.URL}}
+	s.setUpTable()
+
+	var created httpapi.CreatedCharacter
+	s.run(exitOK, "game", "late-characters", "-id", "g1")
+	s.runJSON(&created, "character", "create", "-player", "p1", "-game", "g1", "-id", "c2", "-name", "Lyra",
+		"-class", "Wizard", "-race", "Elf", "-method", "Roll4d6DropLowest")
+	if len(created.Rolls) != 6 {
+		t.Errorf("Rolls = %d, want 6", len(created.Rolls))
+	}
+	g, err := a.Games.GetGame("g1")
+	if err != nil {
+		t.Fatalf("GetGame() error = %v, wantErr nil", err)
+	}
+	if g.GameMaster.ID != "gm1" {
+		t.Errorf("GameMaster = %q, want gm1", g.GameMaster.ID)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	s := &session{t: t, global: []string{"-data", t.TempDir()}}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		want     string
+	}{
+		{"unknown command", []string{"dragons"}, exitUsage, "unknown command"},
+		{"missing flag", []string{"game", "show"}, exitUsage, "-id required"},
+		{"unknown flag", []string{"game", "show", "-level", "3"}, exitUsage, "not defined"},
+		{"invalid attributes", []string{"character", "create", "-player", "p1", "-game", "g1", "-id", "c1", "-name", "Lyra",
+			"-class", "Wizard", "-race", "Elf", "-attributes", "15,14"}, exitUsage, "want 6 values"},
+		{"chosen outcome", []string{"action", "execute", "-game", "g1", "-character", "c1", "-instance", "i1",
+			"-outcome", "Success"}, exitUsage, "not defined"},
+		{"failed request", []string{"game", "show", "-id", "nope"}, exitError, "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &session{t: t, global: s.global}
+			if out := s.run(tt.wantCode, tt.args...); !strings.Contains(out, tt.want) {
+				t.Errorf("stderr = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestShell(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	input := "player create -id p1 -name 'Alex Doe'\n\nplayer show -id p1 -json\nplayer show\nexit\nplayer show -id p1\n"
+	if code := run([]string{"-data", t.TempDir(), "shell"}, strings.NewReader(input), &stdout, &stderr); code != exitOK {
+		t.Fatalf("exit code = %d, want %d, stderr %s", code, exitOK, stderr.String())
+	}
+	if got := strings.Count(stdout.String(), "Alex Doe"); got != 2 {
+		t.Errorf("stdout = %q, want the player twice before the exit", stdout.String())
+	}
+	if !strings.Contains(stdout.String(), `"name": "Alex Doe"`) {
+		t.Errorf("stdout = %q, want the player as JSON", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "-id required") {
+		t.Errorf("stderr = %q, want the usage error of the command", stderr.String())
+	}
+}
+
+func TestSplitLine(t *testing.T) {
+	words, err := splitLine(`game create -id g1 -name "The Sunless Citadel" -x ''`)
+	if err != nil {
+		t.Fatalf("splitLine() error = %v, wantErr nil", err)
+	}
+	want := []string{"game", "create", "-id", "g1", "-name", "The Sunless Citadel", "-x", ""}
+	if strings.Join(words, "|") != strings.Join(want, "|") {
+		t.Errorf("splitLine() = %q, want %q", words, want)
+	}
+	if _, err := splitLine(`-name "open`); err == nil {
+		t.Error("splitLine() error = nil, want the unterminated quote")
+	}
+}
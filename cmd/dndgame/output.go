@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jerberlin/dndgame/internal/httpapi"
+)
+
+// printer writes the results of the commands as tables, or as the JSON of the API with -json.
+type printer struct {
+	out  io.Writer
+	json bool
+}
+
+// table writes rows aligned in columns under a header.
+type table struct {
+	w *tabwriter.Writer
+}
+
+func newTable(out io.Writer, header ...string) *table {
+	t := &table{w: tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)}
+	if len(header) > 0 {
+		t.row(header...)
+	}
+	return t
+}
+
+func (t *table) row(columns ...string) {
+	fmt.Fprintln(t.w, strings.Join(columns, "\t"))
+}
+
+func (t *table) flush() {
+	t.w.Flush()
+}
+
+// print writes v as indented JSON with -json, and with write otherwise.
+func (p *printer) print(v any, write func(out io.Writer)) error {
+	if p.json {
+		enc := json.NewEncoder(p.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	write(p.out)
+	return nil
+}
+
+func itoa(n int) string {
+	return strconv.Itoa(n)
+}
+
+func ints(values []int) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = itoa(v)
+	}
+	return strings.Join(s, " ")
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (p *printer) game(g httpapi.Game) error {
+	return p.print(g, func(out io.Writer) {
+		t := newTable(out)
+		t.row("ID", g.ID)
+		t.row("NAME", g.Name)
+		t.row("STATUS", g.Status)
+		if g.StartTime != nil && g.EndTime != nil {
+			t.row("SCHEDULE", g.StartTime.Format("2006-01-02 15:04")+" - "+g.EndTime.Format("2006-01-02 15:04"))
+		}
+		gm := "none"
+		if g.GameMaster != nil {
+			gm = g.GameMaster.Name + " (" + g.GameMaster.ID + ")"
+		}
+		t.row("GAME MASTER", gm)
+		t.row("PLAYERS", orNone(strings.Join(g.PlayerIDs, ", ")))
+		t.row("LATE CHARACTERS", strconv.FormatBool(g.AllowLateCharacters))
+		t.flush()
+	})
+}
+
+func (p *printer) player(pl httpapi.Player) error {
+	return p.print(pl, func(out io.Writer) {
+		t := newTable(out)
+		t.row("ID", pl.ID)
+		t.row("NAME", pl.Name)
+		t.row("STATUS", pl.Status)
+		t.row("CHARACTERS", orNone(strings.Join(pl.CharacterIDs, ", ")))
+		t.flush()
+	})
+}
+
+func (p *printer) characters(chars []httpapi.Character) error {
+	return p.print(chars, func(out io.Writer) {
+		t := newTable(out, "ID", "NAME", "CLASS", "RACE", "STR", "DEX", "CON", "INT", "WIS", "CHA", "XP", "STATUS")
+		for _, c := range chars {
+			a := c.Attributes
+			t.row(c.ID, c.Name, c.Class, c.Race, itoa(a.Strength), itoa(a.Dexterity), itoa(a.Constitution),
+				itoa(a.Intelligence), itoa(a.Wisdom), itoa(a.Charisma), itoa(c.XP), c.Status)
+		}
+		t.flush()
+	})
+}
+
+// createdCharacter writes a new character, followed by the dice rolled for its attributes if they were rolled.
+func (p *printer) createdCharacter(created httpapi.CreatedCharacter) error {
+	return p.print(created, func(out io.Writer) {
+		(&printer{out: out}).characters([]httpapi.Character{created.Character})
+		if len(created.Rolls) == 0 {
+			return
+		}
+		fmt.Fprintln(out)
+		t := newTable(out, "ATTRIBUTE", "ROLL", "DICE", "KEPT", "TOTAL")
+		for i, r := range created.Rolls {
+			name := "-"
+			if i < len(attributeNames) {
+				name = attributeNames[i]
+			}
+			t.row(name, r.Notation, ints(r.Dice), ints(r.Kept), itoa(r.Total))
+		}
+		t.flush()
+	})
+}
+
+func (p *printer) actions(actions []httpapi.Action) error {
+	return p.print(actions, func(out io.Writer) {
+		t := newTable(out, "ID", "NAME", "COST", "REWARD", "PENALTY")
+		for _, a := range actions {
+			t.row(a.ID, a.Name, itoa(a.BaseXPCost), itoa(a.RewardXP), itoa(a.PenaltyXP))
+		}
+		t.flush()
+	})
+}
+
+func (p *printer) instances(instances []httpapi.ActionInstance) error {
+	return p.print(instances, func(out io.Writer) {
+		t := newTable(out, "ID", "GAME", "CHARACTER", "ACTION", "COST", "REWARD", "PENALTY", "STATE", "OUTCOME")
+		for _, ai := range instances {
+			t.row(ai.ID, ai.GameID, ai.CharacterID, ai.Action.ID, itoa(ai.CustomXPCost), itoa(ai.RewardXP),
+				itoa(ai.PenaltyXP), ai.State, orNone(ai.Outcome))
+		}
+		t.flush()
+	})
+}
+
+// instance writes a single action instance, as an object with -json.
+func (p *printer) instance(ai httpapi.ActionInstance) error {
+	if p.json {
+		return p.print(ai, nil)
+	}
+	return p.instances([]httpapi.ActionInstance{ai})
+}
+
+// character writes a single character, as an object with -json.
+func (p *printer) character(c httpapi.Character) error {
+	if p.json {
+		return p.print(c, nil)
+	}
+	return p.characters([]httpapi.Character{c})
+}
@@ -0,0 +1,123 @@
+// Command dndserver serves the game as a JSON REST API and as a gRPC API on in-memory repositories, see
+// packages httpapi and grpcapi. The games are lost when the server stops.
+//
+// Usage:
+//
+//	dndserver [-addr :8080] [-grpc-addr :9090] [-shutdown-timeout 10s]
+//
+// An empty -grpc-addr serves the REST API only. On SIGINT or SIGTERM the server stops accepting connections,
+// closes the live tables and the event streams, waits for the requests in flight and the commands already sent,
+// and exits.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/grpcapi"
+	"github.com/jerberlin/dndgame/internal/httpapi"
+)
+
+// scheduleInterval is how often the status of the scheduled games is brought up to date.
+const scheduleInterval = 30 * time.Second
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	grpcAddr := flag.String("grpc-addr", ":9090", "address to serve the gRPC API on, none if empty")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 10*time.Second, "time the requests in flight have to finish on shutdown")
+	flag.Parse()
+
+	logger := log.New(os.Stderr, "dndserver: ", log.LstdFlags)
+	if err := run(*addr, *grpcAddr, *shutdownTimeout, logger); err != nil {
+		logger.Fatal(err)
+	}
+}
+
+func run(addr, grpcAddr string, shutdownTimeout time.Duration, logger *log.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	a := app.NewInMemory(clock.Real{})
+	defer a.Close()
+	go updateSchedules(ctx, a, logger)
+
+	api := httpapi.NewServer(a, logger)
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           api,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+		ErrorLog:          logger,
+	}
+	server.RegisterOnShutdown(api.Shutdown)
+	serveErr := make(chan error, 2)
+	go func() {
+		logger.Printf("listening on %s", addr)
+		serveErr <- server.ListenAndServe()
+	}()
+
+	grpcDone := make(chan struct{})
+	grpcServer := grpcapi.NewServer(a, logger, grpcDone)
+	if grpcAddr != "" {
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			return err
+		}
+		go func() {
+			logger.Printf("serving gRPC on %s", grpcAddr)
+			if err := grpcServer.Serve(lis); err != nil {
+				serveErr <- err
+			}
+		}()
+	}
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+	logger.Printf("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	close(grpcDone)
+	go func() {
+		<-shutdownCtx.Done()
+		grpcServer.Stop() // cuts the calls still in flight, if any, and returns at once after GracefulStop
+	}()
+	grpcServer.GracefulStop()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// updateSchedules brings the status of the scheduled games up to date until ctx is done.
+func updateSchedules(ctx context.Context, a *app.App, logger *log.Logger) {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := a.Games.UpdateSchedules(); err != nil {
+				logger.Printf("updating the schedules: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
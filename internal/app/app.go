@@ -0,0 +1,86 @@
+// Package app wires the services of the game on a set of repositories, for the commands serving or
+// running games.
+package app
+
+import (
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/event"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+	"github.com/jerberlin/dndgame/internal/service/command"
+	servgame "github.com/jerberlin/dndgame/internal/service/game"
+	servgamemaster "github.com/jerberlin/dndgame/internal/service/gamemaster"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
+	servxp "github.com/jerberlin/dndgame/internal/service/xp"
+)
+
+const (
+	commandBuffer      = 16               // commands of a game waiting for its loop
+	commandIdleTimeout = 10 * time.Minute // the loop of a game stops after this long without commands
+	journalRetention   = 1000             // events of each game retained for the clients catching up
+)
+
+// App is the set of services of the game, sharing their repositories, clock and event bus.
+type App struct {
+	Clock        clock.Clock
+	Dice         *dice.Roller // rolls the attributes of new characters and the outcomes of the actions executed
+	Events       *event.Bus
+	Journal      *event.Journal // the last events of every game published on Events
+	Repositories uow.Repositories
+	Games        servgame.GameService
+	Players      servplayer.PlayerService
+	GameMasters  servgamemaster.GameMasterService
+	XP           servxp.XPService
+	Commands     *command.Processor // serializes the mutations of each game, see command.Processor
+}
+
+// New wires the services on the given repositories, whose writes are grouped by units.
+func New(r uow.Repositories, units uow.UnitOfWork, clk clock.Clock) *App {
+	bus := event.NewBus(clk)
+	journal := event.NewJournal(journalRetention)
+	bus.Subscribe(journal.Record)
+	xpService := servxp.NewXPService(r.XP, r.Characters, units, bus)
+	playerService := servplayer.NewPlayerService(r.Players, r.Characters, r.Actions, r.Games, units, clk, bus)
+	gameService := servgame.NewGameService(r.Games, r.Actions, playerService, units, clk, bus)
+	gmService := servgamemaster.NewGameMasterService(r.Actions, r.Characters, r.Games, r.GameMasters, r.Players, gameService, playerService, units, clk, bus)
+	services := command.Services{Actions: r.Actions, Games: gameService, Players: playerService, GameMasters: gmService}
+	return &App{
+		Clock:        clk,
+		Dice:         dice.NewSeededRoller(time.Now().UnixNano()),
+		Events:       bus,
+		Journal:      journal,
+		Repositories: r,
+		Games:        gameService,
+		Players:      playerService,
+		GameMasters:  gmService,
+		XP:           xpService,
+		Commands:     command.NewProcessor(services, commandBuffer, commandIdleTimeout),
+	}
+}
+
+// NewInMemory wires the services on empty in-memory repositories, which are lost when the process ends.
+func NewInMemory(clk clock.Clock) *App {
+	r := uow.Repositories{
+		Games:       repogame.NewInMemoryGameRepository(),
+		Players:     repoplayer.NewInMemoryPlayerRepository(),
+		Characters:  repocharacter.NewInMemoryCharacterRepository(),
+		GameMasters: repogamemaster.NewInMemoryGameMasterRepository(),
+		Actions:     repoaction.NewInMemoryActionRepository(),
+		XP:          repoxp.NewInMemoryXPRepository(),
+	}
+	units := uow.NewInMemory(r)
+	return New(units.Repositories(), units, clk)
+}
+
+// Close waits until the commands already sent are applied. The services must not be used afterwards.
+func (a *App) Close() {
+	a.Commands.Close()
+}
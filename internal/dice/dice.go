@@ -0,0 +1,244 @@
+// Package dice parses and rolls dice in the standard tabletop notation, f.ex. 3d6, 4d6kh3, 1d20+5 or 1d20adv.
+package dice
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	maxDice       = 100  // dice rolled in a single expression, not counting explosions
+	maxSides      = 1000 // sides of a single die
+	maxExplosions = 100  // extra dice a single die may add by exploding
+)
+
+// KeepMode defines which dice of a roll count towards the total.
+type KeepMode int
+
+const (
+	KeepAll     KeepMode = iota // every die counts
+	KeepHighest                 // only the highest Keep dice count (kh, and advantage)
+	KeepLowest                  // only the lowest Keep dice count (kl, and disadvantage)
+)
+
+// Expression is a parsed dice notation.
+type Expression struct {
+	Count     int      // number of dice rolled
+	Sides     int      // sides of each die
+	Exploding bool     // a die showing its maximum is rolled again and added
+	Mode      KeepMode // which dice are kept
+	Keep      int      // number of dice kept when Mode is not KeepAll
+	Modifier  int      // constant added to the total
+	Notation  string   // the normalised notation the expression was parsed from
+}
+
+// Die is the result of a single die.
+type Die struct {
+	Value    int
+	Kept     bool
+	Exploded bool // rolled because the previous die exploded
+}
+
+// Result is the outcome of rolling an expression.
+type Result struct {
+	Expression Expression
+	Dice       []Die
+	Total      int
+}
+
+var notation = regexp.MustCompile(`^(\d*)d(\d+)(!)?(?:(kh|kl|dh|dl)(\d+)|(adv|dis))?([+-]\d+)?$`)
+
+// Parse parses a dice notation of the form NdS, optionally followed by "!" for exploding dice,
+// by a keep or drop rule (khN, klN, dhN, dlN) or by "adv"/"dis" for a single die, and by a +K or -K modifier.
+func Parse(s string) (Expression, error) {
+	normalised := strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	m := notation.FindStringSubmatch(normalised)
+	if m == nil {
+		return Expression{}, fmt.Errorf("invalid dice notation %q", s)
+	}
+
+	e := Expression{Count: 1, Mode: KeepAll, Notation: normalised}
+	var err error
+	if m[1] != "" {
+		if e.Count, err = strconv.Atoi(m[1]); err != nil {
+			return Expression{}, fmt.Errorf("invalid dice count in %q", s)
+		}
+	}
+	if e.Sides, err = strconv.Atoi(m[2]); err != nil {
+		return Expression{}, fmt.Errorf("invalid dice sides in %q", s)
+	}
+	if e.Count < 1 || e.Count > maxDice {
+		return Expression{}, fmt.Errorf("dice count must be between 1 and %d in %q", maxDice, s)
+	}
+	if e.Sides < 1 || e.Sides > maxSides {
+		return Expression{}, fmt.Errorf("dice sides must be between 1 and %d in %q", maxSides, s)
+	}
+	e.Exploding = m[3] == "!"
+	if e.Exploding && e.Sides == 1 {
+		return Expression{}, fmt.Errorf("a one-sided die cannot explode in %q", s)
+	}
+
+	if m[4] != "" {
+		n, err := strconv.Atoi(m[5])
+		if err != nil {
+			return Expression{}, fmt.Errorf("invalid keep or drop count in %q", s)
+		}
+		switch m[4] {
+		case "kh":
+			e.Mode, e.Keep = KeepHighest, n
+		case "kl":
+			e.Mode, e.Keep = KeepLowest, n
+		case "dh":
+			e.Mode, e.Keep = KeepLowest, e.Count-n
+		case "dl":
+			e.Mode, e.Keep = KeepHighest, e.Count-n
+		}
+		if e.Keep < 1 || e.Keep > e.Count {
+			return Expression{}, fmt.Errorf("must keep between 1 and %d dice in %q", e.Count, s)
+		}
+	}
+	if m[6] != "" {
+		if e.Count != 1 {
+			return Expression{}, fmt.Errorf("advantage and disadvantage apply to a single die in %q", s)
+		}
+		e.Count, e.Keep = 2, 1
+		e.Mode = KeepHighest
+		if m[6] == "dis" {
+			e.Mode = KeepLowest
+		}
+	}
+	if e.Exploding && e.Mode != KeepAll {
+		return Expression{}, fmt.Errorf("exploding dice cannot be combined with keep or drop rules in %q", s)
+	}
+
+	if m[7] != "" {
+		if e.Modifier, err = strconv.Atoi(m[7]); err != nil {
+			return Expression{}, fmt.Errorf("invalid modifier in %q", s)
+		}
+	}
+	return e, nil
+}
+
+// Source is the random number generator used to roll dice. *rand.Rand satisfies it.
+type Source interface {
+	Intn(n int) int
+}
+
+// Roller rolls dice expressions with an injectable source of randomness. It is safe for concurrent use.
+type Roller struct {
+	src   Source
+	mutex sync.Mutex
+}
+
+// NewRoller creates a roller drawing from the given source.
+func NewRoller(src Source) *Roller {
+	return &Roller{src: src}
+}
+
+// NewSeededRoller creates a roller whose rolls can be replayed by using the same seed.
+func NewSeededRoller(seed int64) *Roller {
+	return NewRoller(rand.New(rand.NewSource(seed)))
+}
+
+// Roll parses the notation and rolls it.
+func (r *Roller) Roll(s string) (Result, error) {
+	e, err := Parse(s)
+	if err != nil {
+		return Result{}, err
+	}
+	return r.RollExpression(e), nil
+}
+
+// MustRoll is like Roll but panics on an invalid notation. It is meant for notations known at compile time.
+func (r *Roller) MustRoll(s string) Result {
+	res, err := r.Roll(s)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// RollExpression rolls a parsed expression.
+func (r *Roller) RollExpression(e Expression) Result {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	res := Result{Expression: e, Dice: make([]Die, 0, e.Count)}
+	for i := 0; i < e.Count; i++ {
+		value := r.rollDie(e.Sides)
+		res.Dice = append(res.Dice, Die{Value: value, Kept: true})
+		for explosions := 0; e.Exploding && value == e.Sides && explosions < maxExplosions; explosions++ {
+			value = r.rollDie(e.Sides)
+			res.Dice = append(res.Dice, Die{Value: value, Kept: true, Exploded: true})
+		}
+	}
+	if e.Mode != KeepAll {
+		markKept(res.Dice, e.Mode, e.Keep)
+	}
+
+	res.Total = e.Modifier
+	for _, d := range res.Dice {
+		if d.Kept {
+			res.Total += d.Value
+		}
+	}
+	return res
+}
+
+func (r *Roller) rollDie(sides int) int {
+	return r.src.Intn(sides) + 1
+}
+
+// markKept flags the highest or lowest keep dice as kept and the rest as dropped.
+// Between dice of equal value the earlier one is kept.
+func markKept(dice []Die, mode KeepMode, keep int) {
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if mode == KeepHighest {
+			return dice[order[a]].Value > dice[order[b]].Value
+		}
+		return dice[order[a]].Value < dice[order[b]].Value
+	})
+	for rank, i := range order {
+		dice[i].Kept = rank < keep
+	}
+}
+
+// Kept returns the values of the dice counting towards the total, in roll order.
+func (res Result) Kept() []int {
+	values := make([]int, 0, len(res.Dice))
+	for _, d := range res.Dice {
+		if d.Kept {
+			values = append(values, d.Value)
+		}
+	}
+	return values
+}
+
+// String shows each die, with dropped dice in parentheses and exploded dice marked with "!", and the total.
+func (res Result) String() string {
+	parts := make([]string, 0, len(res.Dice))
+	for _, d := range res.Dice {
+		v := strconv.Itoa(d.Value)
+		if d.Exploded {
+			v += "!"
+		}
+		if !d.Kept {
+			v = "(" + v + ")"
+		}
+		parts = append(parts, v)
+	}
+	s := fmt.Sprintf("%s: [%s]", res.Expression.Notation, strings.Join(parts, " "))
+	if res.Expression.Modifier != 0 {
+		s += fmt.Sprintf(" %+d", res.Expression.Modifier)
+	}
+	return fmt.Sprintf("%s = %d", s, res.Total)
+}
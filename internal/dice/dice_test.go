@@ -0,0 +1,103 @@
+package dice
+
+import (
+	"reflect"
+	"testing"
+)
+
+// scripted is a Source returning predefined die faces (1-based) in order.
+type scripted struct {
+	faces []int
+}
+
+func (s *scripted) Intn(n int) int {
+	face := s.faces[0]
+	s.faces = s.faces[1:]
+	return face - 1
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		notation string
+		want     Expression
+	}{
+		{"3d6", Expression{Count: 3, Sides: 6, Mode: KeepAll, Notation: "3d6"}},
+		{"d20", Expression{Count: 1, Sides: 20, Mode: KeepAll, Notation: "d20"}},
+		{"4d6kh3", Expression{Count: 4, Sides: 6, Mode: KeepHighest, Keep: 3, Notation: "4d6kh3"}},
+		{"4d6dl1", Expression{Count: 4, Sides: 6, Mode: KeepHighest, Keep: 3, Notation: "4d6dl1"}},
+		{"3d6kl1", Expression{Count: 3, Sides: 6, Mode: KeepLowest, Keep: 1, Notation: "3d6kl1"}},
+		{"1d20 + 5", Expression{Count: 1, Sides: 20, Mode: KeepAll, Modifier: 5, Notation: "1d20+5"}},
+		{"2d8-1", Expression{Count: 2, Sides: 8, Mode: KeepAll, Modifier: -1, Notation: "2d8-1"}},
+		{"3d6!", Expression{Count: 3, Sides: 6, Exploding: true, Mode: KeepAll, Notation: "3d6!"}},
+		{"1d20adv", Expression{Count: 2, Sides: 20, Mode: KeepHighest, Keep: 1, Notation: "1d20adv"}},
+		{"D20DIS+2", Expression{Count: 2, Sides: 20, Mode: KeepLowest, Keep: 1, Modifier: 2, Notation: "d20dis+2"}},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.notation)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.notation, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.notation, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, notation := range []string{"", "d", "3x6", "0d6", "3d0", "101d6", "4d6kh5", "4d6dl4", "2d20adv", "1d1!", "4d6!kh3", "3d6+", "3d6+1+1"} {
+		if _, err := Parse(notation); err == nil {
+			t.Errorf("Parse(%q) expected error, got nil", notation)
+		}
+	}
+}
+
+func TestRollKeepHighest(t *testing.T) {
+	roller := NewRoller(&scripted{faces: []int{3, 6, 1, 5}})
+	res, err := roller.Roll("4d6kh3")
+	if err != nil {
+		t.Fatalf("Roll() error = %v", err)
+	}
+
+	want := []Die{{Value: 3, Kept: true}, {Value: 6, Kept: true}, {Value: 1, Kept: false}, {Value: 5, Kept: true}}
+	if !reflect.DeepEqual(res.Dice, want) || res.Total != 14 {
+		t.Errorf("Roll(4d6kh3) = %+v, want dice %+v and total 14", res, want)
+	}
+	if got := res.String(); got != "4d6kh3: [3 6 (1) 5] = 14" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestRollAdvantageAndDisadvantage(t *testing.T) {
+	roller := NewRoller(&scripted{faces: []int{7, 15, 7, 15}})
+	if res := roller.MustRoll("1d20adv+1"); res.Total != 16 || !reflect.DeepEqual(res.Kept(), []int{15}) {
+		t.Errorf("Roll(1d20adv+1) = %+v, want total 16", res)
+	}
+	if res := roller.MustRoll("1d20dis"); res.Total != 7 || !reflect.DeepEqual(res.Kept(), []int{7}) {
+		t.Errorf("Roll(1d20dis) = %+v, want total 7", res)
+	}
+}
+
+func TestRollExploding(t *testing.T) {
+	roller := NewRoller(&scripted{faces: []int{6, 6, 2, 4}})
+	res := roller.MustRoll("2d6!-1")
+
+	want := []Die{{Value: 6, Kept: true}, {Value: 6, Kept: true, Exploded: true}, {Value: 2, Kept: true, Exploded: true}, {Value: 4, Kept: true}}
+	if !reflect.DeepEqual(res.Dice, want) || res.Total != 17 {
+		t.Errorf("Roll(2d6!-1) = %+v, want dice %+v and total 17", res, want)
+	}
+}
+
+func TestSeededRollerReplays(t *testing.T) {
+	first := NewSeededRoller(42)
+	second := NewSeededRoller(42)
+	for i := 0; i < 20; i++ {
+		a, b := first.MustRoll("4d6kh3"), second.MustRoll("4d6kh3")
+		if !reflect.DeepEqual(a, b) {
+			t.Fatalf("seeded rollers diverged at roll %d: %v vs %v", i, a, b)
+		}
+		if a.Total < 3 || a.Total > 18 {
+			t.Errorf("4d6kh3 total out of range: %v", a)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Package errs defines the errors shared by the repositories, the models and the services, so that callers
+// such as an HTTP layer can tell them apart with errors.Is and errors.As, whatever wraps them.
+//
+// Each sentinel names a category of failure. The errors about an entity are EntityErrors, which match their
+// sentinel with errors.Is and carry the kind and the ID of the entity. The errors of the models with more
+// context, such as game.InvalidStatusTransitionError, match a sentinel too.
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrNotFound          = errors.New("not found")          // the entity does not exist
+	ErrAlreadyExists     = errors.New("already exists")     // an entity with the same ID exists
+	ErrInvalidTransition = errors.New("invalid transition") // the lifecycle of the entity does not allow the change
+	ErrInsufficientXP    = errors.New("insufficient XP")    // the character cannot afford the XP
+	ErrForbidden         = errors.New("forbidden")          // the actor is not allowed to do it
+	ErrConflict          = errors.New("conflict")           // the change conflicts with the current state
+	ErrInvalid           = errors.New("invalid")            // the input breaks a rule, whatever the current state
+)
+
+// Kinds of entities named by the errors.
+const (
+	Game           = "game"
+	Player         = "player"
+	Character      = "character"
+	GameMaster     = "game master"
+	Action         = "action"
+	ActionInstance = "action instance"
+	Adventure      = "adventure"
+	Mission        = "mission"
+	Objective      = "objective"
+)
+
+// EntityError is an error about an entity. It matches its sentinel Err with errors.Is.
+type EntityError struct {
+	Err    error  // one of the sentinels
+	Kind   string // the kind of the entity, e.g. Game
+	ID     string
+	Reason string // what went wrong, the text of the sentinel if empty
+}
+
+func (e *EntityError) Error() string {
+	reason := e.Reason
+	if reason == "" {
+		reason = e.Err.Error()
+	}
+	parts := make([]string, 0, 3)
+	for _, part := range []string{e.Kind, e.ID, reason} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (e *EntityError) Unwrap() error {
+	return e.Err
+}
+
+// NotFound returns the error of an entity that does not exist, e.g. "game g1 not found".
+func NotFound(kind, id string) error {
+	return &EntityError{Err: ErrNotFound, Kind: kind, ID: id}
+}
+
+// AlreadyExists returns the error of an entity created with the ID of an existing one, e.g. "game g1 already exists".
+func AlreadyExists(kind, id string) error {
+	return &EntityError{Err: ErrAlreadyExists, Kind: kind, ID: id}
+}
+
+// Newf returns an EntityError matching err, whose reason follows the entity in the message,
+// e.g. Newf(ErrForbidden, GameMaster, "gm1", "does not direct game %s", "g1").
+func Newf(err error, kind, id, format string, args ...any) error {
+	return &EntityError{Err: err, Kind: kind, ID: id, Reason: fmt.Sprintf(format, args...)}
+}
+
+// VersionConflict returns the error of an update of an outdated version of an entity, which was changed since
+// it was read, e.g. "game g1 has version 4, the update is based on version 3".
+func VersionConflict(kind, id string, stored, version int) error {
+	return Newf(ErrConflict, kind, id, "has version %d, the update is based on version %d", stored, version)
+}
+
+// InsufficientXPError is returned when a character cannot afford an XP cost. It matches ErrInsufficientXP.
+type InsufficientXPError struct {
+	CharacterID string
+	Required    int
+	Available   int
+}
+
+func (e *InsufficientXPError) Error() string {
+	return fmt.Sprintf("insufficient XP: character %s needs %d XP, has %d", e.CharacterID, e.Required, e.Available)
+}
+
+func (e *InsufficientXPError) Is(target error) bool {
+	return target == ErrInsufficientXP
+}
+
+// Invalidf returns an error matching ErrInvalid with the formatted message, which may wrap another error with %w,
+// e.g. Invalidf("game end time %v must be after its start time %v", end, start).
+func Invalidf(format string, args ...any) error {
+	return &invalidError{err: fmt.Errorf(format, args...)}
+}
+
+type invalidError struct {
+	err error
+}
+
+func (e *invalidError) Error() string {
+	return e.err.Error()
+}
+
+func (e *invalidError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+func (e *invalidError) Unwrap() error {
+	return errors.Unwrap(e.err)
+}
@@ -0,0 +1,69 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestEntityError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		want    error
+		message string
+	}{
+		{"NotFound", NotFound(Game, "g1"), ErrNotFound, "game g1 not found"},
+		{"AlreadyExists", AlreadyExists(GameMaster, "gm1"), ErrAlreadyExists, "game master gm1 already exists"},
+		{"Newf", Newf(ErrForbidden, GameMaster, "gm1", "does not direct game %s", "g1"), ErrForbidden, "game master gm1 does not direct game g1"},
+		{"WithoutID", &EntityError{Err: ErrConflict, Kind: Adventure}, ErrConflict, "adventure conflict"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.message {
+				t.Errorf("Error() got = %q, want %q", got, tt.message)
+			}
+			wrapped := fmt.Errorf("context: %w", tt.err)
+			if !errors.Is(wrapped, tt.want) {
+				t.Errorf("errors.Is(%v, %v) got = false, want true", wrapped, tt.want)
+			}
+			if errors.Is(wrapped, ErrInsufficientXP) {
+				t.Errorf("errors.Is(%v, ErrInsufficientXP) got = true, want false", wrapped)
+			}
+			var entityErr *EntityError
+			if !errors.As(wrapped, &entityErr) || entityErr != tt.err {
+				t.Errorf("errors.As(%v) got = %v, want %v", wrapped, entityErr, tt.err)
+			}
+		})
+	}
+}
+
+func TestInsufficientXPError(t *testing.T) {
+	err := fmt.Errorf("executing action: %w", &InsufficientXPError{CharacterID: "c1", Required: 50, Available: 20})
+	if want := "executing action: insufficient XP: character c1 needs 50 XP, has 20"; err.Error() != want {
+		t.Errorf("Error() got = %q, want %q", err.Error(), want)
+	}
+	if !errors.Is(err, ErrInsufficientXP) {
+		t.Errorf("errors.Is(%v, ErrInsufficientXP) got = false, want true", err)
+	}
+	var insufficient *InsufficientXPError
+	if !errors.As(err, &insufficient) || insufficient.Required != 50 || insufficient.Available != 20 {
+		t.Errorf("errors.As(%v) got = %+v", err, insufficient)
+	}
+}
+
+func TestInvalidf(t *testing.T) {
+	err := Invalidf("prerequisite of mission %s: %w", "m2", NotFound(Mission, "m1"))
+	if want := "prerequisite of mission m2: mission m1 not found"; err.Error() != want {
+		t.Errorf("Error() got = %q, want %q", err.Error(), want)
+	}
+	if !errors.Is(err, ErrInvalid) || !errors.Is(fmt.Errorf("context: %w", err), ErrInvalid) {
+		t.Errorf("errors.Is(%v, ErrInvalid) got = false, want true", err)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("errors.Is(%v, ErrNotFound) got = false, want the wrapped error to match", err)
+	}
+	if errors.Is(Invalidf("no reason"), ErrConflict) {
+		t.Errorf("errors.Is(Invalidf(), ErrConflict) got = true, want false")
+	}
+}
@@ -0,0 +1,186 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+)
+
+// Publisher is what the services need to publish domain events.
+type Publisher interface {
+	Publish(e Event)
+}
+
+// Handler handles a published event.
+type Handler func(env Envelope)
+
+// Bus is an in-process event bus. Events are delivered to the subscribers in the order of their sequence numbers:
+// synchronous handlers run in the goroutine of the publisher before Publish returns, async subscribers
+// receive the events through a buffer and handle them in their own goroutine.
+// Handlers must not publish on the bus they are subscribed to.
+type Bus struct {
+	clock       clock.Clock
+	publishing  sync.Mutex // serializes deliveries so every subscriber sees the events in sequence order
+	mutex       sync.RWMutex
+	sequence    uint64
+	nextID      int
+	subscribers []*subscriber // in order of subscription
+}
+
+// Ensure Bus implements Publisher at compile time.
+var _ Publisher = &Bus{}
+
+type subscriber struct {
+	id      int
+	kinds   map[Kind]bool // empty for all kinds
+	handler Handler
+	queue   chan Envelope // nil for synchronous handlers
+}
+
+func (s *subscriber) wants(k Kind) bool {
+	return len(s.kinds) == 0 || s.kinds[k]
+}
+
+// NewBus creates an event bus stamping the events with the time of the given clock.
+func NewBus(clk clock.Clock) *Bus {
+	return &Bus{
+		clock: clk,
+	}
+}
+
+// Publish assigns the next sequence number to the event and delivers it to the subscribers of its kind.
+// When the buffer of an async subscriber is full, Publish waits until the subscriber catches up.
+func (b *Bus) Publish(e Event) {
+	b.publishing.Lock()
+	defer b.publishing.Unlock()
+
+	b.mutex.Lock()
+	b.sequence++
+	env := Envelope{Sequence: b.sequence, Timestamp: b.clock.Now(), Event: e}
+	subscribers := make([]*subscriber, 0, len(b.subscribers))
+	for _, s := range b.subscribers {
+		if s.wants(e.Kind()) {
+			subscribers = append(subscribers, s)
+		}
+	}
+	b.mutex.Unlock()
+
+	for _, s := range subscribers {
+		if s.queue == nil {
+			s.handler(env)
+		} else {
+			s.queue <- env
+		}
+	}
+}
+
+// Subscribe registers a handler called synchronously for every event of the given kinds, or of all kinds if none is given.
+// The returned function unsubscribes the handler.
+func (b *Bus) Subscribe(handler Handler, kinds ...Kind) (unsubscribe func()) {
+	id := b.add(&subscriber{kinds: kindSet(kinds), handler: handler})
+	return func() { b.remove(id) }
+}
+
+// Subscription is an async subscriber of a bus.
+type Subscription struct {
+	bus  *Bus
+	id   int
+	sub  *subscriber
+	done chan struct{}
+	once sync.Once
+}
+
+// SubscribeAsync registers a handler called in its own goroutine for every event of the given kinds, or of all kinds
+// if none is given. Up to buffer events wait for the handler; beyond that the publishers are slowed down.
+func (b *Bus) SubscribeAsync(buffer int, handler Handler, kinds ...Kind) *Subscription {
+	sub := &subscriber{kinds: kindSet(kinds), handler: handler, queue: make(chan Envelope, buffer)}
+	s := &Subscription{bus: b, sub: sub, done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		for env := range sub.queue {
+			handler(env)
+		}
+	}()
+	s.id = b.add(sub)
+	return s
+}
+
+// Unsubscribe stops the delivery of new events and waits until the handler processed the events already buffered.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.bus.publishing.Lock()
+		s.bus.remove(s.id)
+		close(s.sub.queue)
+		s.bus.publishing.Unlock()
+	})
+	<-s.done
+}
+
+// Pending returns the number of events buffered and not handled yet.
+func (s *Subscription) Pending() int {
+	return len(s.sub.queue)
+}
+
+// Sequence returns the sequence number of the last event published on the bus.
+func (b *Bus) Sequence() uint64 {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.sequence
+}
+
+func (b *Bus) add(s *subscriber) int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	s.id = b.nextID
+	b.nextID++
+	b.subscribers = append(b.subscribers, s)
+	return s.id
+}
+
+func (b *Bus) remove(id int) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for i, s := range b.subscribers {
+		if s.id == id {
+			b.subscribers = append(b.subscribers[:i:i], b.subscribers[i+1:]...)
+			return
+		}
+	}
+}
+
+func kindSet(kinds []Kind) map[Kind]bool {
+	set := make(map[Kind]bool, len(kinds))
+	for _, k := range kinds {
+		set[k] = true
+	}
+	return set
+}
+
+// Discard is a Publisher dropping every event, for code that does not need to notify anyone.
+var Discard Publisher = discard{}
+
+type discard struct{}
+
+func (discard) Publish(Event) {}
+
+// Buffer is a Publisher holding the events back until they are flushed, e.g. until the changes they
+// announce are committed. It is not safe for concurrent use.
+type Buffer struct {
+	events []Event
+}
+
+// Ensure Buffer implements Publisher at compile time.
+var _ Publisher = &Buffer{}
+
+func (b *Buffer) Publish(e Event) {
+	b.events = append(b.events, e)
+}
+
+// Flush publishes the events held back to p in the order they were published, and empties the buffer.
+func (b *Buffer) Flush(p Publisher) {
+	events := b.events
+	b.events = nil
+	for _, e := range events {
+		p.Publish(e)
+	}
+}
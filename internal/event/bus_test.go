@@ -0,0 +1,94 @@
+package event
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	"reflect"
+)
+
+func newTestBus() *Bus {
+	return NewBus(clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)))
+}
+
+func TestBusSubscribe(t *testing.T) {
+	bus := newTestBus()
+	var all, joined []Envelope
+	bus.Subscribe(func(env Envelope) { all = append(all, env) })
+	unsubscribe := bus.Subscribe(func(env Envelope) { joined = append(joined, env) }, PlayerJoined)
+
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p1"})
+	bus.Publish(PlayerLeftEvent{GameID: "g1", PlayerID: "p1"})
+	unsubscribe()
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p2"})
+
+	if len(all) != 3 || all[0].Sequence != 1 || all[2].Sequence != 3 {
+		t.Errorf("Subscribe() without kinds should receive every event in order, got %+v", all)
+	}
+	if len(joined) != 1 || joined[0].Event != (PlayerJoinedEvent{GameID: "g1", PlayerID: "p1"}) {
+		t.Errorf("Subscribe() with kinds should only receive these kinds until unsubscribed, got %+v", joined)
+	}
+	if all[0].Event.Kind() != PlayerJoined || all[0].Event.Game() != "g1" {
+		t.Errorf("unexpected event %v in game %v", all[0].Event.Kind(), all[0].Event.Game())
+	}
+}
+
+func TestBusSubscribeAsync(t *testing.T) {
+	bus := newTestBus()
+	release := make(chan struct{})
+	var mutex sync.Mutex
+	var received []uint64
+	sub := bus.SubscribeAsync(2, func(env Envelope) {
+		<-release
+		mutex.Lock()
+		received = append(received, env.Sequence)
+		mutex.Unlock()
+	}, XPChanged)
+
+	// The handler holds the first event and the buffer takes the next two, so the fourth publish has to wait.
+	for i := 0; i < 3; i++ {
+		bus.Publish(XPChangedEvent{GameID: "g1", CharacterID: "c1", Amount: i})
+	}
+	published := make(chan struct{})
+	go func() {
+		bus.Publish(XPChangedEvent{GameID: "g1", CharacterID: "c1", Amount: 3})
+		close(published)
+	}()
+	select {
+	case <-published:
+		t.Fatalf("Publish() should block while the buffer of an async subscriber is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-published
+	sub.Unsubscribe()
+	bus.Publish(XPChangedEvent{GameID: "g1", CharacterID: "c1", Amount: 4})
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(received) != 4 || received[0] != 1 || received[3] != 4 {
+		t.Errorf("SubscribeAsync() should handle the buffered events in order, got %v", received)
+	}
+}
+
+func TestBuffer(t *testing.T) {
+	bus := newTestBus()
+	var published []Event
+	bus.Subscribe(func(env Envelope) { published = append(published, env.Event) })
+
+	var buffer Buffer
+	buffer.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p1"})
+	buffer.Publish(PlayerLeftEvent{GameID: "g1", PlayerID: "p1"})
+	if len(published) != 0 {
+		t.Errorf("Publish() on a buffer should hold the events back, got %+v", published)
+	}
+	buffer.Flush(bus)
+	buffer.Flush(bus)
+	want := []Event{PlayerJoinedEvent{GameID: "g1", PlayerID: "p1"}, PlayerLeftEvent{GameID: "g1", PlayerID: "p1"}}
+	if !reflect.DeepEqual(published, want) {
+		t.Errorf("Flush() published = %+v, want %+v once", published, want)
+	}
+}
@@ -0,0 +1,137 @@
+// Package event carries the domain events of the game from the services to whoever listens:
+// notifications, logging and projections.
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kind identifies the type of a domain event.
+type Kind int
+
+const (
+	ActionProposed    Kind = iota // a player proposed an action for a character
+	ActionApproved                // the game master approved an action instance
+	ActionRejected                // the game master rejected an action instance
+	ActionExecuted                // a player executed an approved action instance
+	XPChanged                     // the XP balance of a character changed
+	PlayerJoined                  // a player joined a game
+	PlayerLeft                    // a player left a game
+	GameStatusChanged             // a game moved to another status of its lifecycle
+	NPCAdded                      // the game master added a non-player character to a game
+)
+
+// Event is a domain event. Every event belongs to a game.
+type Event interface {
+	Kind() Kind
+	Game() string
+}
+
+// Envelope wraps an event published on a bus with its sequence number, unique and increasing per bus,
+// and the time it was published.
+type Envelope struct {
+	Sequence  uint64
+	Timestamp time.Time
+	Event     Event
+}
+
+// ActionProposedEvent is published when a player proposes an action for a character.
+type ActionProposedEvent struct {
+	GameID      string
+	CharacterID string
+	InstanceID  string
+	ActionID    string
+	XPCost      int
+}
+
+// ActionApprovedEvent is published when the game master approves an action instance, possibly modified.
+type ActionApprovedEvent struct {
+	GameID       string
+	GameMasterID string
+	CharacterID  string
+	InstanceID   string
+	XPCost       int
+	Modified     bool
+}
+
+// ActionRejectedEvent is published when the game master rejects an action instance.
+type ActionRejectedEvent struct {
+	GameID      string
+	CharacterID string
+	InstanceID  string
+}
+
+// ActionExecutedEvent is published when a player executes an approved action instance and its outcome is resolved.
+type ActionExecutedEvent struct {
+	GameID      string
+	CharacterID string
+	InstanceID  string
+	Outcome     string
+	XPChange    int // the net XP change of the execution, cost included
+}
+
+// XPChangedEvent is published for every transaction recorded in the XP ledger.
+type XPChangedEvent struct {
+	GameID      string
+	CharacterID string
+	ActorID     string
+	Type        string
+	Amount      int
+	Balance     int // the balance of the character after the change
+	Reason      string
+}
+
+// PlayerJoinedEvent is published when a player joins a game.
+type PlayerJoinedEvent struct {
+	GameID   string
+	PlayerID string
+}
+
+// PlayerLeftEvent is published when a player leaves a game.
+type PlayerLeftEvent struct {
+	GameID   string
+	PlayerID string
+}
+
+// GameStatusChangedEvent is published when a game moves to another status, explicitly or following its schedule.
+type GameStatusChangedEvent struct {
+	GameID string
+	From   string
+	To     string
+}
+
+// NPCAddedEvent is published when the game master adds a non-player character to a game.
+type NPCAddedEvent struct {
+	GameID      string
+	CharacterID string
+	Name        string
+}
+
+func (ActionProposedEvent) Kind() Kind        { return ActionProposed }
+func (ActionApprovedEvent) Kind() Kind        { return ActionApproved }
+func (ActionRejectedEvent) Kind() Kind        { return ActionRejected }
+func (ActionExecutedEvent) Kind() Kind        { return ActionExecuted }
+func (XPChangedEvent) Kind() Kind             { return XPChanged }
+func (PlayerJoinedEvent) Kind() Kind          { return PlayerJoined }
+func (PlayerLeftEvent) Kind() Kind            { return PlayerLeft }
+func (GameStatusChangedEvent) Kind() Kind     { return GameStatusChanged }
+func (NPCAddedEvent) Kind() Kind              { return NPCAdded }
+func (e ActionProposedEvent) Game() string    { return e.GameID }
+func (e ActionApprovedEvent) Game() string    { return e.GameID }
+func (e ActionRejectedEvent) Game() string    { return e.GameID }
+func (e ActionExecutedEvent) Game() string    { return e.GameID }
+func (e XPChangedEvent) Game() string         { return e.GameID }
+func (e PlayerJoinedEvent) Game() string      { return e.GameID }
+func (e PlayerLeftEvent) Game() string        { return e.GameID }
+func (e GameStatusChangedEvent) Game() string { return e.GameID }
+func (e NPCAddedEvent) Game() string          { return e.GameID }
+
+// String returns the string representation of the Kind.
+func (k Kind) String() string {
+	kindNames := [...]string{"ActionProposed", "ActionApproved", "ActionRejected", "ActionExecuted", "XPChanged", "PlayerJoined", "PlayerLeft", "GameStatusChanged", "NPCAdded"}
+	if k < 0 || int(k) >= len(kindNames) {
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+	return kindNames[k]
+}
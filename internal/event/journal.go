@@ -0,0 +1,123 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrExpired is returned when watching a game from a sequence number whose following events are no longer retained.
+var ErrExpired = errors.New("events no longer retained")
+
+// Journal retains the last events of every game published on a bus, so that clients can catch up on what they
+// missed, and feeds them to the watchers of the games. Record it with Bus.Subscribe.
+type Journal struct {
+	retain   int // events retained per game
+	mutex    sync.Mutex
+	sequence uint64 // of the last event recorded
+	games    map[string]*gameJournal
+}
+
+type gameJournal struct {
+	events   []Envelope // the last events of the game, in sequence order
+	dropped  uint64     // the sequence number of the last event no longer retained, 0 if none
+	watchers map[*Watcher]bool
+}
+
+// NewJournal creates a journal retaining the last retain events of every game.
+func NewJournal(retain int) *Journal {
+	return &Journal{retain: retain, games: make(map[string]*gameJournal)}
+}
+
+// Record retains an event and sends it to the watchers of its game. A watcher whose buffer is full is closed
+// as lagging rather than slowing down the publishers.
+func (j *Journal) Record(env Envelope) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.sequence = env.Sequence
+	g := j.game(env.Event.Game())
+	g.events = append(g.events, env)
+	if len(g.events) > j.retain {
+		g.dropped = g.events[0].Sequence
+		g.events = g.events[1:]
+	}
+	for w := range g.watchers {
+		select {
+		case w.c <- env:
+		default:
+			w.lagged = true
+			delete(g.watchers, w)
+			close(w.c)
+		}
+	}
+}
+
+func (j *Journal) game(gameID string) *gameJournal {
+	g, ok := j.games[gameID]
+	if !ok {
+		g = &gameJournal{watchers: make(map[*Watcher]bool)}
+		j.games[gameID] = g
+	}
+	return g
+}
+
+// Watcher receives the events of a game as they are recorded.
+type Watcher struct {
+	C       <-chan Envelope // closed when the watcher is closed or lagging
+	c       chan Envelope
+	journal *Journal
+	gameID  string
+	lagged  bool
+}
+
+// Watch returns the retained events of a game published after the given sequence number, and a watcher receiving
+// the following ones with up to buffer events waiting. It fails with ErrExpired if some of the events after
+// the sequence number are no longer retained, or if the sequence number is unknown.
+func (j *Journal) Watch(gameID string, after uint64, buffer int) ([]Envelope, *Watcher, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if after > j.sequence {
+		// The sequence number comes from another bus, e.g. before a restart.
+		return nil, nil, fmt.Errorf("sequence %d is ahead of the last event %d: %w", after, j.sequence, ErrExpired)
+	}
+	g := j.game(gameID)
+	if after < g.dropped {
+		return nil, nil, fmt.Errorf("events of game %s after %d: %w", gameID, after, ErrExpired)
+	}
+	var backlog []Envelope
+	for _, env := range g.events {
+		if env.Sequence > after {
+			backlog = append(backlog, env)
+		}
+	}
+	c := make(chan Envelope, buffer)
+	w := &Watcher{C: c, c: c, journal: j, gameID: gameID}
+	g.watchers[w] = true
+	return backlog, w, nil
+}
+
+// Sequence returns the sequence number of the last event recorded.
+func (j *Journal) Sequence() uint64 {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.sequence
+}
+
+// Close stops the delivery of events to the watcher and closes its channel, unless it was closed already.
+func (w *Watcher) Close() {
+	j := w.journal
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	g := j.games[w.gameID]
+	if g.watchers[w] {
+		delete(g.watchers, w)
+		close(w.c)
+	}
+}
+
+// Lagged reports whether the watcher was closed because its buffer was full. It is meaningful once C is closed.
+func (w *Watcher) Lagged() bool {
+	w.journal.mutex.Lock()
+	defer w.journal.mutex.Unlock()
+	return w.lagged
+}
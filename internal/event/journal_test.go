@@ -0,0 +1,103 @@
+package event
+
+import (
+	"errors"
+	"testing"
+)
+
+func newTestJournal(retain int) (*Bus, *Journal) {
+	bus := newTestBus()
+	journal := NewJournal(retain)
+	bus.Subscribe(journal.Record)
+	return bus, journal
+}
+
+func sequences(envs []Envelope) []uint64 {
+	result := make([]uint64, 0, len(envs))
+	for _, env := range envs {
+		result = append(result, env.Sequence)
+	}
+	return result
+}
+
+func TestJournalWatch(t *testing.T) {
+	bus, journal := newTestJournal(10)
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p1"})
+	bus.Publish(PlayerJoinedEvent{GameID: "g2", PlayerID: "p2"})
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p3"})
+
+	backlog, w, err := journal.Watch("g1", 1, 4)
+	if err != nil {
+		t.Fatalf("Watch() error = %v, wantErr nil", err)
+	}
+	defer w.Close()
+	if got := sequences(backlog); len(got) != 1 || got[0] != 3 {
+		t.Errorf("Watch() backlog = %v, want [3]", got)
+	}
+
+	bus.Publish(PlayerLeftEvent{GameID: "g2", PlayerID: "p2"})
+	bus.Publish(PlayerLeftEvent{GameID: "g1", PlayerID: "p1"})
+	env := <-w.C
+	if env.Sequence != 5 || env.Event != (PlayerLeftEvent{GameID: "g1", PlayerID: "p1"}) {
+		t.Errorf("watcher received %+v, want the event 5 of game g1", env)
+	}
+
+	w.Close()
+	w.Close()
+	if _, ok := <-w.C; ok {
+		t.Error("watcher channel is open after Close()")
+	}
+	if w.Lagged() {
+		t.Error("Lagged() = true after Close(), want false")
+	}
+	if journal.Sequence() != 5 {
+		t.Errorf("Sequence() = %d, want 5", journal.Sequence())
+	}
+}
+
+func TestJournalExpired(t *testing.T) {
+	bus, journal := newTestJournal(2)
+	for i := 0; i < 4; i++ {
+		bus.Publish(XPChangedEvent{GameID: "g1", CharacterID: "c1", Amount: i})
+	}
+
+	if _, _, err := journal.Watch("g1", 1, 1); !errors.Is(err, ErrExpired) {
+		t.Errorf("Watch() after a dropped event error = %v, want ErrExpired", err)
+	}
+	if _, _, err := journal.Watch("g1", 9, 1); !errors.Is(err, ErrExpired) {
+		t.Errorf("Watch() ahead of the journal error = %v, want ErrExpired", err)
+	}
+	backlog, w, err := journal.Watch("g1", 2, 1)
+	if err != nil {
+		t.Fatalf("Watch() error = %v, wantErr nil", err)
+	}
+	defer w.Close()
+	if got := sequences(backlog); len(got) != 2 || got[0] != 3 || got[1] != 4 {
+		t.Errorf("Watch() backlog = %v, want [3 4]", got)
+	}
+}
+
+func TestJournalLagging(t *testing.T) {
+	bus, journal := newTestJournal(10)
+	_, w, err := journal.Watch("g1", 0, 1)
+	if err != nil {
+		t.Fatalf("Watch() error = %v, wantErr nil", err)
+	}
+
+	// The second event does not fit in the buffer: the watcher is dropped instead of blocking the bus.
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p1"})
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p2"})
+	bus.Publish(PlayerJoinedEvent{GameID: "g1", PlayerID: "p3"})
+
+	var received []Envelope
+	for env := range w.C {
+		received = append(received, env)
+	}
+	if got := sequences(received); len(got) != 1 || got[0] != 1 {
+		t.Errorf("watcher received %v, want [1]", got)
+	}
+	if !w.Lagged() {
+		t.Error("Lagged() = false, want true")
+	}
+	w.Close()
+}
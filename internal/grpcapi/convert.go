@@ -0,0 +1,272 @@
+package grpcapi
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+)
+
+// The protobuf enumerations follow the ones of the game, shifted by one for their unspecified zero value.
+
+func toEnum[P ~int32, M ~int](m M) P {
+	return P(m + 1)
+}
+
+// fromEnum converts a protobuf enumeration value to the one of the game, failing on the unspecified value and
+// on the values unknown to the definitions of the server.
+func fromEnum[M ~int](p protoreflect.Enum) (M, error) {
+	n := p.Number()
+	if n == 0 || p.Descriptor().Values().ByNumber(n) == nil {
+		return 0, errs.Invalidf("invalid request: %s %d is not a valid value", p.Descriptor().Name(), n)
+	}
+	return M(n - 1), nil
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// The conversions from the models.
+
+func toGame(g *game.Game) *pb.Game {
+	result := &pb.Game{
+		Id:                  g.GameID,
+		Name:                g.Name,
+		Status:              toEnum[pb.GameStatus](g.Status),
+		StartTime:           optionalTimestamp(g.StartTime),
+		EndTime:             optionalTimestamp(g.EndTime),
+		AllowLateCharacters: g.AllowLateCharacters,
+		Rulebook:            toRulebook(g.Rulebook),
+		Adventure:           toAdventure(g.Adventure),
+		PayoutRules:         toPayoutRules(g.Payout),
+		Version:             int64(g.Version),
+	}
+	if g.GameMaster.ID != "" {
+		result.GameMaster = &pb.GameMaster{Id: g.GameMaster.ID, Name: g.GameMaster.Name}
+	}
+	for _, p := range g.Players {
+		result.PlayerIds = append(result.PlayerIds, p.PlayerID)
+	}
+	for _, r := range g.Reports {
+		result.Reports = append(result.Reports, toReport(r))
+	}
+	return result
+}
+
+func toRulebook(r gamemaster.Rulebook) *pb.Rulebook {
+	return &pb.Rulebook{
+		MaxXpGrantPerCharacter: int32(r.MaxXPGrantPerCharacter),
+		MinCostMultiplier:      r.MinCostMultiplier,
+		MaxCostMultiplier:      r.MaxCostMultiplier,
+		AllowNegativeBalance:   r.AllowNegativeBalance,
+	}
+}
+
+func toAdventure(a game.Adventure) *pb.Adventure {
+	result := &pb.Adventure{Id: a.ID, Type: toEnum[pb.AdventureType](a.Type), Outcome: toEnum[pb.AdventureOutcome](a.Outcome)}
+	for _, m := range a.Missions {
+		result.Missions = append(result.Missions, toMission(m))
+	}
+	return result
+}
+
+func toMission(m game.Mission) *pb.Mission {
+	result := &pb.Mission{
+		Id:            m.ID,
+		Name:          m.Name,
+		Description:   m.Description,
+		Status:        toEnum[pb.MissionStatus](m.Status),
+		Prerequisites: append([]string(nil), m.Prerequisites...),
+	}
+	for _, o := range m.Objectives {
+		result.Objectives = append(result.Objectives, &pb.Objective{Id: o.ID, Description: o.Description, Criteria: o.Criteria, Optional: o.Optional, Completed: o.Completed})
+	}
+	return result
+}
+
+func toPayoutRules(r game.PayoutRules) *pb.PayoutRules {
+	result := &pb.PayoutRules{XpPerCompletedMission: int32(r.XPPerCompletedMission)}
+	// In the order of the outcomes, so that the same rules make the same message.
+	for outcome := game.Undecided; outcome <= game.Abandoned; outcome++ {
+		if bonus, ok := r.OutcomeBonus[outcome]; ok {
+			result.OutcomeBonuses = append(result.OutcomeBonuses, &pb.OutcomeBonus{Outcome: toEnum[pb.AdventureOutcome](outcome), Bonus: int32(bonus)})
+		}
+	}
+	return result
+}
+
+func toReport(r game.AdventureReport) *pb.AdventureReport {
+	result := &pb.AdventureReport{
+		AdventureId: r.AdventureID,
+		Type:        toEnum[pb.AdventureType](r.Type),
+		Outcome:     toEnum[pb.AdventureOutcome](r.Outcome),
+		EndedAt:     timestamppb.New(r.EndedAt),
+	}
+	for _, m := range r.Missions {
+		result.Missions = append(result.Missions, &pb.MissionResult{MissionId: m.MissionID, Name: m.Name, Status: toEnum[pb.MissionStatus](m.Status)})
+	}
+	for _, s := range r.Standings {
+		result.Standings = append(result.Standings, &pb.Standing{CharacterId: s.CharacterID, Name: s.Name, PayoutXp: int32(s.PayoutXP), FinalXp: int32(s.FinalXP)})
+	}
+	return result
+}
+
+func toPlayer(p *player.Player) *pb.Player {
+	result := &pb.Player{Id: p.PlayerID, Name: p.Name, Active: p.Status == player.Active, Version: int64(p.Version)}
+	for _, c := range p.Characters {
+		result.CharacterIds = append(result.CharacterIds, c.CharacterID)
+	}
+	return result
+}
+
+func toCharacter(c *character.Character) *pb.Character {
+	return &pb.Character{
+		Id:          c.CharacterID,
+		Name:        c.Name,
+		Class:       toEnum[pb.CharacterClass](c.Class),
+		Race:        toEnum[pb.CharacterRace](c.Race),
+		Description: c.Description,
+		Attributes:  toAttributes(c.Attributes),
+		Xp:          int32(c.XP),
+		Active:      c.Status == character.Active,
+		Version:     int64(c.Version),
+	}
+}
+
+func toAttributes(a character.Attributes) *pb.Attributes {
+	return &pb.Attributes{
+		Strength:     int32(a.Strength),
+		Dexterity:    int32(a.Dexterity),
+		Constitution: int32(a.Constitution),
+		Intelligence: int32(a.Intelligence),
+		Wisdom:       int32(a.Wisdom),
+		Charisma:     int32(a.Charisma),
+	}
+}
+
+func toRoll(res dice.Result) *pb.Roll {
+	roll := &pb.Roll{Notation: res.Expression.Notation, Total: int32(res.Total)}
+	for _, d := range res.Dice {
+		roll.Dice = append(roll.Dice, int32(d.Value))
+	}
+	for _, k := range res.Kept() {
+		roll.Kept = append(roll.Kept, int32(k))
+	}
+	return roll
+}
+
+func toAction(a action.Action) *pb.Action {
+	return &pb.Action{Id: a.ActionID, Name: a.Name, BaseXpCost: int32(a.BaseXPCost), RewardXp: int32(a.RewardXP), PenaltyXp: int32(a.PenaltyXP)}
+}
+
+func toInstance(ai action.ActionInstance) *pb.ActionInstance {
+	return &pb.ActionInstance{
+		Id:           ai.InstanceID,
+		GameId:       ai.GameID,
+		CharacterId:  ai.CharacterID,
+		Action:       toAction(ai.Action),
+		CustomXpCost: int32(ai.CustomXPCost),
+		RewardXp:     int32(ai.RewardXP),
+		PenaltyXp:    int32(ai.PenaltyXP),
+		State:        toEnum[pb.ActionState](ai.State),
+		Outcome:      toEnum[pb.Outcome](ai.Outcome),
+		Version:      int64(ai.Version),
+	}
+}
+
+// The conversions to the models, of the messages sent by the clients. A missing message is taken as empty.
+
+func fromRulebook(r *pb.Rulebook) gamemaster.Rulebook {
+	return gamemaster.Rulebook{
+		MaxXPGrantPerCharacter: int(r.GetMaxXpGrantPerCharacter()),
+		MinCostMultiplier:      r.GetMinCostMultiplier(),
+		MaxCostMultiplier:      r.GetMaxCostMultiplier(),
+		AllowNegativeBalance:   r.GetAllowNegativeBalance(),
+	}
+}
+
+func fromAdventure(a *pb.Adventure) (game.Adventure, error) {
+	adventureType, err := fromEnum[game.AdventureType](a.GetType())
+	if err != nil {
+		return game.Adventure{}, err
+	}
+	result := game.Adventure{ID: a.GetId(), Type: adventureType}
+	for _, m := range a.GetMissions() {
+		result.Missions = append(result.Missions, fromMission(m))
+	}
+	return result, nil
+}
+
+func fromMission(m *pb.Mission) game.Mission {
+	result := game.Mission{ID: m.GetId(), Name: m.GetName(), Description: m.GetDescription(), Prerequisites: m.GetPrerequisites()}
+	for _, o := range m.GetObjectives() {
+		result.Objectives = append(result.Objectives, game.Objective{ID: o.GetId(), Description: o.GetDescription(), Criteria: o.GetCriteria(), Optional: o.GetOptional()})
+	}
+	return result
+}
+
+func fromPayoutRules(r *pb.PayoutRules) (game.PayoutRules, error) {
+	result := game.PayoutRules{XPPerCompletedMission: int(r.GetXpPerCompletedMission()), OutcomeBonus: make(map[game.AdventureOutcome]int)}
+	for _, b := range r.GetOutcomeBonuses() {
+		outcome, err := fromEnum[game.AdventureOutcome](b.GetOutcome())
+		if err != nil {
+			return game.PayoutRules{}, err
+		}
+		result.OutcomeBonus[outcome] = int(b.GetBonus())
+	}
+	return result, nil
+}
+
+func fromAttributes(a *pb.Attributes) character.Attributes {
+	return character.Attributes{
+		Strength:     int(a.GetStrength()),
+		Dexterity:    int(a.GetDexterity()),
+		Constitution: int(a.GetConstitution()),
+		Intelligence: int(a.GetIntelligence()),
+		Wisdom:       int(a.GetWisdom()),
+		Charisma:     int(a.GetCharisma()),
+	}
+}
+
+// fromCharacter converts the fields of a character the clients may set: its XP and action instances are not.
+func fromCharacter(c *pb.Character) (character.Character, error) {
+	class, err := fromEnum[character.CharacterClass](c.GetClass())
+	if err != nil {
+		return character.Character{}, err
+	}
+	race, err := fromEnum[character.CharacterRace](c.GetRace())
+	if err != nil {
+		return character.Character{}, err
+	}
+	result := character.Character{
+		CharacterID: c.GetId(),
+		Name:        c.GetName(),
+		Class:       class,
+		Race:        race,
+		Description: c.GetDescription(),
+		Attributes:  fromAttributes(c.GetAttributes()),
+		Status:      character.Inactive,
+		Version:     int(c.GetVersion()),
+	}
+	if c.GetActive() {
+		result.Status = character.Active
+	}
+	return result, nil
+}
+
+func fromAction(a *pb.Action) action.Action {
+	return action.Action{ActionID: a.GetId(), Name: a.GetName(), BaseXPCost: int(a.GetBaseXpCost()), RewardXP: int(a.GetRewardXp()), PenaltyXP: int(a.GetPenaltyXp())}
+}
@@ -0,0 +1,101 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/service/command"
+)
+
+// codeOf maps an error of the services to its status code. The sentinels are checked in order, so that an invalid
+// request wrapping a not found error, e.g. a mission with an unknown prerequisite, is reported as invalid.
+func codeOf(err error) codes.Code {
+	for _, m := range []struct {
+		sentinel error
+		code     codes.Code
+	}{
+		{errs.ErrInvalid, codes.InvalidArgument},
+		{errs.ErrNotFound, codes.NotFound},
+		{errs.ErrAlreadyExists, codes.AlreadyExists},
+		{errs.ErrInvalidTransition, codes.FailedPrecondition},
+		{errs.ErrInsufficientXP, codes.FailedPrecondition},
+		{errs.ErrForbidden, codes.PermissionDenied},
+		{errs.ErrConflict, codes.Aborted},
+		{event.ErrExpired, codes.OutOfRange},
+		{command.ErrClosed, codes.Unavailable},
+		{context.Canceled, codes.Canceled},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+	} {
+		if errors.Is(err, m.sentinel) {
+			return m.code
+		}
+	}
+	return codes.Unknown
+}
+
+// toStatus converts an error of the services to a status error. The internal errors are logged, and their details
+// are not sent to the clients.
+func toStatus(logger *log.Logger, method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := codeOf(err)
+	if code == codes.Unknown {
+		logger.Printf("%s: %v", method, err)
+		return status.Error(codes.Internal, "internal error")
+	}
+	return status.Error(code, err.Error())
+}
+
+// unaryErrors converts the errors returned by the unary methods to status errors.
+func unaryErrors(logger *log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatus(logger, info.FullMethod, err)
+	}
+}
+
+// streamErrors converts the errors returned by the streaming methods to status errors.
+func streamErrors(logger *log.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return toStatus(logger, info.FullMethod, handler(srv, ss))
+	}
+}
+
+// violations collects the rules a request breaks.
+type violations []string
+
+// require records the fields given as name and value pairs whose value is empty.
+func (v *violations) require(fields ...string) {
+	for i := 0; i+1 < len(fields); i += 2 {
+		if fields[i+1] == "" {
+			*v = append(*v, fields[i]+" is required")
+		}
+	}
+}
+
+// check records the violation unless ok.
+func (v *violations) check(ok bool, violation string) {
+	if !ok {
+		*v = append(*v, violation)
+	}
+}
+
+// err returns an error matching errs.ErrInvalid listing the violations, if any.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return errs.Invalidf("invalid request: %s", strings.Join(v, "; "))
+}
@@ -0,0 +1,114 @@
+package grpcapi
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/service/live"
+	"google.golang.org/grpc/metadata"
+)
+
+// watchBuffer is the number of events waiting for a slow client before its stream ends.
+const watchBuffer = 64
+
+type eventServer struct {
+	pb.UnimplementedEventServiceServer
+	app  *app.App
+	done <-chan struct{}
+}
+
+// WatchGame streams the events of a game the viewer may see, as the live table of the REST API does.
+func (s *eventServer) WatchGame(req *pb.WatchGameRequest, stream pb.EventService_WatchGameServer) error {
+	v, err := live.NewViewer(s.app.Games, s.app.Players, req.GameId, req.GetPlayerId(), req.GetGameMasterId())
+	if err != nil {
+		return err
+	}
+	after := s.app.Journal.Sequence()
+	if req.AfterSequence != nil {
+		after = *req.AfterSequence
+	}
+	backlog, watcher, err := s.app.Journal.Watch(req.GameId, after, watchBuffer)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	// The headers tell the client that the stream misses none of the events following its start.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	// send sends an event to the client, and reports whether the stream goes on.
+	send := func(env event.Envelope) (bool, error) {
+		access, err := v.Access(env)
+		if err != nil {
+			return false, err
+		}
+		if access != live.Hidden {
+			if err := stream.Send(gameEvent(env, access)); err != nil {
+				return false, err
+			}
+		}
+		return !v.Left(env), nil
+	}
+	for _, env := range backlog {
+		if more, err := send(env); !more {
+			return err
+		}
+	}
+	for {
+		select {
+		case env, ok := <-watcher.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "lagging behind, watch again after the last sequence received")
+			}
+			if more, err := send(env); !more {
+				return err
+			}
+		case <-s.done:
+			return status.Error(codes.Unavailable, "server shutting down")
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// gameEvent converts an event to the GameEvent a viewer with the given access sees.
+func gameEvent(env event.Envelope, access live.Access) *pb.GameEvent {
+	result := &pb.GameEvent{Sequence: env.Sequence, Timestamp: timestamppb.New(env.Timestamp), GameId: env.Event.Game()}
+	switch e := env.Event.(type) {
+	case event.ActionProposedEvent:
+		result.Event = &pb.GameEvent_ActionProposed{ActionProposed: &pb.ActionProposed{
+			CharacterId: e.CharacterID, InstanceId: e.InstanceID, ActionId: e.ActionID, XpCost: int32(e.XPCost),
+		}}
+	case event.ActionApprovedEvent:
+		result.Event = &pb.GameEvent_ActionApproved{ActionApproved: &pb.ActionApproved{
+			GameMasterId: e.GameMasterID, CharacterId: e.CharacterID, InstanceId: e.InstanceID, XpCost: int32(e.XPCost), Modified: e.Modified,
+		}}
+	case event.ActionRejectedEvent:
+		result.Event = &pb.GameEvent_ActionRejected{ActionRejected: &pb.ActionRejected{CharacterId: e.CharacterID, InstanceId: e.InstanceID}}
+	case event.ActionExecutedEvent:
+		executed := &pb.ActionExecuted{CharacterId: e.CharacterID, InstanceId: e.InstanceID, Outcome: e.Outcome}
+		if access == live.Full {
+			executed.XpChange = proto.Int32(int32(e.XPChange))
+		}
+		result.Event = &pb.GameEvent_ActionExecuted{ActionExecuted: executed}
+	case event.XPChangedEvent:
+		result.Event = &pb.GameEvent_XpChanged{XpChanged: &pb.XPChanged{
+			CharacterId: e.CharacterID, ActorId: e.ActorID, Type: e.Type, Amount: int32(e.Amount), Balance: int32(e.Balance), Reason: e.Reason,
+		}}
+	case event.PlayerJoinedEvent:
+		result.Event = &pb.GameEvent_PlayerJoined{PlayerJoined: &pb.PlayerJoined{PlayerId: e.PlayerID}}
+	case event.PlayerLeftEvent:
+		result.Event = &pb.GameEvent_PlayerLeft{PlayerLeft: &pb.PlayerLeft{PlayerId: e.PlayerID}}
+	case event.GameStatusChangedEvent:
+		result.Event = &pb.GameEvent_GameStatusChanged{GameStatusChanged: &pb.GameStatusChanged{From: e.From, To: e.To}}
+	case event.NPCAddedEvent:
+		result.Event = &pb.GameEvent_NpcAdded{NpcAdded: &pb.NPCAdded{CharacterId: e.CharacterID, Name: e.Name}}
+	}
+	return result
+}
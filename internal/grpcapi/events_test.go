@@ -0,0 +1,168 @@
+package grpcapi
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+)
+
+// newWatchServer sets up game g1, directed by gm1, with players p1 and p2 playing characters c1 and c2, player p3
+// not in the game, and action a1.
+func newWatchServer(t *testing.T) *testServer {
+	t.Helper()
+	s := newTestServer(t)
+	s.ok(s.games.CreateGame(s.ctx, &pb.CreateGameRequest{GameId: "g1", Name: "The Sunless Citadel"}))
+	s.ok(s.games.SetGameMaster(s.ctx, &pb.SetGameMasterRequest{GameId: "g1", GameMaster: &pb.GameMaster{Id: "gm1", Name: "Dana"}}))
+	s.ok(s.games.SetGameStatus(s.ctx, &pb.SetGameStatusRequest{GameId: "g1", Status: pb.GameStatus_GAME_STATUS_LOBBY}))
+	for _, id := range []string{"1", "2"} {
+		s.ok(s.players.CreatePlayer(s.ctx, &pb.CreatePlayerRequest{PlayerId: "p" + id, Name: "Player " + id}))
+		s.ok(s.games.AddPlayerToGame(s.ctx, &pb.AddPlayerToGameRequest{GameId: "g1", PlayerId: "p" + id}))
+		s.ok(s.players.CreateCharacter(s.ctx, &pb.CreateCharacterRequest{
+			PlayerId: "p" + id, GameId: "g1", CharacterId: "c" + id, Name: "Character " + id,
+			Class:  pb.CharacterClass_CHARACTER_CLASS_RANGER,
+			Race:   pb.CharacterRace_CHARACTER_RACE_HUMAN,
+			Method: pb.GenerationMethod_GENERATION_METHOD_STANDARD_ARRAY, Attributes: standardArray,
+		}))
+	}
+	s.ok(s.players.CreatePlayer(s.ctx, &pb.CreatePlayerRequest{PlayerId: "p3", Name: "Player 3"}))
+	s.ok(s.games.StartGame(s.ctx, &pb.StartGameRequest{GameId: "g1"}))
+	s.ok(s.gameMasters.CreateAction(s.ctx, &pb.CreateActionRequest{Action: &pb.Action{Id: "a1", Name: "Climb", BaseXpCost: 10, RewardXp: 20, PenaltyXp: 5}}))
+	return s
+}
+
+// watch watches game g1, and waits for the stream to start.
+func (s *testServer) watch(req *pb.WatchGameRequest) pb.EventService_WatchGameClient {
+	s.t.Helper()
+	req.GameId = "g1"
+	stream, err := s.events.WatchGame(s.ctx, req)
+	if err != nil {
+		s.t.Fatalf("WatchGame() error = %v, wantErr nil", err)
+	}
+	if _, err := stream.Header(); err != nil {
+		s.t.Fatalf("Header() error = %v, wantErr nil", err)
+	}
+	return stream
+}
+
+func asPlayer(playerID string) *pb.WatchGameRequest {
+	return &pb.WatchGameRequest{Viewer: &pb.WatchGameRequest_PlayerId{PlayerId: playerID}}
+}
+
+func asGameMaster(gameMasterID string) *pb.WatchGameRequest {
+	return &pb.WatchGameRequest{Viewer: &pb.WatchGameRequest_GameMasterId{GameMasterId: gameMasterID}}
+}
+
+// untilStatus receives the events of a stream up to the first change of the status of the game to the given one,
+// which is included.
+func untilStatus(t *testing.T, stream pb.EventService_WatchGameClient, status string) []*pb.GameEvent {
+	t.Helper()
+	var result []*pb.GameEvent
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v, wantErr nil", err)
+		}
+		result = append(result, ev)
+		if ev.GetGameStatusChanged().GetTo() == status {
+			return result
+		}
+	}
+}
+
+func TestWatchGameRoleFiltering(t *testing.T) {
+	s := newWatchServer(t)
+	gm, p1, p2 := s.watch(asGameMaster("gm1")), s.watch(asPlayer("p1")), s.watch(asPlayer("p2"))
+
+	ai, err := s.players.ProposeAction(s.ctx, &pb.ProposeActionRequest{GameId: "g1", CharacterId: "c1", ActionId: "a1"})
+	s.ok(ai, err)
+	s.ok(s.gameMasters.ApproveActionInstance(s.ctx, &pb.ApproveActionInstanceRequest{GameId: "g1", GameMasterId: "gm1", InstanceId: ai.Id}))
+	s.ok(s.players.ExecuteActionInstance(s.ctx, &pb.ExecuteActionInstanceRequest{GameId: "g1", CharacterId: "c1", InstanceId: ai.Id}))
+	s.ok(s.games.SetGameStatus(s.ctx, &pb.SetGameStatusRequest{GameId: "g1", Status: pb.GameStatus_GAME_STATUS_PAUSED}))
+
+	gmEvents, p1Events, p2Events := untilStatus(t, gm, "Paused"), untilStatus(t, p1, "Paused"), untilStatus(t, p2, "Paused")
+	if len(gmEvents) != len(p1Events) {
+		t.Errorf("player p1 received %d events, want the %d events of the game master", len(p1Events), len(gmEvents))
+	}
+	if len(p2Events) != 2 || p2Events[0].GetActionExecuted() == nil {
+		t.Fatalf("player p2 received %v, want the execution and the status change only", p2Events)
+	}
+	if p2Events[0].GetActionExecuted().XpChange != nil {
+		t.Error("player p2 sees the XP change of character c1, want it hidden")
+	}
+	for _, ev := range p1Events {
+		if executed := ev.GetActionExecuted(); executed != nil && executed.XpChange == nil {
+			t.Error("player p1 does not see the XP change of character c1, want it")
+		}
+	}
+}
+
+func TestWatchGameResume(t *testing.T) {
+	s := newWatchServer(t)
+	req := asGameMaster("gm1")
+	req.AfterSequence = new(uint64)
+	backlog := untilStatus(t, s.watch(req), "Active")
+	last := backlog[len(backlog)-1].Sequence
+
+	s.ok(s.games.SetGameStatus(s.ctx, &pb.SetGameStatusRequest{GameId: "g1", Status: pb.GameStatus_GAME_STATUS_PAUSED}))
+	req = asGameMaster("gm1")
+	req.AfterSequence = &last
+	ev, err := s.watch(req).Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v, wantErr nil", err)
+	}
+	if ev.GetGameStatusChanged() == nil || ev.Sequence <= last {
+		t.Errorf("first event after resuming = %v, want the game paused after %d", ev, last)
+	}
+}
+
+func TestWatchGamePlayerLeaves(t *testing.T) {
+	s := newWatchServer(t)
+	p2 := s.watch(asPlayer("p2"))
+
+	s.ok(s.games.RemovePlayerFromGame(s.ctx, &pb.RemovePlayerFromGameRequest{GameId: "g1", PlayerId: "p2"}))
+	if ev, err := p2.Recv(); err != nil || ev.GetPlayerLeft() == nil {
+		t.Fatalf("Recv() = %v, %v, want the player leaving", ev, err)
+	}
+	if _, err := p2.Recv(); !errors.Is(err, io.EOF) {
+		t.Errorf("Recv() error = %v, want the end of the stream", err)
+	}
+}
+
+func TestWatchGameShutdown(t *testing.T) {
+	s := newWatchServer(t)
+	gm := s.watch(asGameMaster("gm1"))
+
+	close(s.done)
+	_, err := gm.Recv()
+	expectCode(t, err, codes.Unavailable)
+}
+
+func TestWatchGameRejected(t *testing.T) {
+	s := newWatchServer(t)
+	ahead := uint64(100000)
+
+	tests := []struct {
+		name string
+		req  *pb.WatchGameRequest
+		want codes.Code
+	}{
+		{"no role", &pb.WatchGameRequest{}, codes.InvalidArgument},
+		{"other game master", asGameMaster("gm2"), codes.PermissionDenied},
+		{"player not in the game", asPlayer("p3"), codes.PermissionDenied},
+		{"sequence ahead", &pb.WatchGameRequest{Viewer: &pb.WatchGameRequest_PlayerId{PlayerId: "p1"}, AfterSequence: &ahead}, codes.OutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.GameId = "g1"
+			stream, err := s.events.WatchGame(s.ctx, tt.req)
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			expectCode(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package grpcapi
+
+import (
+	"context"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/service/command"
+)
+
+type gameMasterServer struct {
+	pb.UnimplementedGameMasterServiceServer
+	app *app.App
+}
+
+// instance returns the current state of an action instance of a game, after the change applied with err if it
+// did not fail.
+func (s *gameMasterServer) instance(gameID, instanceID string, err error) (*pb.ActionInstance, error) {
+	if err != nil {
+		return nil, err
+	}
+	ai, err := s.app.Repositories.Actions.GetActionInstanceByID(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if ai.GameID != gameID {
+		return nil, errs.Newf(errs.ErrNotFound, errs.ActionInstance, instanceID, "is not an action instance of game %s", gameID)
+	}
+	return toInstance(*ai), nil
+}
+
+// character returns the current state of a character, after the change applied with err if it did not fail.
+func (s *gameMasterServer) character(characterID string, err error) (*pb.Character, error) {
+	if err != nil {
+		return nil, err
+	}
+	char, err := s.app.GameMasters.GetCharacter(characterID)
+	if err != nil {
+		return nil, err
+	}
+	return toCharacter(char), nil
+}
+
+func (s *gameMasterServer) ListPendingActionInstances(ctx context.Context, req *pb.ListPendingActionInstancesRequest) (*pb.ListPendingActionInstancesResponse, error) {
+	instances, err := s.app.GameMasters.ListPendingActionInstances()
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListPendingActionInstancesResponse{}
+	for _, ai := range instances {
+		resp.Instances = append(resp.Instances, toInstance(ai))
+	}
+	return resp, nil
+}
+
+func (s *gameMasterServer) ApproveActionInstance(ctx context.Context, req *pb.ApproveActionInstanceRequest) (*pb.ActionInstance, error) {
+	var v violations
+	v.require("game_master_id", req.GameMasterId)
+	if m := req.Modification; m != nil {
+		v.check(req.Justification != "", "justification is required to modify an action instance")
+		v.check(m.CustomXpCost >= 0 && m.RewardXp >= 0 && m.PenaltyXp >= 0, "modification XP values must not be negative")
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	cmd := command.ApproveAction{GameID: req.GameId, GameMasterID: req.GameMasterId, InstanceID: req.InstanceId, Justification: req.Justification}
+	if m := req.Modification; m != nil {
+		cmd.Modified = &action.ActionInstance{CustomXPCost: int(m.CustomXpCost), RewardXP: int(m.RewardXp), PenaltyXP: int(m.PenaltyXp)}
+	}
+	_, err := s.app.Commands.Execute(ctx, cmd)
+	return s.instance(req.GameId, req.InstanceId, err)
+}
+
+func (s *gameMasterServer) RejectActionInstance(ctx context.Context, req *pb.RejectActionInstanceRequest) (*pb.ActionInstance, error) {
+	var v violations
+	v.require("game_master_id", req.GameMasterId)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	_, err := s.app.Commands.Execute(ctx, command.RejectAction{GameID: req.GameId, GameMasterID: req.GameMasterId, InstanceID: req.InstanceId})
+	return s.instance(req.GameId, req.InstanceId, err)
+}
+
+func (s *gameMasterServer) ListActions(ctx context.Context, req *pb.ListActionsRequest) (*pb.ListActionsResponse, error) {
+	actions, err := s.app.GameMasters.ListActions()
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListActionsResponse{}
+	for _, a := range actions {
+		resp.Actions = append(resp.Actions, toAction(a))
+	}
+	return resp, nil
+}
+
+func (s *gameMasterServer) CreateAction(ctx context.Context, req *pb.CreateActionRequest) (*pb.Action, error) {
+	var v violations
+	v.require("action.id", req.Action.GetId())
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	a := fromAction(req.Action)
+	if err := s.app.Repositories.Actions.CreateAction(&a); err != nil {
+		return nil, err
+	}
+	return toAction(a), nil
+}
+
+func (s *gameMasterServer) ModifyAction(ctx context.Context, req *pb.ModifyActionRequest) (*pb.Action, error) {
+	a := fromAction(req.Action)
+	if err := s.app.GameMasters.ModifyAction(a.ActionID, &a); err != nil {
+		return nil, err
+	}
+	return toAction(a), nil
+}
+
+func (s *gameMasterServer) ListCharacters(ctx context.Context, req *pb.ListCharactersRequest) (*pb.ListCharactersResponse, error) {
+	chars, err := s.app.GameMasters.ListCharacters()
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListCharactersResponse{}
+	for i := range chars {
+		resp.Characters = append(resp.Characters, toCharacter(&chars[i]))
+	}
+	return resp, nil
+}
+
+func (s *gameMasterServer) GetCharacter(ctx context.Context, req *pb.GetCharacterRequest) (*pb.Character, error) {
+	return s.character(req.CharacterId, nil)
+}
+
+// UpdateCharacter replaces the fields of a character the clients may set. Its XP changes with UpdateCharacterXP
+// only, and its action instances with their lifecycle.
+func (s *gameMasterServer) UpdateCharacter(ctx context.Context, req *pb.UpdateCharacterRequest) (*pb.Character, error) {
+	update, err := fromCharacter(req.Character)
+	if err != nil {
+		return nil, err
+	}
+	char, err := s.app.GameMasters.GetCharacter(update.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+	update.XP = char.XP
+	update.ActionInstances = char.ActionInstances
+	return s.character(update.CharacterID, s.app.GameMasters.UpdateCharacter(&update))
+}
+
+func (s *gameMasterServer) UpdateCharacterXP(ctx context.Context, req *pb.UpdateCharacterXPRequest) (*pb.Character, error) {
+	var v violations
+	v.require("game_master_id", req.GameMasterId, "character_id", req.CharacterId, "reason", req.Reason)
+	v.check(req.XpChange != 0, "xp_change must not be zero")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	cmd := command.GrantXP{GameID: req.GameId, GameMasterID: req.GameMasterId, CharacterID: req.CharacterId, Amount: int(req.XpChange), Reason: req.Reason}
+	_, err := s.app.Commands.Execute(ctx, cmd)
+	return s.character(req.CharacterId, err)
+}
+
+func (s *gameMasterServer) ReviewMissionProgress(ctx context.Context, req *pb.ReviewMissionProgressRequest) (*pb.Mission, error) {
+	m, err := s.app.GameMasters.ReviewMissionProgress(req.GameId, req.MissionId)
+	if err != nil {
+		return nil, err
+	}
+	return toMission(m), nil
+}
+
+func (s *gameMasterServer) StartMission(ctx context.Context, req *pb.StartMissionRequest) (*pb.Mission, error) {
+	return s.mission(req.GameId, req.MissionId, s.app.GameMasters.StartMission(req.GameId, req.GameMasterId, req.MissionId))
+}
+
+func (s *gameMasterServer) CompleteObjective(ctx context.Context, req *pb.CompleteObjectiveRequest) (*pb.Mission, error) {
+	return s.mission(req.GameId, req.MissionId, s.app.GameMasters.CompleteObjective(req.GameId, req.GameMasterId, req.MissionId, req.ObjectiveId))
+}
+
+func (s *gameMasterServer) FailMission(ctx context.Context, req *pb.FailMissionRequest) (*pb.Mission, error) {
+	return s.mission(req.GameId, req.MissionId, s.app.GameMasters.FailMission(req.GameId, req.GameMasterId, req.MissionId))
+}
+
+// mission returns the current state of a mission of a game, after the change applied with err if it did not fail.
+func (s *gameMasterServer) mission(gameID, missionID string, err error) (*pb.Mission, error) {
+	if err != nil {
+		return nil, err
+	}
+	return s.ReviewMissionProgress(context.Background(), &pb.ReviewMissionProgressRequest{GameId: gameID, MissionId: missionID})
+}
+
+func (s *gameMasterServer) SetAdventureOutcome(ctx context.Context, req *pb.SetAdventureOutcomeRequest) (*pb.Game, error) {
+	outcome, err := fromEnum[game.AdventureOutcome](req.Outcome)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.app.GameMasters.SetAdventureOutcome(req.GameId, req.GameMasterId, outcome); err != nil {
+		return nil, err
+	}
+	g, err := s.app.Games.GetGame(req.GameId)
+	if err != nil {
+		return nil, err
+	}
+	return toGame(g), nil
+}
+
+func (s *gameMasterServer) EndAdventure(ctx context.Context, req *pb.EndAdventureRequest) (*pb.AdventureReport, error) {
+	report, err := s.app.GameMasters.EndAdventure(req.GameId, req.GameMasterId, req.AdventureId)
+	if err != nil {
+		return nil, err
+	}
+	return toReport(report), nil
+}
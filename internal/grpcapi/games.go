@@ -0,0 +1,118 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/service/command"
+)
+
+type gameServer struct {
+	pb.UnimplementedGameServiceServer
+	app *app.App
+}
+
+// game returns the current state of a game, after the change applied with err if it did not fail.
+func (s *gameServer) game(gameID string, err error) (*pb.Game, error) {
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.app.Games.GetGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	return toGame(g), nil
+}
+
+func (s *gameServer) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.Game, error) {
+	var v violations
+	v.require("game_id", req.GameId, "name", req.Name)
+	v.check((req.StartTime == nil) == (req.EndTime == nil), "start_time and end_time are required together")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	if req.StartTime != nil {
+		return s.game(req.GameId, s.app.Games.ScheduleGame(req.GameId, req.Name, req.StartTime.AsTime(), req.EndTime.AsTime()))
+	}
+	return s.game(req.GameId, s.app.Games.CreateGame(req.GameId, req.Name))
+}
+
+func (s *gameServer) GetGame(ctx context.Context, req *pb.GetGameRequest) (*pb.Game, error) {
+	return s.game(req.GameId, nil)
+}
+
+func (s *gameServer) UpdateSchedules(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.app.Games.UpdateSchedules(); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *gameServer) StartGame(ctx context.Context, req *pb.StartGameRequest) (*pb.Game, error) {
+	return s.game(req.GameId, s.app.Games.StartGame(req.GameId))
+}
+
+func (s *gameServer) EndGame(ctx context.Context, req *pb.EndGameRequest) (*pb.Game, error) {
+	return s.game(req.GameId, s.app.Games.EndGame(req.GameId))
+}
+
+func (s *gameServer) SetGameStatus(ctx context.Context, req *pb.SetGameStatusRequest) (*pb.Game, error) {
+	status, err := fromEnum[game.GameStatus](req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return s.game(req.GameId, s.app.Games.SetGameStatus(req.GameId, status))
+}
+
+func (s *gameServer) AddPlayerToGame(ctx context.Context, req *pb.AddPlayerToGameRequest) (*pb.Game, error) {
+	var v violations
+	v.require("player_id", req.PlayerId)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	_, err := s.app.Commands.Execute(ctx, command.JoinGame{GameID: req.GameId, PlayerID: req.PlayerId})
+	return s.game(req.GameId, err)
+}
+
+func (s *gameServer) RemovePlayerFromGame(ctx context.Context, req *pb.RemovePlayerFromGameRequest) (*pb.Game, error) {
+	_, err := s.app.Commands.Execute(ctx, command.LeaveGame{GameID: req.GameId, PlayerID: req.PlayerId})
+	return s.game(req.GameId, err)
+}
+
+func (s *gameServer) SetGameMaster(ctx context.Context, req *pb.SetGameMasterRequest) (*pb.Game, error) {
+	gm := gamemaster.GameMaster{ID: req.GameMaster.GetId(), Name: req.GameMaster.GetName(), Status: gamemaster.Active}
+	return s.game(req.GameId, s.app.Games.SetGameMaster(req.GameId, gm))
+}
+
+func (s *gameServer) SetRulebook(ctx context.Context, req *pb.SetRulebookRequest) (*pb.Game, error) {
+	return s.game(req.GameId, s.app.Games.SetRulebook(req.GameId, fromRulebook(req.Rulebook)))
+}
+
+func (s *gameServer) SetAllowLateCharacters(ctx context.Context, req *pb.SetAllowLateCharactersRequest) (*pb.Game, error) {
+	return s.game(req.GameId, s.app.Games.SetAllowLateCharacters(req.GameId, req.Allow))
+}
+
+func (s *gameServer) SetPayoutRules(ctx context.Context, req *pb.SetPayoutRulesRequest) (*pb.Game, error) {
+	rules, err := fromPayoutRules(req.PayoutRules)
+	if err != nil {
+		return nil, err
+	}
+	return s.game(req.GameId, s.app.Games.SetPayoutRules(req.GameId, rules))
+}
+
+func (s *gameServer) SetAdventure(ctx context.Context, req *pb.SetAdventureRequest) (*pb.Game, error) {
+	adventure, err := fromAdventure(req.Adventure)
+	if err != nil {
+		return nil, err
+	}
+	return s.game(req.GameId, s.app.Games.SetAdventure(req.GameId, adventure))
+}
+
+func (s *gameServer) AddMissionToGame(ctx context.Context, req *pb.AddMissionToGameRequest) (*pb.Game, error) {
+	return s.game(req.GameId, s.app.Games.AddMissionToGame(req.GameId, fromMission(req.Mission)))
+}
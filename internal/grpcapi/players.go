@@ -0,0 +1,126 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/service/command"
+)
+
+type playerServer struct {
+	pb.UnimplementedPlayerServiceServer
+	app *app.App
+}
+
+// player returns the current state of a player, after the change applied with err if it did not fail.
+func (s *playerServer) player(playerID string, err error) (*pb.Player, error) {
+	if err != nil {
+		return nil, err
+	}
+	pl, err := s.app.Players.GetPlayerByID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	return toPlayer(pl), nil
+}
+
+func (s *playerServer) CreatePlayer(ctx context.Context, req *pb.CreatePlayerRequest) (*pb.Player, error) {
+	var v violations
+	v.require("player_id", req.PlayerId, "name", req.Name)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return s.player(req.PlayerId, s.app.Players.CreatePlayer(req.PlayerId, req.Name))
+}
+
+func (s *playerServer) DeletePlayer(ctx context.Context, req *pb.DeletePlayerRequest) (*emptypb.Empty, error) {
+	if err := s.app.Players.DeletePlayer(req.PlayerId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *playerServer) GetPlayer(ctx context.Context, req *pb.GetPlayerRequest) (*pb.Player, error) {
+	return s.player(req.PlayerId, nil)
+}
+
+func (s *playerServer) CreateCharacter(ctx context.Context, req *pb.CreateCharacterRequest) (*pb.CreateCharacterResponse, error) {
+	var v violations
+	v.require("game_id", req.GameId)
+	class, err := fromEnum[character.CharacterClass](req.Class)
+	v.check(err == nil, "class is required")
+	race, err := fromEnum[character.CharacterRace](req.Race)
+	v.check(err == nil, "race is required")
+	method, err := fromEnum[character.GenerationMethod](req.Method)
+	v.check(err == nil, "method is required")
+	rolled := method == character.Roll3d6InOrder || method == character.Roll4d6DropLowest
+	v.check(err != nil || rolled == (req.Attributes == nil), "attributes are required by the methods assigning them only")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	b := character.NewBuilder(req.CharacterId, req.Name, class, race).WithDescription(req.Description)
+	if rolled {
+		b.RollAttributes(s.app.Dice, method)
+	} else {
+		b.AssignAttributes(method, fromAttributes(req.Attributes))
+	}
+	char, err := s.app.Players.CreateCharacter(req.PlayerId, req.GameId, b)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.CreateCharacterResponse{Character: toCharacter(char)}
+	for _, res := range b.Rolls() {
+		resp.Rolls = append(resp.Rolls, toRoll(res))
+	}
+	return resp, nil
+}
+
+func (s *playerServer) AddCharacterToPlayer(ctx context.Context, req *pb.AddCharacterToPlayerRequest) (*pb.Player, error) {
+	char, err := fromCharacter(req.Character)
+	if err != nil {
+		return nil, err
+	}
+	return s.player(req.PlayerId, s.app.Players.AddCharacterToPlayer(req.PlayerId, char))
+}
+
+func (s *playerServer) RemoveCharacterFromPlayer(ctx context.Context, req *pb.RemoveCharacterFromPlayerRequest) (*pb.Player, error) {
+	return s.player(req.PlayerId, s.app.Players.RemoveCharacterFromPlayer(req.PlayerId, req.CharacterId))
+}
+
+func (s *playerServer) ProposeAction(ctx context.Context, req *pb.ProposeActionRequest) (*pb.ActionInstance, error) {
+	var v violations
+	v.require("character_id", req.CharacterId, "action_id", req.ActionId)
+	v.check(req.CustomXpCost >= 0, "custom_xp_cost must not be negative")
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	value, err := s.app.Commands.Execute(ctx, command.ProposeAction{
+		GameID:       req.GameId,
+		CharacterID:  req.CharacterId,
+		ActionID:     req.ActionId,
+		CustomXPCost: int(req.CustomXpCost),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toInstance(value.(action.ActionInstance)), nil
+}
+
+func (s *playerServer) ExecuteActionInstance(ctx context.Context, req *pb.ExecuteActionInstanceRequest) (*pb.ActionInstance, error) {
+	value, err := s.app.Commands.Execute(ctx, command.ExecuteAction{
+		GameID:      req.GameId,
+		CharacterID: req.CharacterId,
+		InstanceID:  req.InstanceId,
+		Resolver:    action.RolledOutcome{Roller: s.app.Dice},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toInstance(value.(action.ActionInstance)), nil
+}
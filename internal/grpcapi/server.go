@@ -0,0 +1,29 @@
+// Package grpcapi serves the game, player and game master services over gRPC, with the definitions of
+// api/dndgame/v1, and streams the events of the games.
+//
+// The errors of the services are reported with the status code of their sentinel, see codeOf. The mutations of
+// a game, such as proposing or approving an action, go through the command processor of the app so that they
+// apply one at a time, as they do through the REST API.
+package grpcapi
+
+import (
+	"log"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/app"
+)
+
+// NewServer creates a gRPC server with the services of an app registered, the errors of the services converted
+// to status errors, and the given options. The internal errors are logged with logger. Closing done ends the
+// event streams, which grpc.Server.GracefulStop waits for.
+func NewServer(a *app.App, logger *log.Logger, done <-chan struct{}, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.ChainUnaryInterceptor(unaryErrors(logger)), grpc.ChainStreamInterceptor(streamErrors(logger)))
+	s := grpc.NewServer(opts...)
+	pb.RegisterGameServiceServer(s, &gameServer{app: a})
+	pb.RegisterPlayerServiceServer(s, &playerServer{app: a})
+	pb.RegisterGameMasterServiceServer(s, &gameMasterServer{app: a})
+	pb.RegisterEventServiceServer(s, &eventServer{app: a, done: done})
+	return s
+}
@@ -0,0 +1,290 @@
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/jerberlin/dndgame/api/dndgame/v1"
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/dice"
+)
+
+// testServer serves the API on in-memory repositories over an in-process connection, rolling the same dice on
+// every run.
+type testServer struct {
+	t           *testing.T
+	ctx         context.Context
+	done        chan struct{}
+	games       pb.GameServiceClient
+	players     pb.PlayerServiceClient
+	gameMasters pb.GameMasterServiceClient
+	events      pb.EventServiceClient
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	a := app.NewInMemory(clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+	a.Dice = dice.NewSeededRoller(42)
+	t.Cleanup(a.Close)
+
+	done := make(chan struct{})
+	lis := bufconn.Listen(1 << 20)
+	server := NewServer(a, log.New(io.Discard, "", 0), done)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v, wantErr nil", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return &testServer{
+		t:           t,
+		ctx:         ctx,
+		done:        done,
+		games:       pb.NewGameServiceClient(conn),
+		players:     pb.NewPlayerServiceClient(conn),
+		gameMasters: pb.NewGameMasterServiceClient(conn),
+		events:      pb.NewEventServiceClient(conn),
+	}
+}
+
+// ok fails the test if a call failed.
+func (s *testServer) ok(_ any, err error) {
+	s.t.Helper()
+	if err != nil {
+		s.t.Fatalf("error = %v, wantErr nil", err)
+	}
+}
+
+// expectCode checks the status code a call failed with.
+func expectCode(t *testing.T, err error, want codes.Code) *status.Status {
+	t.Helper()
+	st, _ := status.FromError(err)
+	if st.Code() != want {
+		t.Fatalf("code = %v (%v), want %v", st.Code(), err, want)
+	}
+	return st
+}
+
+var standardArray = &pb.Attributes{Strength: 15, Dexterity: 14, Constitution: 13, Intelligence: 12, Wisdom: 10, Charisma: 8}
+
+func (s *testServer) setUpGame() {
+	s.t.Helper()
+	ctx := s.ctx
+	s.ok(s.games.CreateGame(ctx, &pb.CreateGameRequest{GameId: "g1", Name: "The Sunless Citadel"}))
+	s.ok(s.games.SetGameMaster(ctx, &pb.SetGameMasterRequest{GameId: "g1", GameMaster: &pb.GameMaster{Id: "gm1", Name: "Dana"}}))
+	s.ok(s.games.SetGameStatus(ctx, &pb.SetGameStatusRequest{GameId: "g1", Status: pb.GameStatus_GAME_STATUS_LOBBY}))
+	s.ok(s.players.CreatePlayer(ctx, &pb.CreatePlayerRequest{PlayerId: "p1", Name: "Alex"}))
+	s.ok(s.games.AddPlayerToGame(ctx, &pb.AddPlayerToGameRequest{GameId: "g1", PlayerId: "p1"}))
+	s.ok(s.players.CreateCharacter(ctx, &pb.CreateCharacterRequest{
+		PlayerId: "p1", GameId: "g1", CharacterId: "c1", Name: "Merric",
+		Class:  pb.CharacterClass_CHARACTER_CLASS_WARRIOR,
+		Race:   pb.CharacterRace_CHARACTER_RACE_DWARF,
+		Method: pb.GenerationMethod_GENERATION_METHOD_STANDARD_ARRAY, Attributes: standardArray,
+	}))
+	s.ok(s.games.StartGame(ctx, &pb.StartGameRequest{GameId: "g1"}))
+	s.ok(s.gameMasters.CreateAction(ctx, &pb.CreateActionRequest{Action: &pb.Action{Id: "a1", Name: "Climb", BaseXpCost: 10, RewardXp: 20, PenaltyXp: 5}}))
+}
+
+func TestServerGame(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	g, err := s.games.GetGame(s.ctx, &pb.GetGameRequest{GameId: "g1"})
+	s.ok(g, err)
+	if g.Status != pb.GameStatus_GAME_STATUS_ACTIVE {
+		t.Errorf("Status = %v, want GAME_STATUS_ACTIVE", g.Status)
+	}
+	if g.GetGameMaster().GetId() != "gm1" {
+		t.Errorf("GameMaster = %v, want gm1", g.GameMaster)
+	}
+	if len(g.PlayerIds) != 1 || g.PlayerIds[0] != "p1" {
+		t.Errorf("PlayerIds = %v, want [p1]", g.PlayerIds)
+	}
+
+	g, err = s.games.RemovePlayerFromGame(s.ctx, &pb.RemovePlayerFromGameRequest{GameId: "g1", PlayerId: "p1"})
+	s.ok(g, err)
+	if len(g.PlayerIds) != 0 {
+		t.Errorf("PlayerIds = %v, want none", g.PlayerIds)
+	}
+}
+
+func TestServerCreateCharacterRolled(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+	s.ok(s.games.SetAllowLateCharacters(s.ctx, &pb.SetAllowLateCharactersRequest{GameId: "g1", Allow: true}))
+
+	created, err := s.players.CreateCharacter(s.ctx, &pb.CreateCharacterRequest{
+		PlayerId: "p1", GameId: "g1", CharacterId: "c2", Name: "Lyra",
+		Class:  pb.CharacterClass_CHARACTER_CLASS_WIZARD,
+		Race:   pb.CharacterRace_CHARACTER_RACE_ELF,
+		Method: pb.GenerationMethod_GENERATION_METHOD_ROLL_4D6_DROP_LOWEST,
+	})
+	s.ok(created, err)
+	if len(created.Rolls) != 6 {
+		t.Fatalf("Rolls = %d, want 6", len(created.Rolls))
+	}
+	if created.Rolls[0].Total != created.Character.Attributes.Strength {
+		t.Errorf("Strength = %d, want the first roll %d", created.Character.Attributes.Strength, created.Rolls[0].Total)
+	}
+
+	_, err = s.players.CreateCharacter(s.ctx, &pb.CreateCharacterRequest{
+		PlayerId: "p1", GameId: "g1", CharacterId: "c3", Name: "Lyra",
+		Class:  pb.CharacterClass_CHARACTER_CLASS_WIZARD,
+		Race:   pb.CharacterRace_CHARACTER_RACE_ELF,
+		Method: pb.GenerationMethod_GENERATION_METHOD_ROLL_3D6_IN_ORDER, Attributes: standardArray,
+	})
+	expectCode(t, err, codes.InvalidArgument)
+}
+
+func TestServerActionLifecycle(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	ai, err := s.players.ProposeAction(s.ctx, &pb.ProposeActionRequest{GameId: "g1", CharacterId: "c1", ActionId: "a1"})
+	s.ok(ai, err)
+	if ai.State != pb.ActionState_ACTION_STATE_PROPOSED || ai.CustomXpCost != 10 {
+		t.Fatalf("instance = %v, want proposed with cost 10", ai)
+	}
+	pending, err := s.gameMasters.ListPendingActionInstances(s.ctx, &pb.ListPendingActionInstancesRequest{})
+	s.ok(pending, err)
+	if len(pending.Instances) != 1 {
+		t.Errorf("pending instances = %d, want 1", len(pending.Instances))
+	}
+
+	_, err = s.gameMasters.ApproveActionInstance(s.ctx, &pb.ApproveActionInstanceRequest{GameId: "g1", GameMasterId: "gm2", InstanceId: ai.Id})
+	expectCode(t, err, codes.PermissionDenied)
+	_, err = s.gameMasters.RejectActionInstance(s.ctx, &pb.RejectActionInstanceRequest{GameId: "g1", GameMasterId: "gm2", InstanceId: ai.Id})
+	expectCode(t, err, codes.PermissionDenied)
+	ai, err = s.gameMasters.ApproveActionInstance(s.ctx, &pb.ApproveActionInstanceRequest{
+		GameId: "g1", GameMasterId: "gm1", InstanceId: ai.Id,
+		Modification:  &pb.Modification{CustomXpCost: 8, RewardXp: 30, PenaltyXp: 5},
+		Justification: "the wall is slick with rain",
+	})
+	s.ok(ai, err)
+	if ai.State != pb.ActionState_ACTION_STATE_APPROVED || ai.CustomXpCost != 8 || ai.RewardXp != 30 {
+		t.Fatalf("instance = %v, want approved with cost 8 and reward 30", ai)
+	}
+
+	before, err := s.gameMasters.GetCharacter(s.ctx, &pb.GetCharacterRequest{CharacterId: "c1"})
+	s.ok(before, err)
+	ai, err = s.players.ExecuteActionInstance(s.ctx, &pb.ExecuteActionInstanceRequest{GameId: "g1", CharacterId: "c1", InstanceId: ai.Id})
+	s.ok(ai, err)
+	// The outcome is rolled by the server
+	outcomeXP, ok := map[pb.Outcome]int32{pb.Outcome_OUTCOME_SUCCESS: 30, pb.Outcome_OUTCOME_PARTIAL: 15, pb.Outcome_OUTCOME_FAILURE: -5}[ai.Outcome]
+	if ai.State != pb.ActionState_ACTION_STATE_RESOLVED || !ok {
+		t.Fatalf("instance = %v, want resolved with a rolled outcome", ai)
+	}
+	after, err := s.gameMasters.GetCharacter(s.ctx, &pb.GetCharacterRequest{CharacterId: "c1"})
+	s.ok(after, err)
+	if want := before.Xp - 8 + outcomeXP; after.Xp != want {
+		t.Errorf("Xp = %d, want %d after %v", after.Xp, want, ai.Outcome)
+	}
+
+	granted, err := s.gameMasters.UpdateCharacterXP(s.ctx, &pb.UpdateCharacterXPRequest{
+		GameId: "g1", GameMasterId: "gm1", CharacterId: "c1", XpChange: 15, Reason: "saved the village",
+	})
+	s.ok(granted, err)
+	if granted.Xp != after.Xp+15 {
+		t.Errorf("Xp = %d, want %d", granted.Xp, after.Xp+15)
+	}
+	_, err = s.players.ExecuteActionInstance(s.ctx, &pb.ExecuteActionInstanceRequest{GameId: "g1", CharacterId: "c1", InstanceId: ai.Id})
+	expectCode(t, err, codes.FailedPrecondition)
+}
+
+func TestServerUpdateCharacterKeepsXP(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	char, err := s.gameMasters.GetCharacter(s.ctx, &pb.GetCharacterRequest{CharacterId: "c1"})
+	s.ok(char, err)
+	update := proto.Clone(char).(*pb.Character)
+	update.Name = "Merric the Bold"
+	update.Xp = char.Xp + 1000
+	updated, err := s.gameMasters.UpdateCharacter(s.ctx, &pb.UpdateCharacterRequest{Character: update})
+	s.ok(updated, err)
+	if updated.Name != "Merric the Bold" {
+		t.Errorf("Name = %q, want Merric the Bold", updated.Name)
+	}
+	if updated.Xp != char.Xp {
+		t.Errorf("Xp = %d, want %d unchanged", updated.Xp, char.Xp)
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+		want codes.Code
+	}{
+		{"unknown game", func(ctx context.Context) error {
+			_, err := s.games.GetGame(ctx, &pb.GetGameRequest{GameId: "nope"})
+			return err
+		}, codes.NotFound},
+		{"existing game", func(ctx context.Context) error {
+			_, err := s.games.CreateGame(ctx, &pb.CreateGameRequest{GameId: "g1", Name: "Again"})
+			return err
+		}, codes.AlreadyExists},
+		{"missing field", func(ctx context.Context) error {
+			_, err := s.players.CreatePlayer(ctx, &pb.CreatePlayerRequest{PlayerId: "p2"})
+			return err
+		}, codes.InvalidArgument},
+		{"unspecified status", func(ctx context.Context) error {
+			_, err := s.games.SetGameStatus(ctx, &pb.SetGameStatusRequest{GameId: "g1"})
+			return err
+		}, codes.InvalidArgument},
+		{"unknown status", func(ctx context.Context) error {
+			_, err := s.games.SetGameStatus(ctx, &pb.SetGameStatusRequest{GameId: "g1", Status: 42})
+			return err
+		}, codes.InvalidArgument},
+		{"invalid transition", func(ctx context.Context) error {
+			_, err := s.games.SetGameStatus(ctx, &pb.SetGameStatusRequest{GameId: "g1", Status: pb.GameStatus_GAME_STATUS_DRAFT})
+			return err
+		}, codes.FailedPrecondition},
+		{"rejection without game master", func(ctx context.Context) error {
+			_, err := s.gameMasters.RejectActionInstance(ctx, &pb.RejectActionInstanceRequest{GameId: "g1", InstanceId: "i1"})
+			return err
+		}, codes.InvalidArgument},
+		{"other game instance", func(ctx context.Context) error {
+			_, err := s.gameMasters.RejectActionInstance(ctx, &pb.RejectActionInstanceRequest{GameId: "g2", GameMasterId: "gm1", InstanceId: "i1"})
+			return err
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCode(t, tt.call(s.ctx), tt.want)
+		})
+	}
+}
+
+func TestServerValidationViolations(t *testing.T) {
+	s := newTestServer(t)
+
+	_, err := s.players.CreatePlayer(s.ctx, &pb.CreatePlayerRequest{})
+	st := expectCode(t, err, codes.InvalidArgument)
+	if !strings.Contains(st.Message(), "player_id is required") || !strings.Contains(st.Message(), "name is required") {
+		t.Errorf("Message = %q, want both violations", st.Message())
+	}
+}
@@ -0,0 +1,88 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+func (s *Server) actionRoutes() {
+	s.handle(http.MethodGet, "/actions", s.listActions)
+	s.handle(http.MethodPost, "/actions", s.createAction)
+	s.handle(http.MethodGet, "/actions/{actionID}", s.getAction)
+	s.handle(http.MethodPut, "/actions/{actionID}", s.modifyAction)
+	s.handle(http.MethodGet, "/instances/pending", s.listPendingInstances)
+}
+
+func (req *Action) validate() error {
+	var v violations
+	v.check(req.Name != "", "name is required")
+	v.check(req.BaseXPCost >= 0 && req.RewardXP >= 0 && req.PenaltyXP >= 0, "XP values must not be negative")
+	return v.err()
+}
+
+func (s *Server) listActions(r *http.Request, p params) (int, any, error) {
+	actions, err := s.app.GameMasters.ListActions()
+	if err != nil {
+		return 0, nil, err
+	}
+	result := make([]Action, 0, len(actions))
+	for _, a := range actions {
+		result = append(result, toAction(a))
+	}
+	return http.StatusOK, result, nil
+}
+
+// createAction adds an action template to the repertoire of the game. The services have no operation for it:
+// the templates are stored directly, as the game master would find them in the rules.
+func (s *Server) createAction(r *http.Request, p params) (int, any, error) {
+	var req Action
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.ID == "" {
+		return 0, nil, errs.Invalidf("invalid request: id is required")
+	}
+	a := req.model()
+	if err := s.app.Repositories.Actions.CreateAction(&a); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, toAction(a), nil
+}
+
+func (s *Server) getAction(r *http.Request, p params) (int, any, error) {
+	a, err := s.app.Repositories.Actions.GetActionByID(p["actionID"])
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toAction(*a), nil
+}
+
+// modifyAction replaces an action template. The ID of the body, if any, has to be the one of the path.
+func (s *Server) modifyAction(r *http.Request, p params) (int, any, error) {
+	var req Action
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.ID != "" && req.ID != p["actionID"] {
+		return 0, nil, errs.Invalidf("invalid request: id %s does not match the path", req.ID)
+	}
+	req.ID = p["actionID"]
+	a := req.model()
+	if err := s.app.GameMasters.ModifyAction(a.ActionID, &a); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toAction(a), nil
+}
+
+func (s *Server) listPendingInstances(r *http.Request, p params) (int, any, error) {
+	instances, err := s.app.GameMasters.ListPendingActionInstances()
+	if err != nil {
+		return 0, nil, err
+	}
+	result := make([]ActionInstance, 0, len(instances))
+	for _, ai := range instances {
+		result = append(result, toInstance(ai))
+	}
+	return http.StatusOK, result, nil
+}
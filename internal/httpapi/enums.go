@@ -0,0 +1,66 @@
+package httpapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+)
+
+// enum maps the values of an enumeration of the models to their names in the API.
+type enum[T comparable] struct {
+	kind   string
+	values []T
+	names  []string
+}
+
+// named returns the enumeration of values named by their String method.
+func named[T interface {
+	comparable
+	fmt.Stringer
+}](kind string, values ...T) enum[T] {
+	e := enum[T]{kind: kind, values: values}
+	for _, v := range values {
+		e.names = append(e.names, v.String())
+	}
+	return e
+}
+
+func (e enum[T]) name(v T) string {
+	for i, value := range e.values {
+		if value == v {
+			return e.names[i]
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// parse returns the value with the given name, or an error listing the names if there is none.
+func (e enum[T]) parse(name string) (T, error) {
+	for i, n := range e.names {
+		if n == name {
+			return e.values[i], nil
+		}
+	}
+	var zero T
+	return zero, errs.Invalidf("unknown %s %q, want one of %s", e.kind, name, strings.Join(e.names, ", "))
+}
+
+var (
+	gameStatuses      = named("game status", game.Draft, game.Lobby, game.Active, game.Paused, game.Ended, game.Archived)
+	adventureOutcomes = named("adventure outcome", game.Undecided, game.Victory, game.Defeat, game.PartialVictory, game.Abandoned)
+	adventureTypes    = enum[game.AdventureType]{
+		kind:   "adventure type",
+		values: []game.AdventureType{game.DungeonCrawls, game.Quests, game.Campaigns},
+		names:  []string{"DungeonCrawls", "Quests", "Campaigns"},
+	}
+	characterClasses  = named("character class", character.Wizard, character.Warrior, character.Cleric, character.Ranger)
+	characterRaces    = named("character race", character.Human, character.Elf, character.Dwarf, character.Orc, character.Ghost)
+	generationMethods = enum[character.GenerationMethod]{
+		kind:   "generation method",
+		values: []character.GenerationMethod{character.Roll3d6InOrder, character.Roll4d6DropLowest, character.StandardArrayMethod, character.PointBuyMethod},
+		names:  []string{"Roll3d6InOrder", "Roll4d6DropLowest", "StandardArray", "PointBuy"},
+	}
+)
@@ -0,0 +1,91 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/service/command"
+)
+
+// ErrorBody is the body of every response of a failed request.
+type ErrorBody struct {
+	Error Error `json:"error"`
+}
+
+// Error describes why a request failed. Its code is stable, its message is meant for humans.
+type Error struct {
+	Code       string   `json:"code"`
+	Message    string   `json:"message"`
+	Violations []string `json:"violations,omitempty"` // every rule a new character breaks
+}
+
+// The codes of the errors.
+const (
+	CodeBadRequest        = "bad_request"        // the request cannot be decoded
+	CodeInvalid           = "invalid"            // the request breaks a rule, errs.ErrInvalid
+	CodeNotFound          = "not_found"          // errs.ErrNotFound, or no such route
+	CodeMethodNotAllowed  = "method_not_allowed" // the route does not support the method
+	CodeAlreadyExists     = "already_exists"     // errs.ErrAlreadyExists
+	CodeInvalidTransition = "invalid_transition" // errs.ErrInvalidTransition
+	CodeInsufficientXP    = "insufficient_xp"    // errs.ErrInsufficientXP
+	CodeForbidden         = "forbidden"          // errs.ErrForbidden
+	CodeConflict          = "conflict"           // errs.ErrConflict
+	CodeExpired           = "expired"            // the events to resume from are no longer retained, event.ErrExpired
+	CodeUnavailable       = "unavailable"        // the server is shutting down or the request was canceled
+	CodeInternal          = "internal"           // anything else, the details are logged only
+)
+
+// statusError is an error of the API itself, e.g. a request body that cannot be decoded.
+type statusError struct {
+	status int
+	code   string
+	msg    string
+}
+
+func (e *statusError) Error() string {
+	return e.msg
+}
+
+func badRequest(msg string) error {
+	return &statusError{status: http.StatusBadRequest, code: CodeBadRequest, msg: msg}
+}
+
+// errorStatus maps an error to the status and the body of its response. The sentinels are checked in order,
+// so that an invalid request wrapping a not found error, e.g. a mission with an unknown prerequisite,
+// is reported as invalid.
+func errorStatus(err error) (int, Error) {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.status, Error{Code: se.code, Message: se.msg}
+	}
+	var creationErr *character.CreationError
+	if errors.As(err, &creationErr) {
+		return http.StatusUnprocessableEntity, Error{Code: CodeInvalid, Message: err.Error(), Violations: creationErr.Violations}
+	}
+	for _, m := range []struct {
+		sentinel error
+		status   int
+		code     string
+	}{
+		{errs.ErrInvalid, http.StatusUnprocessableEntity, CodeInvalid},
+		{errs.ErrNotFound, http.StatusNotFound, CodeNotFound},
+		{errs.ErrAlreadyExists, http.StatusConflict, CodeAlreadyExists},
+		{errs.ErrInvalidTransition, http.StatusConflict, CodeInvalidTransition},
+		{errs.ErrInsufficientXP, http.StatusUnprocessableEntity, CodeInsufficientXP},
+		{errs.ErrForbidden, http.StatusForbidden, CodeForbidden},
+		{errs.ErrConflict, http.StatusConflict, CodeConflict},
+		{event.ErrExpired, http.StatusGone, CodeExpired},
+		{command.ErrClosed, http.StatusServiceUnavailable, CodeUnavailable},
+		{context.Canceled, http.StatusServiceUnavailable, CodeUnavailable},
+		{context.DeadlineExceeded, http.StatusServiceUnavailable, CodeUnavailable},
+	} {
+		if errors.Is(err, m.sentinel) {
+			return m.status, Error{Code: m.code, Message: err.Error()}
+		}
+	}
+	return http.StatusInternalServerError, Error{Code: CodeInternal, Message: "internal error"}
+}
@@ -0,0 +1,531 @@
+package httpapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/service/command"
+)
+
+func (s *Server) gameRoutes() {
+	s.handle(http.MethodPost, "/games", s.createGame)
+	s.handle(http.MethodGet, "/games/{gameID}", s.getGame)
+	s.handle(http.MethodPost, "/games/{gameID}/start", s.startGame)
+	s.handle(http.MethodPost, "/games/{gameID}/end", s.endGame)
+	s.handle(http.MethodPut, "/games/{gameID}/status", s.setGameStatus)
+	s.handle(http.MethodPut, "/games/{gameID}/gamemaster", s.setGameMaster)
+	s.handle(http.MethodPut, "/games/{gameID}/rulebook", s.setRulebook)
+	s.handle(http.MethodPut, "/games/{gameID}/late-characters", s.setLateCharacters)
+	s.handle(http.MethodPut, "/games/{gameID}/payout", s.setPayout)
+	s.handle(http.MethodPost, "/games/{gameID}/players", s.joinGame)
+	s.handle(http.MethodDelete, "/games/{gameID}/players/{playerID}", s.leaveGame)
+
+	s.handle(http.MethodPut, "/games/{gameID}/adventure", s.setAdventure)
+	s.handle(http.MethodPut, "/games/{gameID}/adventure/outcome", s.setAdventureOutcome)
+	s.handle(http.MethodPost, "/games/{gameID}/adventure/end", s.endAdventure)
+	s.handle(http.MethodPost, "/games/{gameID}/adventure/missions", s.addMission)
+	s.handle(http.MethodGet, "/games/{gameID}/adventure/missions/{missionID}", s.getMission)
+	s.handle(http.MethodPost, "/games/{gameID}/adventure/missions/{missionID}/start", s.startMission)
+	s.handle(http.MethodPost, "/games/{gameID}/adventure/missions/{missionID}/fail", s.failMission)
+	s.handle(http.MethodPost, "/games/{gameID}/adventure/missions/{missionID}/objectives/{objectiveID}/complete", s.completeObjective)
+
+	s.handle(http.MethodPost, "/games/{gameID}/instances", s.proposeAction)
+	s.handle(http.MethodGet, "/games/{gameID}/instances/{instanceID}", s.getInstance)
+	s.handle(http.MethodPost, "/games/{gameID}/instances/{instanceID}/approve", s.approveAction)
+	s.handle(http.MethodPost, "/games/{gameID}/instances/{instanceID}/reject", s.rejectAction)
+	s.handle(http.MethodPost, "/games/{gameID}/instances/{instanceID}/execute", s.executeAction)
+
+	s.handle(http.MethodGet, "/games/{gameID}/xp", s.listGameXP)
+	s.handle(http.MethodPost, "/games/{gameID}/xp", s.grantXP)
+}
+
+// CreateGameRequest creates a game in Draft, or a scheduled game in the lobby if it has a start and an end time.
+type CreateGameRequest struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+}
+
+func (req *CreateGameRequest) validate() error {
+	var v violations
+	v.check(req.ID != "", "id is required")
+	v.check(req.Name != "", "name is required")
+	v.check((req.StartTime == nil) == (req.EndTime == nil), "startTime and endTime are required together")
+	return v.err()
+}
+
+func (s *Server) createGame(r *http.Request, p params) (int, any, error) {
+	var req CreateGameRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	var err error
+	if req.StartTime != nil {
+		err = s.app.Games.ScheduleGame(req.ID, req.Name, *req.StartTime, *req.EndTime)
+	} else {
+		err = s.app.Games.CreateGame(req.ID, req.Name)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusCreated, req.ID)
+}
+
+// game responds with the current state of a game.
+func (s *Server) game(status int, gameID string) (int, any, error) {
+	g, err := s.app.Games.GetGame(gameID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return status, toGame(g), nil
+}
+
+func (s *Server) getGame(r *http.Request, p params) (int, any, error) {
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+func (s *Server) startGame(r *http.Request, p params) (int, any, error) {
+	if err := s.app.Games.StartGame(p["gameID"]); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+func (s *Server) endGame(r *http.Request, p params) (int, any, error) {
+	if err := s.app.Games.EndGame(p["gameID"]); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+type SetGameStatusRequest struct {
+	Status string `json:"status"`
+}
+
+func (req *SetGameStatusRequest) validate() error {
+	_, err := gameStatuses.parse(req.Status)
+	return err
+}
+
+func (s *Server) setGameStatus(r *http.Request, p params) (int, any, error) {
+	var req SetGameStatusRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	status, _ := gameStatuses.parse(req.Status)
+	if err := s.app.Games.SetGameStatus(p["gameID"], status); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+func (req *GameMaster) validate() error {
+	var v violations
+	v.check(req.ID != "", "id is required")
+	return v.err()
+}
+
+func (s *Server) setGameMaster(r *http.Request, p params) (int, any, error) {
+	var req GameMaster
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	gm := gamemaster.GameMaster{ID: req.ID, Name: req.Name, Status: gamemaster.Active}
+	if err := s.app.Games.SetGameMaster(p["gameID"], gm); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+func (req *Rulebook) validate() error {
+	var v violations
+	v.check(req.MaxXPGrantPerCharacter >= 0, "maxXpGrantPerCharacter must not be negative")
+	v.check(req.MinCostMultiplier >= 0, "minCostMultiplier must not be negative")
+	v.check(req.MaxCostMultiplier >= req.MinCostMultiplier, "maxCostMultiplier must not be below minCostMultiplier")
+	return v.err()
+}
+
+func (s *Server) setRulebook(r *http.Request, p params) (int, any, error) {
+	var req Rulebook
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := s.app.Games.SetRulebook(p["gameID"], req.model()); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+type SetLateCharactersRequest struct {
+	Allow bool `json:"allow"`
+}
+
+func (req *SetLateCharactersRequest) validate() error {
+	return nil
+}
+
+func (s *Server) setLateCharacters(r *http.Request, p params) (int, any, error) {
+	var req SetLateCharactersRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := s.app.Games.SetAllowLateCharacters(p["gameID"], req.Allow); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+func (req *Payout) validate() error {
+	_, err := req.model()
+	return err
+}
+
+func (s *Server) setPayout(r *http.Request, p params) (int, any, error) {
+	var req Payout
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	rules, _ := req.model()
+	if err := s.app.Games.SetPayoutRules(p["gameID"], rules); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+type JoinGameRequest struct {
+	PlayerID string `json:"playerId"`
+}
+
+func (req *JoinGameRequest) validate() error {
+	var v violations
+	v.check(req.PlayerID != "", "playerId is required")
+	return v.err()
+}
+
+func (s *Server) joinGame(r *http.Request, p params) (int, any, error) {
+	var req JoinGameRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if _, err := s.app.Commands.Execute(r.Context(), command.JoinGame{GameID: p["gameID"], PlayerID: req.PlayerID}); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+func (s *Server) leaveGame(r *http.Request, p params) (int, any, error) {
+	if _, err := s.app.Commands.Execute(r.Context(), command.LeaveGame{GameID: p["gameID"], PlayerID: p["playerID"]}); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (req *Adventure) validate() error {
+	var v violations
+	_, err := adventureTypes.parse(req.Type)
+	v.check(err == nil, "%v", err)
+	return v.err()
+}
+
+func (s *Server) setAdventure(r *http.Request, p params) (int, any, error) {
+	var req Adventure
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	adventure, err := req.model()
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := s.app.Games.SetAdventure(p["gameID"], adventure); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+// GameMasterRequest is a decision of the game master directing a game.
+type GameMasterRequest struct {
+	GameMasterID string `json:"gameMasterId"`
+}
+
+func (req *GameMasterRequest) validate() error {
+	var v violations
+	v.check(req.GameMasterID != "", "gameMasterId is required")
+	return v.err()
+}
+
+type SetAdventureOutcomeRequest struct {
+	GameMasterRequest
+	Outcome string `json:"outcome"`
+}
+
+func (req *SetAdventureOutcomeRequest) validate() error {
+	var v violations
+	v.check(req.GameMasterID != "", "gameMasterId is required")
+	_, err := adventureOutcomes.parse(req.Outcome)
+	v.check(err == nil, "%v", err)
+	return v.err()
+}
+
+func (s *Server) setAdventureOutcome(r *http.Request, p params) (int, any, error) {
+	var req SetAdventureOutcomeRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	outcome, _ := adventureOutcomes.parse(req.Outcome)
+	if err := s.app.GameMasters.SetAdventureOutcome(p["gameID"], req.GameMasterID, outcome); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusOK, p["gameID"])
+}
+
+type EndAdventureRequest struct {
+	GameMasterRequest
+	AdventureID string `json:"adventureId"`
+}
+
+func (req *EndAdventureRequest) validate() error {
+	var v violations
+	v.check(req.GameMasterID != "", "gameMasterId is required")
+	v.check(req.AdventureID != "", "adventureId is required")
+	return v.err()
+}
+
+func (s *Server) endAdventure(r *http.Request, p params) (int, any, error) {
+	var req EndAdventureRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	report, err := s.app.GameMasters.EndAdventure(p["gameID"], req.GameMasterID, req.AdventureID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toReport(report), nil
+}
+
+func (req *Mission) validate() error {
+	return nil // checked by the adventure it is added to
+}
+
+func (s *Server) addMission(r *http.Request, p params) (int, any, error) {
+	var req Mission
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := s.app.Games.AddMissionToGame(p["gameID"], req.model()); err != nil {
+		return 0, nil, err
+	}
+	return s.game(http.StatusCreated, p["gameID"])
+}
+
+func (s *Server) getMission(r *http.Request, p params) (int, any, error) {
+	m, err := s.app.GameMasters.ReviewMissionProgress(p["gameID"], p["missionID"])
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toMission(m), nil
+}
+
+// changeMission applies a decision of the game master to a mission and responds with the mission.
+func (s *Server) changeMission(r *http.Request, p params, change func(gameMasterID string) error) (int, any, error) {
+	var req GameMasterRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := change(req.GameMasterID); err != nil {
+		return 0, nil, err
+	}
+	return s.getMission(r, p)
+}
+
+func (s *Server) startMission(r *http.Request, p params) (int, any, error) {
+	return s.changeMission(r, p, func(gameMasterID string) error {
+		return s.app.GameMasters.StartMission(p["gameID"], gameMasterID, p["missionID"])
+	})
+}
+
+func (s *Server) failMission(r *http.Request, p params) (int, any, error) {
+	return s.changeMission(r, p, func(gameMasterID string) error {
+		return s.app.GameMasters.FailMission(p["gameID"], gameMasterID, p["missionID"])
+	})
+}
+
+func (s *Server) completeObjective(r *http.Request, p params) (int, any, error) {
+	return s.changeMission(r, p, func(gameMasterID string) error {
+		return s.app.GameMasters.CompleteObjective(p["gameID"], gameMasterID, p["missionID"], p["objectiveID"])
+	})
+}
+
+// ProposeActionRequest is the choice of an action template for a character. A zero custom XP cost takes the
+// base cost of the template.
+type ProposeActionRequest struct {
+	CharacterID  string `json:"characterId"`
+	ActionID     string `json:"actionId"`
+	CustomXPCost int    `json:"customXpCost,omitempty"`
+}
+
+func (req *ProposeActionRequest) validate() error {
+	var v violations
+	v.check(req.CharacterID != "", "characterId is required")
+	v.check(req.ActionID != "", "actionId is required")
+	v.check(req.CustomXPCost >= 0, "customXpCost must not be negative")
+	return v.err()
+}
+
+func (s *Server) proposeAction(r *http.Request, p params) (int, any, error) {
+	var req ProposeActionRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	value, err := s.app.Commands.Execute(r.Context(), command.ProposeAction{
+		GameID:       p["gameID"],
+		CharacterID:  req.CharacterID,
+		ActionID:     req.ActionID,
+		CustomXPCost: req.CustomXPCost,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, toInstance(value.(action.ActionInstance)), nil
+}
+
+// instance responds with the current state of an action instance of a game.
+func (s *Server) instance(gameID, instanceID string) (int, any, error) {
+	ai, err := s.app.Repositories.Actions.GetActionInstanceByID(instanceID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if ai.GameID != gameID {
+		return 0, nil, errs.Newf(errs.ErrNotFound, errs.ActionInstance, instanceID, "is not an action instance of game %s", gameID)
+	}
+	return http.StatusOK, toInstance(*ai), nil
+}
+
+func (s *Server) getInstance(r *http.Request, p params) (int, any, error) {
+	return s.instance(p["gameID"], p["instanceID"])
+}
+
+// ApproveActionRequest is the approval of an action instance by the game master. The instance is approved
+// as proposed unless it carries a modification, which needs a justification.
+type ApproveActionRequest struct {
+	GameMasterRequest
+	Modification  *Modification `json:"modification,omitempty"`
+	Justification string        `json:"justification,omitempty"`
+}
+
+// Modification is the XP cost, reward and penalty the game master sets for an action instance.
+type Modification struct {
+	CustomXPCost int `json:"customXpCost"`
+	RewardXP     int `json:"rewardXp"`
+	PenaltyXP    int `json:"penaltyXp"`
+}
+
+func (req *ApproveActionRequest) validate() error {
+	var v violations
+	v.check(req.GameMasterID != "", "gameMasterId is required")
+	if m := req.Modification; m != nil {
+		v.check(req.Justification != "", "justification is required to modify an action instance")
+		v.check(m.CustomXPCost >= 0 && m.RewardXP >= 0 && m.PenaltyXP >= 0, "modification XP values must not be negative")
+	}
+	return v.err()
+}
+
+func (s *Server) approveAction(r *http.Request, p params) (int, any, error) {
+	var req ApproveActionRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	cmd := command.ApproveAction{GameID: p["gameID"], GameMasterID: req.GameMasterID, InstanceID: p["instanceID"], Justification: req.Justification}
+	if m := req.Modification; m != nil {
+		cmd.Modified = &action.ActionInstance{CustomXPCost: m.CustomXPCost, RewardXP: m.RewardXP, PenaltyXP: m.PenaltyXP}
+	}
+	if _, err := s.app.Commands.Execute(r.Context(), cmd); err != nil {
+		return 0, nil, err
+	}
+	return s.instance(p["gameID"], p["instanceID"])
+}
+
+func (s *Server) rejectAction(r *http.Request, p params) (int, any, error) {
+	var req GameMasterRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	cmd := command.RejectAction{GameID: p["gameID"], GameMasterID: req.GameMasterID, InstanceID: p["instanceID"]}
+	if _, err := s.app.Commands.Execute(r.Context(), cmd); err != nil {
+		return 0, nil, err
+	}
+	return s.instance(p["gameID"], p["instanceID"])
+}
+
+// ExecuteActionRequest is the execution of an approved action instance by its character. The server rolls
+// the outcome.
+type ExecuteActionRequest struct {
+	CharacterID string `json:"characterId"`
+}
+
+func (req *ExecuteActionRequest) validate() error {
+	var v violations
+	v.check(req.CharacterID != "", "characterId is required")
+	return v.err()
+}
+
+func (s *Server) executeAction(r *http.Request, p params) (int, any, error) {
+	var req ExecuteActionRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	value, err := s.app.Commands.Execute(r.Context(), command.ExecuteAction{
+		GameID:      p["gameID"],
+		CharacterID: req.CharacterID,
+		InstanceID:  p["instanceID"],
+		Resolver:    action.RolledOutcome{Roller: s.app.Dice},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toInstance(value.(action.ActionInstance)), nil
+}
+
+func (s *Server) listGameXP(r *http.Request, p params) (int, any, error) {
+	if _, err := s.app.Games.GetGame(p["gameID"]); err != nil {
+		return 0, nil, err
+	}
+	txs, err := s.app.XP.ListTransactionsByGame(p["gameID"])
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toTransactions(txs), nil
+}
+
+// GrantXPRequest is a change of the XP of a character by the game master, independently of actions.
+// A negative amount takes XP.
+type GrantXPRequest struct {
+	GameMasterRequest
+	CharacterID string `json:"characterId"`
+	Amount      int    `json:"amount"`
+	Reason      string `json:"reason"`
+}
+
+func (req *GrantXPRequest) validate() error {
+	var v violations
+	v.check(req.GameMasterID != "", "gameMasterId is required")
+	v.check(req.CharacterID != "", "characterId is required")
+	v.check(req.Amount != 0, "amount must not be zero")
+	v.check(req.Reason != "", "reason is required")
+	return v.err()
+}
+
+func (s *Server) grantXP(r *http.Request, p params) (int, any, error) {
+	var req GrantXPRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	cmd := command.GrantXP{GameID: p["gameID"], GameMasterID: req.GameMasterID, CharacterID: req.CharacterID, Amount: req.Amount, Reason: req.Reason}
+	if _, err := s.app.Commands.Execute(r.Context(), cmd); err != nil {
+		return 0, nil, err
+	}
+	return s.character(http.StatusOK, req.CharacterID)
+}
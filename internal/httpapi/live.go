@@ -0,0 +1,227 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/service/live"
+)
+
+// The live table of a game streams its events over a WebSocket as LiveEvents, one JSON text message each.
+// The client connects as the game master of the game, with ?gameMasterId=, or as one of its players, with
+// ?playerId=. The game master sees every event; a player sees the events of the table, such as the players
+// joining and the actions executed, and the proposals, decisions and XP changes of their own characters only.
+//
+// With ?after=, the stream starts with the retained events following that sequence number, so that a client
+// reconnecting with the sequence number of the last event it received misses none. Without it, the stream
+// starts with the next event. The stream closes with the code 1013 (try again later) when the client lags
+// behind; it may then reconnect the same way.
+
+const (
+	liveBuffer       = 64               // events waiting for a slow client before it is disconnected
+	liveWriteTimeout = 10 * time.Second // time a message has to reach the client
+	livePongTimeout  = 60 * time.Second // time the client has to answer a ping
+	livePingInterval = 45 * time.Second
+	liveReadLimit    = 512 // the messages of the clients are ignored, except the control messages
+)
+
+var upgrader = websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 4096}
+
+func (s *Server) liveRoutes() {
+	s.handleStream(http.MethodGet, "/games/{gameID}/live", s.live)
+}
+
+// LiveEvent is an event of the live table of a game. Its data depends on its kind, e.g. an ActionProposedData
+// for an ActionProposed event.
+type LiveEvent struct {
+	Sequence  uint64    `json:"sequence"`
+	Timestamp time.Time `json:"timestamp"`
+	Kind      string    `json:"kind"`
+	GameID    string    `json:"gameId"`
+	Data      any       `json:"data"`
+}
+
+type ActionProposedData struct {
+	CharacterID string `json:"characterId"`
+	InstanceID  string `json:"instanceId"`
+	ActionID    string `json:"actionId"`
+	XPCost      int    `json:"xpCost"`
+}
+
+type ActionApprovedData struct {
+	GameMasterID string `json:"gameMasterId"`
+	CharacterID  string `json:"characterId"`
+	InstanceID   string `json:"instanceId"`
+	XPCost       int    `json:"xpCost"`
+	Modified     bool   `json:"modified"`
+}
+
+type ActionRejectedData struct {
+	CharacterID string `json:"characterId"`
+	InstanceID  string `json:"instanceId"`
+}
+
+type ActionExecutedData struct {
+	CharacterID string `json:"characterId"`
+	InstanceID  string `json:"instanceId"`
+	Outcome     string `json:"outcome"`
+	XPChange    *int   `json:"xpChange,omitempty"` // unset for the players not owning the character
+}
+
+type XPChangedData struct {
+	CharacterID string `json:"characterId"`
+	ActorID     string `json:"actorId"`
+	Type        string `json:"type"`
+	Amount      int    `json:"amount"`
+	Balance     int    `json:"balance"`
+	Reason      string `json:"reason"`
+}
+
+type PlayerData struct {
+	PlayerID string `json:"playerId"`
+}
+
+type GameStatusChangedData struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type NPCAddedData struct {
+	CharacterID string `json:"characterId"`
+	Name        string `json:"name"`
+}
+
+// liveEvent converts an event to the LiveEvent a viewer with the given access sees.
+func liveEvent(env event.Envelope, access live.Access) LiveEvent {
+	result := LiveEvent{Sequence: env.Sequence, Timestamp: env.Timestamp, Kind: env.Event.Kind().String(), GameID: env.Event.Game()}
+	switch e := env.Event.(type) {
+	case event.ActionProposedEvent:
+		result.Data = ActionProposedData{CharacterID: e.CharacterID, InstanceID: e.InstanceID, ActionID: e.ActionID, XPCost: e.XPCost}
+	case event.ActionApprovedEvent:
+		result.Data = ActionApprovedData{GameMasterID: e.GameMasterID, CharacterID: e.CharacterID, InstanceID: e.InstanceID, XPCost: e.XPCost, Modified: e.Modified}
+	case event.ActionRejectedEvent:
+		result.Data = ActionRejectedData{CharacterID: e.CharacterID, InstanceID: e.InstanceID}
+	case event.ActionExecutedEvent:
+		data := ActionExecutedData{CharacterID: e.CharacterID, InstanceID: e.InstanceID, Outcome: e.Outcome}
+		if access == live.Full {
+			xpChange := e.XPChange
+			data.XPChange = &xpChange
+		}
+		result.Data = data
+	case event.XPChangedEvent:
+		result.Data = XPChangedData{CharacterID: e.CharacterID, ActorID: e.ActorID, Type: e.Type, Amount: e.Amount, Balance: e.Balance, Reason: e.Reason}
+	case event.PlayerJoinedEvent:
+		result.Data = PlayerData{PlayerID: e.PlayerID}
+	case event.PlayerLeftEvent:
+		result.Data = PlayerData{PlayerID: e.PlayerID}
+	case event.GameStatusChangedEvent:
+		result.Data = GameStatusChangedData{From: e.From, To: e.To}
+	case event.NPCAddedEvent:
+		result.Data = NPCAddedData{CharacterID: e.CharacterID, Name: e.Name}
+	}
+	return result
+}
+
+func (s *Server) live(w http.ResponseWriter, r *http.Request, p params) {
+	gameID := p["gameID"]
+	query := r.URL.Query()
+	v, err := live.NewViewer(s.app.Games, s.app.Players, gameID, query.Get("playerId"), query.Get("gameMasterId"))
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	after := s.app.Journal.Sequence()
+	if value := query.Get("after"); value != "" {
+		if after, err = strconv.ParseUint(value, 10, 64); err != nil {
+			s.writeError(w, r, errs.Invalidf("invalid request: after must be a sequence number"))
+			return
+		}
+	}
+	backlog, watcher, err := s.app.Journal.Watch(gameID, after, liveBuffer)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	defer watcher.Close()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // the upgrader responded
+	}
+	defer conn.Close()
+	gone := make(chan struct{})
+	go readLive(conn, gone)
+
+	send := func(env event.Envelope) bool {
+		access, err := v.Access(env)
+		if err != nil {
+			s.logger.Printf("%s %s: %v", r.Method, r.URL.Path, err)
+			closeLive(conn, websocket.CloseInternalServerErr, "internal error")
+			return false
+		}
+		if access != live.Hidden {
+			conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			if err := conn.WriteJSON(liveEvent(env, access)); err != nil {
+				return false
+			}
+		}
+		if v.Left(env) {
+			closeLive(conn, websocket.CloseNormalClosure, "left the game")
+			return false
+		}
+		return true
+	}
+	for _, env := range backlog {
+		if !send(env) {
+			return
+		}
+	}
+	ping := time.NewTicker(livePingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case env, ok := <-watcher.C:
+			if !ok {
+				closeLive(conn, websocket.CloseTryAgainLater, "lagging behind, reconnect after the last sequence received")
+				return
+			}
+			if !send(env) {
+				return
+			}
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteTimeout)); err != nil {
+				return
+			}
+		case <-s.done:
+			closeLive(conn, websocket.CloseGoingAway, "server shutting down")
+			return
+		case <-gone:
+			return
+		}
+	}
+}
+
+// readLive reads the messages of the client, which keeps the control messages flowing, until the connection
+// fails or the client closes it, and then closes gone.
+func readLive(conn *websocket.Conn, gone chan<- struct{}) {
+	defer close(gone)
+	conn.SetReadLimit(liveReadLimit)
+	conn.SetReadDeadline(time.Now().Add(livePongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(livePongTimeout))
+	})
+	for {
+		if _, _, err := conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+func closeLive(conn *websocket.Conn, code int, text string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(liveWriteTimeout))
+}
@@ -0,0 +1,209 @@
+package httpapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// liveServer serves the API over HTTP, for the WebSocket clients.
+type liveServer struct {
+	*testServer
+	url string
+}
+
+// newLiveServer starts game g1 directed by gm1 and joined by player p1 with character c1 and player p2 with
+// character c2, adds the action template a1 and the player p3, who does not join.
+func newLiveServer(t *testing.T) *liveServer {
+	t.Helper()
+	s := newTestServer(t)
+	s.do(http.MethodPost, "/games", CreateGameRequest{ID: "g1", Name: "The Sunless Citadel"}, http.StatusCreated, nil)
+	s.do(http.MethodPut, "/games/g1/gamemaster", GameMaster{ID: "gm1", Name: "Dana"}, http.StatusOK, nil)
+	s.do(http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Lobby"}, http.StatusOK, nil)
+	for _, id := range []string{"1", "2"} {
+		s.do(http.MethodPost, "/players", CreatePlayerRequest{ID: "p" + id, Name: "Player " + id}, http.StatusCreated, nil)
+		s.do(http.MethodPost, "/games/g1/players", JoinGameRequest{PlayerID: "p" + id}, http.StatusOK, nil)
+		s.do(http.MethodPost, "/players/p"+id+"/characters", CreateCharacterRequest{
+			GameID: "g1", ID: "c" + id, Name: "Character " + id, Class: "Ranger", Race: "Human", Method: "StandardArray",
+			Attributes: &Attributes{Strength: 12, Dexterity: 15, Constitution: 13, Intelligence: 8, Wisdom: 14, Charisma: 10},
+		}, http.StatusCreated, nil)
+	}
+	s.do(http.MethodPost, "/players", CreatePlayerRequest{ID: "p3", Name: "Player 3"}, http.StatusCreated, nil)
+	s.do(http.MethodPost, "/games/g1/start", nil, http.StatusOK, nil)
+	s.do(http.MethodPost, "/actions", Action{ID: "a1", Name: "Climb", BaseXPCost: 10, RewardXP: 20, PenaltyXP: 5}, http.StatusCreated, nil)
+
+	server := httptest.NewServer(s.handler)
+	t.Cleanup(server.Close)
+	return &liveServer{testServer: s, url: "ws" + strings.TrimPrefix(server.URL, "http") + Version + "/games/g1/live?"}
+}
+
+// dial connects to the live table of game g1 with the given query.
+func (s *liveServer) dial(query string) *websocket.Conn {
+	s.t.Helper()
+	conn, resp, err := websocket.DefaultDialer.Dial(s.url+query, nil)
+	if err != nil {
+		s.t.Fatalf("Dial(%s) error = %v, wantErr nil", query, err)
+	}
+	resp.Body.Close()
+	s.t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// next reads the next event of a live table.
+func next(t *testing.T, conn *websocket.Conn) LiveEvent {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var ev LiveEvent
+	if err := conn.ReadJSON(&ev); err != nil {
+		t.Fatalf("ReadJSON() error = %v, wantErr nil", err)
+	}
+	return ev
+}
+
+// until reads the events of a live table up to the first change of the status of the game to the given one,
+// which is included.
+func until(t *testing.T, conn *websocket.Conn, status string) []LiveEvent {
+	t.Helper()
+	var result []LiveEvent
+	for {
+		ev := next(t, conn)
+		result = append(result, ev)
+		if ev.Kind == "GameStatusChanged" && ev.Data.(map[string]any)["to"] == status {
+			return result
+		}
+	}
+}
+
+// count counts the events of each kind.
+func count(events []LiveEvent) map[string]int {
+	result := make(map[string]int)
+	for _, ev := range events {
+		result[ev.Kind]++
+	}
+	return result
+}
+
+// closeCode reads a live table until it is closed and returns the code it is closed with.
+func closeCode(t *testing.T, conn *websocket.Conn) int {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			var closeErr *websocket.CloseError
+			if !errors.As(err, &closeErr) {
+				t.Fatalf("ReadMessage() error = %v, want a close error", err)
+			}
+			return closeErr.Code
+		}
+	}
+}
+
+func TestLiveRoleFiltering(t *testing.T) {
+	s := newLiveServer(t)
+	gm := s.dial("gameMasterId=gm1")
+	p1 := s.dial("playerId=p1")
+	p2 := s.dial("playerId=p2")
+
+	var ai ActionInstance
+	s.do(http.MethodPost, "/games/g1/instances", ProposeActionRequest{CharacterID: "c1", ActionID: "a1"}, http.StatusCreated, &ai)
+	s.do(http.MethodPost, "/games/g1/instances/"+ai.ID+"/approve", ApproveActionRequest{GameMasterRequest: GameMasterRequest{GameMasterID: "gm1"}}, http.StatusOK, nil)
+	s.do(http.MethodPost, "/games/g1/instances/"+ai.ID+"/execute", ExecuteActionRequest{CharacterID: "c1"}, http.StatusOK, nil)
+	s.do(http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Paused"}, http.StatusOK, nil)
+
+	gmEvents, p1Events, p2Events := until(t, gm, "Paused"), until(t, p1, "Paused"), until(t, p2, "Paused")
+	if len(gmEvents) != len(p1Events) {
+		t.Errorf("player p1 received %d events, want the %d events of the game master", len(p1Events), len(gmEvents))
+	}
+	gmKinds := count(gmEvents)
+	for _, kind := range []string{"ActionProposed", "ActionApproved", "ActionExecuted", "XPChanged"} {
+		if gmKinds[kind] == 0 {
+			t.Errorf("game master received no %s event", kind)
+		}
+	}
+	if got := count(p2Events); len(p2Events) != 2 || got["ActionExecuted"] != 1 {
+		t.Fatalf("player p2 received %v, want the execution and the status change only", got)
+	}
+	if _, ok := p2Events[0].Data.(map[string]any)["xpChange"]; ok {
+		t.Error("player p2 sees the XP change of character c1, want it hidden")
+	}
+	for _, ev := range p1Events {
+		if _, ok := ev.Data.(map[string]any)["xpChange"]; ev.Kind == "ActionExecuted" && !ok {
+			t.Error("player p1 does not see the XP change of character c1, want it")
+		}
+	}
+}
+
+func TestLiveResume(t *testing.T) {
+	s := newLiveServer(t)
+	gm := s.dial("gameMasterId=gm1&after=0")
+	backlog := until(t, gm, "Active")
+	if got := count(backlog); got["PlayerJoined"] != 2 {
+		t.Errorf("backlog = %v, want the two players joining", got)
+	}
+	last := backlog[len(backlog)-1].Sequence
+	gm.Close()
+
+	s.do(http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Paused"}, http.StatusOK, nil)
+	gm = s.dial(fmt.Sprintf("gameMasterId=gm1&after=%d", last))
+	if ev := next(t, gm); ev.Kind != "GameStatusChanged" || ev.Sequence <= last {
+		t.Errorf("first event after resuming = %s %d, want the game paused after %d", ev.Kind, ev.Sequence, last)
+	}
+}
+
+func TestLivePlayerLeaves(t *testing.T) {
+	s := newLiveServer(t)
+	p2 := s.dial("playerId=p2")
+
+	s.do(http.MethodDelete, "/games/g1/players/p2", nil, http.StatusNoContent, nil)
+	if ev := next(t, p2); ev.Kind != "PlayerLeft" {
+		t.Errorf("event = %s, want PlayerLeft", ev.Kind)
+	}
+	if code := closeCode(t, p2); code != websocket.CloseNormalClosure {
+		t.Errorf("close code = %d, want %d", code, websocket.CloseNormalClosure)
+	}
+}
+
+func TestLiveShutdown(t *testing.T) {
+	s := newLiveServer(t)
+	gm := s.dial("gameMasterId=gm1")
+
+	s.handler.(*Server).Shutdown()
+	if code := closeCode(t, gm); code != websocket.CloseGoingAway {
+		t.Errorf("close code = %d, want %d", code, websocket.CloseGoingAway)
+	}
+}
+
+func TestLiveRejected(t *testing.T) {
+	s := newLiveServer(t)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{"no role", "", http.StatusUnprocessableEntity},
+		{"both roles", "playerId=p1&gameMasterId=gm1", http.StatusUnprocessableEntity},
+		{"other game master", "gameMasterId=gm2", http.StatusForbidden},
+		{"player not in the game", "playerId=p3", http.StatusForbidden},
+		{"invalid sequence", "playerId=p1&after=last", http.StatusUnprocessableEntity},
+		{"sequence ahead", "playerId=p1&after=100000", http.StatusGone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, resp, err := websocket.DefaultDialer.Dial(s.url+tt.query, nil)
+			if !errors.Is(err, websocket.ErrBadHandshake) {
+				t.Fatalf("Dial() error = %v, want ErrBadHandshake", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
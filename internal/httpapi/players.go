@@ -0,0 +1,165 @@
+package httpapi
+
+import (
+	"net/http"
+
+	"github.com/jerberlin/dndgame/internal/model/character"
+)
+
+func (s *Server) playerRoutes() {
+	s.handle(http.MethodPost, "/players", s.createPlayer)
+	s.handle(http.MethodGet, "/players/{playerID}", s.getPlayer)
+	s.handle(http.MethodDelete, "/players/{playerID}", s.deletePlayer)
+	s.handle(http.MethodPost, "/players/{playerID}/characters", s.createCharacter)
+	s.handle(http.MethodDelete, "/players/{playerID}/characters/{characterID}", s.removeCharacter)
+
+	s.handle(http.MethodGet, "/characters", s.listCharacters)
+	s.handle(http.MethodGet, "/characters/{characterID}", s.getCharacter)
+	s.handle(http.MethodGet, "/characters/{characterID}/xp", s.listCharacterXP)
+}
+
+type CreatePlayerRequest struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (req *CreatePlayerRequest) validate() error {
+	var v violations
+	v.check(req.ID != "", "id is required")
+	v.check(req.Name != "", "name is required")
+	return v.err()
+}
+
+func (s *Server) createPlayer(r *http.Request, p params) (int, any, error) {
+	var req CreatePlayerRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	if err := s.app.Players.CreatePlayer(req.ID, req.Name); err != nil {
+		return 0, nil, err
+	}
+	return s.player(http.StatusCreated, req.ID)
+}
+
+// player responds with the current state of a player.
+func (s *Server) player(status int, playerID string) (int, any, error) {
+	pl, err := s.app.Players.GetPlayerByID(playerID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return status, toPlayer(pl), nil
+}
+
+func (s *Server) getPlayer(r *http.Request, p params) (int, any, error) {
+	return s.player(http.StatusOK, p["playerID"])
+}
+
+func (s *Server) deletePlayer(r *http.Request, p params) (int, any, error) {
+	if err := s.app.Players.DeletePlayer(p["playerID"]); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// CreateCharacterRequest creates a character of a player for a game. Its attributes are rolled by the server
+// with the Roll3d6InOrder and Roll4d6DropLowest methods, or given with the StandardArray and PointBuy methods.
+// The character starts with character.DefaultStartingXP, and PointBuy spends character.DefaultPointBudget:
+// a player cannot choose them.
+type CreateCharacterRequest struct {
+	GameID      string      `json:"gameId"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Class       string      `json:"class"`
+	Race        string      `json:"race"`
+	Description string      `json:"description,omitempty"`
+	Method      string      `json:"method"`
+	Attributes  *Attributes `json:"attributes,omitempty"` // required by the methods assigning the attributes only
+}
+
+func (req *CreateCharacterRequest) validate() error {
+	var v violations
+	v.check(req.GameID != "", "gameId is required")
+	_, err := characterClasses.parse(req.Class)
+	v.check(err == nil, "%v", err)
+	_, err = characterRaces.parse(req.Race)
+	v.check(err == nil, "%v", err)
+	method, err := generationMethods.parse(req.Method)
+	v.check(err == nil, "%v", err)
+	if err == nil {
+		rolled := method == character.Roll3d6InOrder || method == character.Roll4d6DropLowest
+		v.check(rolled == (req.Attributes == nil), "attributes are required by the %s method only", req.Method)
+	}
+	return v.err()
+}
+
+// builder returns the builder of the character, with its attributes rolled or assigned.
+func (req *CreateCharacterRequest) builder(s *Server) *character.Builder {
+	class, _ := characterClasses.parse(req.Class)
+	race, _ := characterRaces.parse(req.Race)
+	method, _ := generationMethods.parse(req.Method)
+	b := character.NewBuilder(req.ID, req.Name, class, race).WithDescription(req.Description)
+	if req.Attributes == nil {
+		return b.RollAttributes(s.app.Dice, method)
+	}
+	return b.AssignAttributes(method, character.Attributes(*req.Attributes))
+}
+
+func (s *Server) createCharacter(r *http.Request, p params) (int, any, error) {
+	var req CreateCharacterRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	b := req.builder(s)
+	char, err := s.app.Players.CreateCharacter(p["playerID"], req.GameID, b)
+	if err != nil {
+		return 0, nil, err
+	}
+	created := CreatedCharacter{Character: toCharacter(char)}
+	for _, res := range b.Rolls() {
+		created.Rolls = append(created.Rolls, toRoll(res))
+	}
+	return http.StatusCreated, created, nil
+}
+
+func (s *Server) removeCharacter(r *http.Request, p params) (int, any, error) {
+	if err := s.app.Players.RemoveCharacterFromPlayer(p["playerID"], p["characterID"]); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (s *Server) listCharacters(r *http.Request, p params) (int, any, error) {
+	chars, err := s.app.GameMasters.ListCharacters()
+	if err != nil {
+		return 0, nil, err
+	}
+	result := make([]Character, 0, len(chars))
+	for i := range chars {
+		result = append(result, toCharacter(&chars[i]))
+	}
+	return http.StatusOK, result, nil
+}
+
+// character responds with the current state of a character.
+func (s *Server) character(status int, characterID string) (int, any, error) {
+	char, err := s.app.GameMasters.GetCharacter(characterID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return status, toCharacter(char), nil
+}
+
+func (s *Server) getCharacter(r *http.Request, p params) (int, any, error) {
+	return s.character(http.StatusOK, p["characterID"])
+}
+
+func (s *Server) listCharacterXP(r *http.Request, p params) (int, any, error) {
+	if _, err := s.app.GameMasters.GetCharacter(p["characterID"]); err != nil {
+		return 0, nil, err
+	}
+	txs, err := s.app.XP.ListTransactionsByCharacter(p["characterID"])
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toTransactions(txs), nil
+}
@@ -0,0 +1,198 @@
+// Package httpapi serves the game, player and game master services as a JSON REST API, versioned by the
+// prefix of its paths, e.g. /v1/games/g1.
+//
+// Request bodies are single JSON objects whose unknown fields are rejected. Failed requests are answered
+// with an ErrorBody whose code tells the errors of the services apart, see errorStatus. The mutations of
+// a game, such as proposing or approving an action, go through the command processor of the app so that
+// they apply one at a time. The events of a game are streamed to its players and game master over a WebSocket,
+// the live table of the game.
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// Version is the prefix of the paths of the API.
+const Version = "/v1"
+
+// maxBodySize is the size of the largest request body accepted.
+const maxBodySize = 1 << 20
+
+// Server is the http.Handler of the API.
+type Server struct {
+	app    *app.App
+	logger *log.Logger // records the internal errors, whose details are not sent to the clients
+	routes []route
+	done   chan struct{} // closed on Shutdown, ends the streams
+	once   sync.Once
+}
+
+// handler handles a request and returns the status and the body of its response, or the error it failed with.
+// A nil body is not written.
+type handler func(r *http.Request, p params) (int, any, error)
+
+// params are the values of the variable segments of the path of a request, by name.
+type params map[string]string
+
+// streamHandler handles a request taking over its connection, e.g. to upgrade it to a WebSocket.
+type streamHandler func(w http.ResponseWriter, r *http.Request, p params)
+
+type route struct {
+	method   string
+	segments []string // a segment in braces, e.g. "{gameID}", matches any value
+	handle   handler
+	stream   streamHandler // set instead of handle for the streams
+}
+
+// NewServer creates the handler of the API on the services of an app.
+func NewServer(a *app.App, logger *log.Logger) *Server {
+	s := &Server{app: a, logger: logger, done: make(chan struct{})}
+	s.gameRoutes()
+	s.playerRoutes()
+	s.actionRoutes()
+	s.liveRoutes()
+	return s
+}
+
+// Shutdown closes the streams, which http.Server.Shutdown does not wait for. Register it with
+// http.Server.RegisterOnShutdown.
+func (s *Server) Shutdown() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// handle registers the handler of a method and a path pattern below Version.
+func (s *Server) handle(method, pattern string, h handler) {
+	s.routes = append(s.routes, route{method: method, segments: split(Version + pattern), handle: h})
+}
+
+// handleStream registers the stream handler of a method and a path pattern below Version.
+func (s *Server) handleStream(method, pattern string, h streamHandler) {
+	s.routes = append(s.routes, route{method: method, segments: split(Version + pattern), stream: h})
+}
+
+func split(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := split(r.URL.Path)
+	var allowed []string
+	for _, rt := range s.routes {
+		p, ok := match(rt.segments, segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			allowed = append(allowed, rt.method)
+			continue
+		}
+		if rt.stream != nil {
+			rt.stream(w, r, p)
+		} else {
+			s.serve(w, r, rt.handle, p)
+		}
+		return
+	}
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		s.writeError(w, r, &statusError{status: http.StatusMethodNotAllowed, code: CodeMethodNotAllowed, msg: fmt.Sprintf("%s is not supported on %s", r.Method, r.URL.Path)})
+		return
+	}
+	s.writeError(w, r, &statusError{status: http.StatusNotFound, code: CodeNotFound, msg: fmt.Sprintf("no resource at %s", r.URL.Path)})
+}
+
+func match(pattern, segments []string) (params, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	p := make(params)
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if segments[i] == "" {
+				return nil, false
+			}
+			p[segment[1:len(segment)-1]] = segments[i]
+		} else if segment != segments[i] {
+			return nil, false
+		}
+	}
+	return p, true
+}
+
+// serve runs a handler and writes its response. A panicking handler gets an internal error response.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request, h handler, p params) {
+	defer func() {
+		if v := recover(); v != nil {
+			s.writeError(w, r, fmt.Errorf("panic: %v", v))
+		}
+	}()
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+	status, body, err := h(r, p)
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, status, body)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, body := errorStatus(err)
+	if status == http.StatusInternalServerError {
+		s.logger.Printf("%s %s: %v", r.Method, r.URL.Path, err)
+	}
+	writeJSON(w, status, ErrorBody{Error: body})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// request is the body of a request, which checks the rules its fields have to follow.
+type request interface {
+	validate() error
+}
+
+// decode reads the body of a request into req and validates it.
+func decode(r *http.Request, req request) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(req); err != nil {
+		return badRequest("invalid request body: " + err.Error())
+	}
+	if dec.More() {
+		return badRequest("invalid request body: a single JSON object is expected")
+	}
+	return req.validate()
+}
+
+// violations collects the rules a request breaks.
+type violations []string
+
+// check records the violation described by format unless ok.
+func (v *violations) check(ok bool, format string, args ...any) {
+	if !ok {
+		*v = append(*v, fmt.Sprintf(format, args...))
+	}
+}
+
+// err returns an error matching errs.ErrInvalid listing the violations, if any.
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return errs.Invalidf("invalid request: %s", strings.Join(v, "; "))
+}
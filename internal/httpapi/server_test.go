@@ -0,0 +1,257 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/model/character"
+)
+
+// testServer serves the API on in-memory repositories, rolling the same dice on every run.
+type testServer struct {
+	t       *testing.T
+	handler http.Handler
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	a := app.NewInMemory(clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+	a.Dice = dice.NewSeededRoller(42)
+	t.Cleanup(a.Close)
+	return &testServer{t: t, handler: NewServer(a, log.New(io.Discard, "", 0))}
+}
+
+// do sends a request with body encoded as JSON, unless it is a string sent as is, and checks the status of
+// the response. The body of the response is decoded into result unless it is nil.
+func (s *testServer) do(method, path string, body any, wantStatus int, result any) *httptest.ResponseRecorder {
+	s.t.Helper()
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			s.t.Fatalf("json.Marshal() error = %v, wantErr nil", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	rec := httptest.NewRecorder()
+	s.handler.ServeHTTP(rec, httptest.NewRequest(method, Version+path, reader))
+	if rec.Code != wantStatus {
+		s.t.Fatalf("%s %s status = %d, want %d, body %s", method, path, rec.Code, wantStatus, rec.Body)
+	}
+	if result != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), result); err != nil {
+			s.t.Fatalf("%s %s body = %s, error = %v", method, path, rec.Body, err)
+		}
+	}
+	return rec
+}
+
+// expectError sends a request and checks the status and the code of the error it fails with.
+func (s *testServer) expectError(method, path string, body any, wantStatus int, wantCode string) Error {
+	s.t.Helper()
+	var result ErrorBody
+	s.do(method, path, body, wantStatus, &result)
+	if result.Error.Code != wantCode {
+		s.t.Errorf("%s %s error code = %q, want %q (%s)", method, path, result.Error.Code, wantCode, result.Error.Message)
+	}
+	return result.Error
+}
+
+// setUpGame starts game g1 directed by gm1 and joined by player p1, whose character c1 has the standard array,
+// and adds the action template a1.
+func (s *testServer) setUpGame() {
+	s.t.Helper()
+	s.do(http.MethodPost, "/games", CreateGameRequest{ID: "g1", Name: "The Sunless Citadel"}, http.StatusCreated, nil)
+	s.do(http.MethodPut, "/games/g1/gamemaster", GameMaster{ID: "gm1", Name: "Dana"}, http.StatusOK, nil)
+	s.do(http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Lobby"}, http.StatusOK, nil)
+	s.do(http.MethodPost, "/players", CreatePlayerRequest{ID: "p1", Name: "Alex"}, http.StatusCreated, nil)
+	s.do(http.MethodPost, "/games/g1/players", JoinGameRequest{PlayerID: "p1"}, http.StatusOK, nil)
+	s.do(http.MethodPost, "/players/p1/characters", CreateCharacterRequest{
+		GameID: "g1", ID: "c1", Name: "Merric", Class: "Warrior", Race: "Dwarf", Method: "StandardArray",
+		Attributes: &Attributes{Strength: 15, Dexterity: 14, Constitution: 13, Intelligence: 12, Wisdom: 10, Charisma: 8},
+	}, http.StatusCreated, nil)
+	s.do(http.MethodPost, "/games/g1/start", nil, http.StatusOK, nil)
+	s.do(http.MethodPost, "/actions", Action{ID: "a1", Name: "Climb", BaseXPCost: 10, RewardXP: 20, PenaltyXP: 5}, http.StatusCreated, nil)
+}
+
+func TestServerGame(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	var g Game
+	s.do(http.MethodGet, "/games/g1", nil, http.StatusOK, &g)
+	if g.Status != "Active" {
+		t.Errorf("Status = %q, want Active", g.Status)
+	}
+	if g.GameMaster == nil || g.GameMaster.ID != "gm1" {
+		t.Errorf("GameMaster = %+v, want gm1", g.GameMaster)
+	}
+	if len(g.PlayerIDs) != 1 || g.PlayerIDs[0] != "p1" {
+		t.Errorf("PlayerIDs = %v, want [p1]", g.PlayerIDs)
+	}
+
+	s.do(http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Paused"}, http.StatusOK, &g)
+	if g.Status != "Paused" {
+		t.Errorf("Status = %q, want Paused", g.Status)
+	}
+	s.do(http.MethodDelete, "/games/g1/players/p1", nil, http.StatusNoContent, nil)
+	s.do(http.MethodGet, "/games/g1", nil, http.StatusOK, &g)
+	if len(g.PlayerIDs) != 0 {
+		t.Errorf("PlayerIDs = %v, want none", g.PlayerIDs)
+	}
+}
+
+func TestServerCreateCharacterRolled(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+	s.do(http.MethodPut, "/games/g1/late-characters", SetLateCharactersRequest{Allow: true}, http.StatusOK, nil)
+
+	var created CreatedCharacter
+	s.do(http.MethodPost, "/players/p1/characters", CreateCharacterRequest{
+		GameID: "g1", ID: "c2", Name: "Lyra", Class: "Wizard", Race: "Elf", Method: "Roll4d6DropLowest",
+	}, http.StatusCreated, &created)
+	if len(created.Rolls) != 6 {
+		t.Fatalf("Rolls = %d, want 6", len(created.Rolls))
+	}
+	if created.Rolls[0].Total != created.Character.Attributes.Strength {
+		t.Errorf("Strength = %d, want the first roll %d", created.Character.Attributes.Strength, created.Rolls[0].Total)
+	}
+	var pl Player
+	s.do(http.MethodGet, "/players/p1", nil, http.StatusOK, &pl)
+	if len(pl.CharacterIDs) != 2 {
+		t.Errorf("CharacterIDs = %v, want 2 characters", pl.CharacterIDs)
+	}
+
+	s.expectError(http.MethodPost, "/players/p1/characters", CreateCharacterRequest{
+		GameID: "g1", ID: "c3", Name: "Lyra", Class: "Wizard", Race: "Elf", Method: "Roll3d6InOrder",
+		Attributes: &Attributes{Strength: 10},
+	}, http.StatusUnprocessableEntity, CodeInvalid)
+	s.expectError(http.MethodPost, "/players/p1/characters", map[string]any{
+		"gameId": "g1", "id": "c3", "name": "Lyra", "class": "Wizard", "race": "Elf", "method": "Roll3d6InOrder",
+		"startingXp": 1000000,
+	}, http.StatusBadRequest, CodeBadRequest)
+	var c Character
+	s.do(http.MethodGet, "/characters/c2", nil, http.StatusOK, &c)
+	if c.XP != character.DefaultStartingXP {
+		t.Errorf("XP = %d, want the default starting XP %d", c.XP, character.DefaultStartingXP)
+	}
+}
+
+func TestServerActionLifecycle(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	var ai ActionInstance
+	s.do(http.MethodPost, "/games/g1/instances", ProposeActionRequest{CharacterID: "c1", ActionID: "a1"}, http.StatusCreated, &ai)
+	if ai.State != "Proposed" || ai.CustomXPCost != 10 {
+		t.Fatalf("instance = %+v, want Proposed with cost 10", ai)
+	}
+	path := "/games/g1/instances/" + ai.ID
+
+	var pending []ActionInstance
+	s.do(http.MethodGet, "/instances/pending", nil, http.StatusOK, &pending)
+	if len(pending) != 1 {
+		t.Errorf("pending instances = %d, want 1", len(pending))
+	}
+
+	s.expectError(http.MethodPost, path+"/approve", ApproveActionRequest{GameMasterRequest: GameMasterRequest{GameMasterID: "gm2"}}, http.StatusForbidden, CodeForbidden)
+	s.expectError(http.MethodPost, path+"/reject", GameMasterRequest{GameMasterID: "gm2"}, http.StatusForbidden, CodeForbidden)
+	s.expectError(http.MethodPost, path+"/reject", GameMasterRequest{}, http.StatusUnprocessableEntity, CodeInvalid)
+	s.do(http.MethodPost, path+"/approve", ApproveActionRequest{
+		GameMasterRequest: GameMasterRequest{GameMasterID: "gm1"},
+		Modification:      &Modification{CustomXPCost: 8, RewardXP: 30, PenaltyXP: 5},
+		Justification:     "the wall is slick with rain",
+	}, http.StatusOK, &ai)
+	if ai.State != "Approved" || ai.CustomXPCost != 8 || ai.RewardXP != 30 {
+		t.Fatalf("instance = %+v, want Approved with cost 8 and reward 30", ai)
+	}
+
+	var before Character
+	s.do(http.MethodGet, "/characters/c1", nil, http.StatusOK, &before)
+	// The outcome is rolled by the server, the player cannot choose it
+	s.expectError(http.MethodPost, path+"/execute", map[string]any{"characterId": "c1", "outcome": "Success"}, http.StatusBadRequest, CodeBadRequest)
+	s.do(http.MethodPost, path+"/execute", ExecuteActionRequest{CharacterID: "c1"}, http.StatusOK, &ai)
+	outcomeXP, ok := map[string]int{"Success": 30, "Partial": 15, "Failure": -5}[ai.Outcome]
+	if ai.State != "Resolved" || !ok {
+		t.Fatalf("instance = %+v, want Resolved with a rolled outcome", ai)
+	}
+	var after Character
+	s.do(http.MethodGet, "/characters/c1", nil, http.StatusOK, &after)
+	if want := before.XP - 8 + outcomeXP; after.XP != want {
+		t.Errorf("XP = %d, want %d after a %s", after.XP, want, ai.Outcome)
+	}
+
+	var txs []Transaction
+	s.do(http.MethodGet, "/characters/c1/xp", nil, http.StatusOK, &txs)
+	if len(txs) == 0 {
+		t.Error("XP ledger is empty, want the transactions of the action")
+	}
+	s.expectError(http.MethodPost, path+"/execute", ExecuteActionRequest{CharacterID: "c1"}, http.StatusConflict, CodeInvalidTransition)
+}
+
+func TestServerErrors(t *testing.T) {
+	s := newTestServer(t)
+	s.setUpGame()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       any
+		wantStatus int
+		wantCode   string
+	}{
+		{"unknown game", http.MethodGet, "/games/nope", nil, http.StatusNotFound, CodeNotFound},
+		{"unknown route", http.MethodGet, "/dragons", nil, http.StatusNotFound, CodeNotFound},
+		{"existing game", http.MethodPost, "/games", CreateGameRequest{ID: "g1", Name: "Again"}, http.StatusConflict, CodeAlreadyExists},
+		{"missing field", http.MethodPost, "/players", CreatePlayerRequest{ID: "p2"}, http.StatusUnprocessableEntity, CodeInvalid},
+		{"unknown field", http.MethodPost, "/players", `{"id": "p2", "name": "Sam", "level": 3}`, http.StatusBadRequest, CodeBadRequest},
+		{"malformed body", http.MethodPost, "/players", `{"id": `, http.StatusBadRequest, CodeBadRequest},
+		{"unknown status", http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Sleeping"}, http.StatusUnprocessableEntity, CodeInvalid},
+		{"invalid transition", http.MethodPut, "/games/g1/status", SetGameStatusRequest{Status: "Draft"}, http.StatusConflict, CodeInvalidTransition},
+		{"other game instance", http.MethodGet, "/games/g2/instances/i1", nil, http.StatusNotFound, CodeNotFound},
+		{"mismatched action", http.MethodPut, "/actions/a1", Action{ID: "a2", Name: "Climb"}, http.StatusUnprocessableEntity, CodeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &testServer{t: t, handler: s.handler}
+			s.expectError(tt.method, tt.path, tt.body, tt.wantStatus, tt.wantCode)
+		})
+	}
+}
+
+func TestServerMethodNotAllowed(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := s.do(http.MethodPatch, "/games/g1", nil, http.StatusMethodNotAllowed, nil)
+	if got := rec.Header().Get("Allow"); got != http.MethodGet {
+		t.Errorf("Allow = %q, want %q", got, http.MethodGet)
+	}
+	rec = s.do(http.MethodDelete, "/actions", nil, http.StatusMethodNotAllowed, nil)
+	if got := rec.Header().Get("Allow"); got != "GET, POST" {
+		t.Errorf("Allow = %q, want %q", got, "GET, POST")
+	}
+}
+
+func TestServerValidationViolations(t *testing.T) {
+	s := newTestServer(t)
+
+	e := s.expectError(http.MethodPost, "/players", CreatePlayerRequest{}, http.StatusUnprocessableEntity, CodeInvalid)
+	if !strings.Contains(e.Message, "id is required") || !strings.Contains(e.Message, "name is required") {
+		t.Errorf("Message = %q, want both violations", e.Message)
+	}
+}
@@ -0,0 +1,384 @@
+package httpapi
+
+import (
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+)
+
+// The resources of the API. The enumerations of the models are represented by their names, e.g. "Active",
+// and the times in RFC 3339.
+
+type Game struct {
+	ID                  string            `json:"id"`
+	Name                string            `json:"name"`
+	Status              string            `json:"status"`
+	StartTime           *time.Time        `json:"startTime,omitempty"` // unset until the game is scheduled or started
+	EndTime             *time.Time        `json:"endTime,omitempty"`
+	AllowLateCharacters bool              `json:"allowLateCharacters"`
+	GameMaster          *GameMaster       `json:"gameMaster,omitempty"` // unset until a game master is set to direct the game
+	PlayerIDs           []string          `json:"playerIds"`
+	Rulebook            Rulebook          `json:"rulebook"`
+	Adventure           Adventure         `json:"adventure"`
+	Payout              Payout            `json:"payout"`
+	Reports             []AdventureReport `json:"reports"`
+	Version             int               `json:"version"`
+}
+
+type GameMaster struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Rulebook struct {
+	MaxXPGrantPerCharacter int     `json:"maxXpGrantPerCharacter"`
+	MinCostMultiplier      float64 `json:"minCostMultiplier"`
+	MaxCostMultiplier      float64 `json:"maxCostMultiplier"`
+	AllowNegativeBalance   bool    `json:"allowNegativeBalance"`
+}
+
+type Adventure struct {
+	ID       string    `json:"id"`
+	Type     string    `json:"type"`
+	Outcome  string    `json:"outcome,omitempty"` // ignored when the adventure is set
+	Missions []Mission `json:"missions"`
+}
+
+type Mission struct {
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Status        string      `json:"status,omitempty"` // ignored when the mission is added
+	Objectives    []Objective `json:"objectives"`
+	Prerequisites []string    `json:"prerequisites"`
+}
+
+type Objective struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Criteria    string `json:"criteria"`
+	Optional    bool   `json:"optional"`
+	Completed   bool   `json:"completed"` // ignored when the mission is added
+}
+
+type Payout struct {
+	XPPerCompletedMission int            `json:"xpPerCompletedMission"`
+	OutcomeBonus          map[string]int `json:"outcomeBonus"` // keyed by adventure outcome
+}
+
+type AdventureReport struct {
+	AdventureID string          `json:"adventureId"`
+	Type        string          `json:"type"`
+	Outcome     string          `json:"outcome"`
+	EndedAt     time.Time       `json:"endedAt"`
+	Missions    []MissionResult `json:"missions"`
+	Standings   []Standing      `json:"standings"`
+}
+
+type MissionResult struct {
+	MissionID string `json:"missionId"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+}
+
+type Standing struct {
+	CharacterID string `json:"characterId"`
+	Name        string `json:"name"`
+	PayoutXP    int    `json:"payoutXp"`
+	FinalXP     int    `json:"finalXp"`
+}
+
+type Player struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Status       string   `json:"status"`
+	CharacterIDs []string `json:"characterIds"`
+	Version      int      `json:"version"`
+}
+
+type Character struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Class       string     `json:"class"`
+	Race        string     `json:"race"`
+	Description string     `json:"description"`
+	Attributes  Attributes `json:"attributes"`
+	XP          int        `json:"xp"`
+	Status      string     `json:"status"`
+	Version     int        `json:"version"`
+}
+
+type Attributes struct {
+	Strength     int `json:"strength"`
+	Dexterity    int `json:"dexterity"`
+	Constitution int `json:"constitution"`
+	Intelligence int `json:"intelligence"`
+	Wisdom       int `json:"wisdom"`
+	Charisma     int `json:"charisma"`
+}
+
+// CreatedCharacter is a new character, with the dice rolled for its attributes if they were rolled.
+type CreatedCharacter struct {
+	Character Character `json:"character"`
+	Rolls     []Roll    `json:"rolls,omitempty"` // one per attribute, in the order of Attributes
+}
+
+type Roll struct {
+	Notation string `json:"notation"`
+	Dice     []int  `json:"dice"` // every die rolled, in roll order
+	Kept     []int  `json:"kept"` // the dice counting towards the total
+	Total    int    `json:"total"`
+}
+
+// Action is an action template of the game.
+type Action struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	BaseXPCost int    `json:"baseXpCost"`
+	RewardXP   int    `json:"rewardXp"`
+	PenaltyXP  int    `json:"penaltyXp"`
+}
+
+type ActionInstance struct {
+	ID           string `json:"id"`
+	GameID       string `json:"gameId"`
+	CharacterID  string `json:"characterId"`
+	Action       Action `json:"action"`
+	CustomXPCost int    `json:"customXpCost"`
+	RewardXP     int    `json:"rewardXp"`
+	PenaltyXP    int    `json:"penaltyXp"`
+	State        string `json:"state"`
+	Outcome      string `json:"outcome,omitempty"` // unset until the instance is resolved
+	Version      int    `json:"version"`
+}
+
+// Transaction is an entry of the XP ledger.
+type Transaction struct {
+	Sequence    int       `json:"sequence"`
+	CharacterID string    `json:"characterId"`
+	GameID      string    `json:"gameId"`
+	ActorID     string    `json:"actorId"`
+	Type        string    `json:"type"`
+	Amount      int       `json:"amount"`
+	Reason      string    `json:"reason"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// The conversions from the models.
+
+func toGame(g *game.Game) Game {
+	result := Game{
+		ID:                  g.GameID,
+		Name:                g.Name,
+		Status:              g.Status.String(),
+		StartTime:           optionalTime(g.StartTime),
+		EndTime:             optionalTime(g.EndTime),
+		AllowLateCharacters: g.AllowLateCharacters,
+		PlayerIDs:           make([]string, 0, len(g.Players)),
+		Rulebook:            toRulebook(g.Rulebook),
+		Adventure:           toAdventure(g.Adventure),
+		Payout:              toPayout(g.Payout),
+		Reports:             make([]AdventureReport, 0, len(g.Reports)),
+		Version:             g.Version,
+	}
+	if g.GameMaster.ID != "" {
+		result.GameMaster = &GameMaster{ID: g.GameMaster.ID, Name: g.GameMaster.Name}
+	}
+	for _, p := range g.Players {
+		result.PlayerIDs = append(result.PlayerIDs, p.PlayerID)
+	}
+	for _, r := range g.Reports {
+		result.Reports = append(result.Reports, toReport(r))
+	}
+	return result
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func toRulebook(r gamemaster.Rulebook) Rulebook {
+	return Rulebook{
+		MaxXPGrantPerCharacter: r.MaxXPGrantPerCharacter,
+		MinCostMultiplier:      r.MinCostMultiplier,
+		MaxCostMultiplier:      r.MaxCostMultiplier,
+		AllowNegativeBalance:   r.AllowNegativeBalance,
+	}
+}
+
+func toAdventure(a game.Adventure) Adventure {
+	result := Adventure{ID: a.ID, Type: adventureTypes.name(a.Type), Outcome: a.Outcome.String(), Missions: make([]Mission, 0, len(a.Missions))}
+	for _, m := range a.Missions {
+		result.Missions = append(result.Missions, toMission(m))
+	}
+	return result
+}
+
+func toMission(m game.Mission) Mission {
+	result := Mission{
+		ID:            m.ID,
+		Name:          m.Name,
+		Description:   m.Description,
+		Status:        m.Status.String(),
+		Objectives:    make([]Objective, 0, len(m.Objectives)),
+		Prerequisites: append([]string{}, m.Prerequisites...),
+	}
+	for _, o := range m.Objectives {
+		result.Objectives = append(result.Objectives, Objective(o))
+	}
+	return result
+}
+
+func toPayout(r game.PayoutRules) Payout {
+	result := Payout{XPPerCompletedMission: r.XPPerCompletedMission, OutcomeBonus: make(map[string]int, len(r.OutcomeBonus))}
+	for outcome, bonus := range r.OutcomeBonus {
+		result.OutcomeBonus[outcome.String()] = bonus
+	}
+	return result
+}
+
+func toReport(r game.AdventureReport) AdventureReport {
+	result := AdventureReport{
+		AdventureID: r.AdventureID,
+		Type:        adventureTypes.name(r.Type),
+		Outcome:     r.Outcome.String(),
+		EndedAt:     r.EndedAt,
+		Missions:    make([]MissionResult, 0, len(r.Missions)),
+		Standings:   make([]Standing, 0, len(r.Standings)),
+	}
+	for _, m := range r.Missions {
+		result.Missions = append(result.Missions, MissionResult{MissionID: m.MissionID, Name: m.Name, Status: m.Status.String()})
+	}
+	for _, s := range r.Standings {
+		result.Standings = append(result.Standings, Standing(s))
+	}
+	return result
+}
+
+func toPlayer(p *player.Player) Player {
+	result := Player{ID: p.PlayerID, Name: p.Name, Status: activeNames[p.Status == player.Active], CharacterIDs: make([]string, 0, len(p.Characters)), Version: p.Version}
+	for _, c := range p.Characters {
+		result.CharacterIDs = append(result.CharacterIDs, c.CharacterID)
+	}
+	return result
+}
+
+// activeNames names the status of players and characters, which are either active or inactive.
+var activeNames = map[bool]string{true: "Active", false: "Inactive"}
+
+func toCharacter(c *character.Character) Character {
+	return Character{
+		ID:          c.CharacterID,
+		Name:        c.Name,
+		Class:       c.Class.String(),
+		Race:        c.Race.String(),
+		Description: c.Description,
+		Attributes:  Attributes(c.Attributes),
+		XP:          c.XP,
+		Status:      activeNames[c.Status == character.Active],
+		Version:     c.Version,
+	}
+}
+
+func toRoll(res dice.Result) Roll {
+	roll := Roll{Notation: res.Expression.Notation, Dice: make([]int, 0, len(res.Dice)), Kept: res.Kept(), Total: res.Total}
+	for _, d := range res.Dice {
+		roll.Dice = append(roll.Dice, d.Value)
+	}
+	return roll
+}
+
+func toAction(a action.Action) Action {
+	return Action{ID: a.ActionID, Name: a.Name, BaseXPCost: a.BaseXPCost, RewardXP: a.RewardXP, PenaltyXP: a.PenaltyXP}
+}
+
+func toInstance(ai action.ActionInstance) ActionInstance {
+	result := ActionInstance{
+		ID:           ai.InstanceID,
+		GameID:       ai.GameID,
+		CharacterID:  ai.CharacterID,
+		Action:       toAction(ai.Action),
+		CustomXPCost: ai.CustomXPCost,
+		RewardXP:     ai.RewardXP,
+		PenaltyXP:    ai.PenaltyXP,
+		State:        ai.State.String(),
+		Version:      ai.Version,
+	}
+	if ai.Outcome != action.NoOutcome {
+		result.Outcome = ai.Outcome.String()
+	}
+	return result
+}
+
+func toTransactions(txs []xp.Transaction) []Transaction {
+	result := make([]Transaction, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, Transaction{
+			Sequence:    tx.Sequence,
+			CharacterID: tx.CharacterID,
+			GameID:      tx.GameID,
+			ActorID:     tx.ActorID,
+			Type:        tx.Type.String(),
+			Amount:      tx.Amount,
+			Reason:      tx.Reason,
+			Timestamp:   tx.Timestamp,
+		})
+	}
+	return result
+}
+
+// The conversions to the models, of the resources sent by the clients.
+
+func (r Rulebook) model() gamemaster.Rulebook {
+	return gamemaster.Rulebook{
+		MaxXPGrantPerCharacter: r.MaxXPGrantPerCharacter,
+		MinCostMultiplier:      r.MinCostMultiplier,
+		MaxCostMultiplier:      r.MaxCostMultiplier,
+		AllowNegativeBalance:   r.AllowNegativeBalance,
+	}
+}
+
+func (a Adventure) model() (game.Adventure, error) {
+	adventureType, err := adventureTypes.parse(a.Type)
+	if err != nil {
+		return game.Adventure{}, err
+	}
+	result := game.Adventure{ID: a.ID, Type: adventureType}
+	for _, m := range a.Missions {
+		result.Missions = append(result.Missions, m.model())
+	}
+	return result, nil
+}
+
+func (m Mission) model() game.Mission {
+	result := game.Mission{ID: m.ID, Name: m.Name, Description: m.Description, Prerequisites: m.Prerequisites}
+	for _, o := range m.Objectives {
+		result.Objectives = append(result.Objectives, game.Objective{ID: o.ID, Description: o.Description, Criteria: o.Criteria, Optional: o.Optional})
+	}
+	return result
+}
+
+func (p Payout) model() (game.PayoutRules, error) {
+	result := game.PayoutRules{XPPerCompletedMission: p.XPPerCompletedMission, OutcomeBonus: make(map[game.AdventureOutcome]int, len(p.OutcomeBonus))}
+	for name, bonus := range p.OutcomeBonus {
+		outcome, err := adventureOutcomes.parse(name)
+		if err != nil {
+			return game.PayoutRules{}, err
+		}
+		result.OutcomeBonus[outcome] = bonus
+	}
+	return result, nil
+}
+
+func (a Action) model() action.Action {
+	return action.Action{ActionID: a.ID, Name: a.Name, BaseXPCost: a.BaseXPCost, RewardXP: a.RewardXP, PenaltyXP: a.PenaltyXP}
+}
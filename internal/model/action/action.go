@@ -1,28 +1,273 @@
 // Package action manages the action templates and instances withing the game.
 package action
 
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
 // Action represents a template for possible actions in the game.
+// RewardXP and PenaltyXP are the defaults the game master may override for each instance.
 type Action struct {
 	ActionID   string
 	Name       string
 	BaseXPCost int
+	RewardXP   int // earned on success
+	PenaltyXP  int // lost on failure
+}
+
+// Outcome defines the possible results of an executed action.
+type Outcome int
+
+const (
+	NoOutcome Outcome = iota // not resolved yet
+	Success                  // the full reward is earned
+	Partial                  // half of the reward is earned, no penalty
+	Failure                  // the penalty is lost
+)
+
+// OutcomeResolver decides the outcome of an executed action instance,
+// f.ex. from a decision of the game master or from a dice roll.
+type OutcomeResolver interface {
+	ResolveOutcome(ai ActionInstance) Outcome
+}
+
+// FixedOutcome is an OutcomeResolver that always returns itself, used when the game master decides the outcome.
+type FixedOutcome Outcome
+
+// ResolveOutcome returns the fixed outcome.
+func (o FixedOutcome) ResolveOutcome(ActionInstance) Outcome {
+	return Outcome(o)
+}
+
+// RolledOutcome is an OutcomeResolver rolling a d20 with its Roller, used when the players execute their actions:
+// below 8 the action fails, below 15 it partially succeeds, and from 15 on it succeeds.
+type RolledOutcome struct {
+	Roller *dice.Roller
+}
+
+// ResolveOutcome rolls the outcome.
+func (o RolledOutcome) ResolveOutcome(ActionInstance) Outcome {
+	switch roll := o.Roller.MustRoll("1d20").Total; {
+	case roll < 8:
+		return Failure
+	case roll < 15:
+		return Partial
+	default:
+		return Success
+	}
+}
+
+// ActionState defines the stages of the lifecycle of an action instance.
+type ActionState int
+
+const (
+	Proposed  ActionState = iota // chosen by the player, waiting for the game master
+	Modified                     // customised by the game master, still waiting for approval
+	Approved                     // approved by the game master, the player may execute it
+	Rejected                     // refused by the game master
+	Withdrawn                    // retrieved by the player before execution
+	Executed                     // executed by the player, waiting for the outcome
+	Resolved                     // the outcome has been applied
+	Expired                      // not executed in time
+)
+
+// transitions lists the states an action instance may move to from each state.
+// States without an entry are final.
+var transitions = map[ActionState][]ActionState{
+	Proposed: {Modified, Approved, Rejected, Withdrawn, Expired},
+	Modified: {Modified, Approved, Rejected, Withdrawn, Expired},
+	Approved: {Executed, Withdrawn, Expired},
+	Executed: {Resolved},
+}
+
+// InvalidTransitionError is returned when an action instance is asked to move to a state not reachable from its current one.
+type InvalidTransitionError struct {
+	From ActionState
+	To   ActionState
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("invalid action instance transition from %s to %s", e.From, e.To)
+}
+
+func (e *InvalidTransitionError) Is(target error) bool {
+	return target == errs.ErrInvalidTransition
 }
 
 // ActionInstance represents a specific action taken by a character, customised to them and to a given scenario
 // The action will be chosen by the player of the character but has to be approved by the game master.
 type ActionInstance struct {
+	InstanceID   string
 	Action       Action
+	GameID       string
 	CharacterID  string
 	CustomXPCost int
-	Approved     bool
+	RewardXP     int
+	PenaltyXP    int
+	State        ActionState
+	Outcome      Outcome
+	Version      int // set by the repository, which rejects updates of an outdated version
+}
+
+// IDGenerator produces unique identifiers for new action instances.
+type IDGenerator func() string
+
+var generateID IDGenerator = RandomID
+
+// SetIDGenerator replaces the generator used by CreateInstance and returns the previous one,
+// so that tests can make instance IDs deterministic and restore the default afterwards.
+func SetIDGenerator(gen IDGenerator) IDGenerator {
+	previous := generateID
+	generateID = gen
+	return previous
 }
 
-// CreateInstance creates a new action instance customized for a character.
-func (a *Action) CreateInstance(characterID string, customXPCost int) ActionInstance {
+// RandomID is the default IDGenerator, returning 128 random bits as a hex string.
+func RandomID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("action: cannot generate instance ID: %v", err))
+	}
+	return hex.EncodeToString(b)
+}
+
+// SequentialIDGenerator returns an IDGenerator yielding prefix-1, prefix-2, and so on. It is safe for concurrent use.
+func SequentialIDGenerator(prefix string) IDGenerator {
+	var counter int64
+	return func() string {
+		return fmt.Sprintf("%s-%d", prefix, atomic.AddInt64(&counter, 1))
+	}
+}
+
+// CreateInstance creates a new action instance customized for a character in a game, with a freshly generated ID.
+func (a *Action) CreateInstance(gameID, characterID string, customXPCost int) ActionInstance {
 	return ActionInstance{
+		InstanceID:   generateID(),
 		Action:       *a,
+		GameID:       gameID,
 		CharacterID:  characterID,
 		CustomXPCost: customXPCost,
-		Approved:     false,
+		RewardXP:     a.RewardXP,
+		PenaltyXP:    a.PenaltyXP,
+		State:        Proposed,
+	}
+}
+
+// CanTransitionTo reports whether the instance may move from its current state to the given one.
+func (ai *ActionInstance) CanTransitionTo(to ActionState) bool {
+	for _, allowed := range transitions[ai.State] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// transition moves the instance to a new state, or returns an InvalidTransitionError leaving it untouched.
+func (ai *ActionInstance) transition(to ActionState) error {
+	if !ai.CanTransitionTo(to) {
+		return &InvalidTransitionError{From: ai.State, To: to}
+	}
+	ai.State = to
+	return nil
+}
+
+// Modify lets the game master change the XP cost, reward and penalty of a pending instance before approving it.
+func (ai *ActionInstance) Modify(customXPCost, rewardXP, penaltyXP int) error {
+	if customXPCost < 0 || rewardXP < 0 || penaltyXP < 0 {
+		return errs.Invalidf("action instance XP values must not be negative")
+	}
+	if err := ai.transition(Modified); err != nil {
+		return err
+	}
+	ai.CustomXPCost = customXPCost
+	ai.RewardXP = rewardXP
+	ai.PenaltyXP = penaltyXP
+	return nil
+}
+
+// Approve marks a pending instance as approved by the game master.
+func (ai *ActionInstance) Approve() error {
+	return ai.transition(Approved)
+}
+
+// Reject marks a pending instance as refused by the game master.
+func (ai *ActionInstance) Reject() error {
+	return ai.transition(Rejected)
+}
+
+// Withdraw lets the player retrieve an instance that has not been executed yet.
+func (ai *ActionInstance) Withdraw() error {
+	return ai.transition(Withdrawn)
+}
+
+// Execute marks an approved instance as executed by the player.
+func (ai *ActionInstance) Execute() error {
+	return ai.transition(Executed)
+}
+
+// Resolve records the outcome of an executed instance.
+func (ai *ActionInstance) Resolve(outcome Outcome) error {
+	if outcome == NoOutcome {
+		return errs.Invalidf("action instance cannot be resolved without an outcome")
+	}
+	if err := ai.transition(Resolved); err != nil {
+		return err
+	}
+	ai.Outcome = outcome
+	return nil
+}
+
+// OutcomeXP returns the signed XP change the outcome of the instance gives to the character:
+// the reward on success, half of it (rounded down) on a partial success, and minus the penalty on failure.
+func (ai *ActionInstance) OutcomeXP() int {
+	switch ai.Outcome {
+	case Success:
+		return ai.RewardXP
+	case Partial:
+		return ai.RewardXP / 2
+	case Failure:
+		return -ai.PenaltyXP
+	default:
+		return 0
+	}
+}
+
+// Expire marks an instance that was not executed in time.
+func (ai *ActionInstance) Expire() error {
+	return ai.transition(Expired)
+}
+
+// IsPending reports whether the instance is waiting for a decision of the game master.
+func (ai *ActionInstance) IsPending() bool {
+	return ai.State == Proposed || ai.State == Modified
+}
+
+// IsFinal reports whether the instance has reached a state it cannot leave.
+func (ai *ActionInstance) IsFinal() bool {
+	return len(transitions[ai.State]) == 0
+}
+
+// String returns the string representation of the ActionState.
+func (s ActionState) String() string {
+	stateNames := [...]string{"Proposed", "Modified", "Approved", "Rejected", "Withdrawn", "Executed", "Resolved", "Expired"}
+	if s < 0 || int(s) >= len(stateNames) {
+		return fmt.Sprintf("ActionState(%d)", int(s))
+	}
+	return stateNames[s]
+}
+
+// String returns the string representation of the Outcome.
+func (o Outcome) String() string {
+	outcomeNames := [...]string{"NoOutcome", "Success", "Partial", "Failure"}
+	if o < 0 || int(o) >= len(outcomeNames) {
+		return fmt.Sprintf("Outcome(%d)", int(o))
 	}
+	return outcomeNames[o]
 }
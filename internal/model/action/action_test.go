@@ -1,7 +1,10 @@
 package action
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/jerberlin/dndgame/internal/dice"
 )
 
 func TestActionCreation(t *testing.T) {
@@ -22,9 +25,139 @@ func TestCreateInstance(t *testing.T) {
 		Name:       "Fireball",
 		BaseXPCost: 50,
 	}
-	instance := action.CreateInstance("char123", 60)
+	instance := action.CreateInstance("game1", "char123", 60)
 
-	if instance.Action != action || instance.CharacterID != "char123" || instance.CustomXPCost != 60 || instance.Approved {
+	if instance.Action != action || instance.GameID != "game1" || instance.CharacterID != "char123" || instance.CustomXPCost != 60 || instance.State != Proposed {
 		t.Errorf("CreateInstance did not properly initialize, got: %+v", instance)
 	}
+	if instance.InstanceID == "" {
+		t.Errorf("CreateInstance did not generate an instance ID")
+	}
+	if other := action.CreateInstance("game1", "char123", 60); other.InstanceID == instance.InstanceID {
+		t.Errorf("CreateInstance generated the same ID twice: %s", instance.InstanceID)
+	}
+}
+
+func TestSetIDGenerator(t *testing.T) {
+	previous := SetIDGenerator(SequentialIDGenerator("ai"))
+	defer SetIDGenerator(previous)
+
+	action := Action{ActionID: "a1", Name: "Fireball", BaseXPCost: 50}
+	first := action.CreateInstance("game1", "char123", 50)
+	second := action.CreateInstance("game1", "char123", 50)
+
+	if first.InstanceID != "ai-1" || second.InstanceID != "ai-2" {
+		t.Errorf("SetIDGenerator did not make IDs deterministic, got: %s, %s", first.InstanceID, second.InstanceID)
+	}
+}
+
+func TestActionInstanceLifecycle(t *testing.T) {
+	action := Action{ActionID: "a1", Name: "Fireball", BaseXPCost: 50}
+	instance := action.CreateInstance("game1", "char123", 50)
+
+	if err := instance.Modify(40, 30, 10); err != nil || instance.State != Modified || instance.CustomXPCost != 40 || instance.RewardXP != 30 {
+		t.Fatalf("Modify failed, err: %v, got: %+v", err, instance)
+	}
+	if err := instance.Approve(); err != nil || instance.State != Approved {
+		t.Fatalf("Approve failed, err: %v, got: %+v", err, instance)
+	}
+	if err := instance.Execute(); err != nil || instance.State != Executed {
+		t.Fatalf("Execute failed, err: %v, got: %+v", err, instance)
+	}
+	if err := instance.Resolve(Success); err != nil || instance.State != Resolved || instance.Outcome != Success {
+		t.Fatalf("Resolve failed, err: %v, got: %+v", err, instance)
+	}
+	if !instance.IsFinal() {
+		t.Errorf("Resolved instance should be final")
+	}
+}
+
+func TestActionInstanceInvalidTransitions(t *testing.T) {
+	action := Action{ActionID: "a1", Name: "Fireball", BaseXPCost: 50}
+	tests := []struct {
+		name  string
+		setup func(ai *ActionInstance)
+		move  func(ai *ActionInstance) error
+		from  ActionState
+		to    ActionState
+	}{
+		{"execute unapproved", func(ai *ActionInstance) {}, (*ActionInstance).Execute, Proposed, Executed},
+		{"modify approved", func(ai *ActionInstance) { ai.Approve() }, func(ai *ActionInstance) error { return ai.Modify(10, 0, 0) }, Approved, Modified},
+		{"approve rejected", func(ai *ActionInstance) { ai.Reject() }, (*ActionInstance).Approve, Rejected, Approved},
+		{"withdraw executed", func(ai *ActionInstance) { ai.Approve(); ai.Execute() }, (*ActionInstance).Withdraw, Executed, Withdrawn},
+		{"resolve approved", func(ai *ActionInstance) { ai.Approve() }, func(ai *ActionInstance) error { return ai.Resolve(Success) }, Approved, Resolved},
+		{"expire resolved", func(ai *ActionInstance) { ai.Approve(); ai.Execute(); ai.Resolve(Failure) }, (*ActionInstance).Expire, Resolved, Expired},
+	}
+	for _, tt := range tests {
+		instance := action.CreateInstance("game1", "char123", 50)
+		tt.setup(&instance)
+		err := tt.move(&instance)
+
+		var transitionErr *InvalidTransitionError
+		if !errors.As(err, &transitionErr) {
+			t.Errorf("%s: expected InvalidTransitionError, got: %v", tt.name, err)
+			continue
+		}
+		if transitionErr.From != tt.from || transitionErr.To != tt.to {
+			t.Errorf("%s: expected transition %s -> %s, got: %s -> %s", tt.name, tt.from, tt.to, transitionErr.From, transitionErr.To)
+		}
+		if instance.State != tt.from {
+			t.Errorf("%s: state changed after invalid transition, got: %s", tt.name, instance.State)
+		}
+	}
+}
+
+func TestOutcomeXP(t *testing.T) {
+	action := Action{ActionID: "a1", Name: "Fireball", BaseXPCost: 50, RewardXP: 25, PenaltyXP: 10}
+	tests := []struct {
+		outcome Outcome
+		want    int
+	}{
+		{Success, 25},
+		{Partial, 12},
+		{Failure, -10},
+	}
+	for _, tt := range tests {
+		instance := action.CreateInstance("game1", "char123", 50)
+		instance.Approve()
+		instance.Execute()
+		if err := instance.Resolve(FixedOutcome(tt.outcome).ResolveOutcome(instance)); err != nil {
+			t.Fatalf("Resolve(%s) failed: %v", tt.outcome, err)
+		}
+		if got := instance.OutcomeXP(); got != tt.want {
+			t.Errorf("OutcomeXP() for %s = %d, want %d", tt.outcome, got, tt.want)
+		}
+	}
+
+	instance := action.CreateInstance("game1", "char123", 50)
+	instance.Approve()
+	instance.Execute()
+	if err := instance.Resolve(NoOutcome); err == nil {
+		t.Errorf("Expected an error when resolving without an outcome, but got none")
+	}
+}
+
+// face is a dice.Source always rolling the same face.
+type face int
+
+func (f face) Intn(int) int { return int(f) - 1 }
+
+func TestRolledOutcome(t *testing.T) {
+	tests := []struct {
+		roll    int
+		outcome Outcome
+	}{
+		{1, Failure},
+		{7, Failure},
+		{8, Partial},
+		{14, Partial},
+		{15, Success},
+		{20, Success},
+	}
+	for _, tt := range tests {
+		resolver := RolledOutcome{Roller: dice.NewRoller(face(tt.roll))}
+		if got := resolver.ResolveOutcome(ActionInstance{}); got != tt.outcome {
+			t.Errorf("ResolveOutcome() rolling %d = %v, want %v", tt.roll, got, tt.outcome)
+		}
+	}
 }
@@ -1,7 +1,10 @@
 // Package character manages player and non-player characters within the game.
 package character
 
-import "github.com/jerberlin/dndgame/internal/model/action"
+import (
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+)
 
 // CharacterClass defines common classes a character may belong to.
 type CharacterClass int
@@ -51,12 +54,27 @@ type Character struct {
 	Race            CharacterRace
 	Description     string
 	Attributes      Attributes
+	XP              int // current balance, every change is recorded in the XP ledger
 	Status          CharacterStatus
 	ActionInstances []action.ActionInstance
+	Version         int // set by the repository, which rejects updates of an outdated version
+}
+
+// Clone returns a deep copy of the character, which can be changed without affecting the character.
+func (c *Character) Clone() *Character {
+	clone := *c
+	if c.ActionInstances != nil {
+		clone.ActionInstances = append(make([]action.ActionInstance, 0, len(c.ActionInstances)), c.ActionInstances...)
+	}
+	return &clone
 }
 
 // NewCharacter creates a new character with specified attributes and characteristics.
-func NewCharacter(id, name string, class CharacterClass, race CharacterRace, desc string, attrs Attributes) *Character {
+// The class, race and attributes are validated against the rules table in use.
+func NewCharacter(id, name string, class CharacterClass, race CharacterRace, desc string, attrs Attributes) (*Character, error) {
+	if violations := Rules().Violations(class, race, attrs); len(violations) > 0 {
+		return nil, &CreationError{Violations: violations}
+	}
 	return &Character{
 		CharacterID:     id,
 		Name:            name,
@@ -66,14 +84,19 @@ func NewCharacter(id, name string, class CharacterClass, race CharacterRace, des
 		Attributes:      attrs,
 		Status:          Active, // default, can be changed as needed
 		ActionInstances: []action.ActionInstance{},
-	}
+	}, nil
 }
 
 // ChooseAction makes the choice to perform an action by a character. The action needs approval to be effectively executed.
-func (c *Character) ChooseAction(act action.Action, customXPCost int) {
-	actionInstance := act.CreateInstance(c.CharacterID, customXPCost)
+// The new instance starts in the Proposed state and is returned so it can be handed to the game master;
+// the player service notifies the game master by publishing it on the event bus.
+func (c *Character) ChooseAction(gameID string, act action.Action, customXPCost int) (action.ActionInstance, error) {
+	if c.Status != Active {
+		return action.ActionInstance{}, errs.Invalidf("inactive characters cannot choose actions")
+	}
+	actionInstance := act.CreateInstance(gameID, c.CharacterID, customXPCost)
 	c.ActionInstances = append(c.ActionInstances, actionInstance)
-	// TODO Optional: Notify game master for approval
+	return actionInstance, nil
 }
 
 // SetStatus changes the status of the character.
@@ -81,19 +104,45 @@ func (c *Character) SetStatus(newStatus CharacterStatus) {
 	c.Status = newStatus
 }
 
+// AdjustXP changes the XP balance of the character by a signed amount.
+func (c *Character) AdjustXP(amount int) {
+	c.XP += amount
+}
+
 // UpdateAttributes updates the attributes of a character.
 func (c *Character) UpdateAttributes(attrs Attributes) {
 	c.Attributes = attrs
 }
 
+var classNames = [...]string{"Wizard", "Warrior", "Cleric", "Ranger"}
+var raceNames = [...]string{"Human", "Elf", "Dwarf", "Orc", "Ghost"}
+
 // ClassString returns the string representation of the CharacterClass.
 func (c CharacterClass) String() string {
-	classNames := [...]string{"Wizard", "Warrior", "Cleric", "Ranger"}
 	return classNames[c]
 }
 
 // RaceString returns the string representation of the CharacterRace.
 func (r CharacterRace) String() string {
-	raceNames := [...]string{"Human", "Elf", "Dwarf", "Orc", "Ghost"}
 	return raceNames[r]
 }
+
+// ParseClass returns the CharacterClass with the given name.
+func ParseClass(name string) (CharacterClass, error) {
+	for i, n := range classNames {
+		if n == name {
+			return CharacterClass(i), nil
+		}
+	}
+	return 0, errs.Invalidf("unknown character class %q", name)
+}
+
+// ParseRace returns the CharacterRace with the given name.
+func ParseRace(name string) (CharacterRace, error) {
+	for i, n := range raceNames {
+		if n == name {
+			return CharacterRace(i), nil
+		}
+	}
+	return 0, errs.Invalidf("unknown character race %q", name)
+}
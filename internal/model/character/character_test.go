@@ -8,25 +8,57 @@ import (
 
 func TestNewCharacter(t *testing.T) {
 	attrs := Attributes{Strength: 10, Dexterity: 12, Constitution: 14, Intelligence: 18, Wisdom: 13, Charisma: 11}
-	char := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", attrs)
+	char, err := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", attrs)
 
-	if char.Name != "Zanaphia Starfire" || char.Class != Wizard || char.Race != Human || char.Status != Active {
+	if err != nil || char.Name != "Zanaphia Starfire" || char.Class != Wizard || char.Race != Human || char.Status != Active {
 		t.Errorf("NewCharacter failed to correctly initialize, got: %+v", char)
 	}
 }
 
+func TestNewCharacterValidatesRules(t *testing.T) {
+	attrs := Attributes{Strength: 10, Dexterity: 12, Constitution: 14, Intelligence: 18, Wisdom: 13, Charisma: 11}
+	if _, err := NewCharacter("char1", "Casper", Cleric, Ghost, "A restless spirit", attrs); err == nil {
+		t.Errorf("Expected an error when a Ghost is created as a Cleric, but got none")
+	}
+	if _, err := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", Attributes{}); err == nil {
+		t.Errorf("Expected an error when attributes are out of range, but got none")
+	}
+}
+
+func TestParseClassAndRace(t *testing.T) {
+	class, err := ParseClass("Ranger")
+	if err != nil || class != Ranger {
+		t.Errorf("ParseClass failed, expected Ranger, got: %v, %v", class, err)
+	}
+	race, err := ParseRace("Dwarf")
+	if err != nil || race != Dwarf {
+		t.Errorf("ParseRace failed, expected Dwarf, got: %v, %v", race, err)
+	}
+	if _, err := ParseRace("Hobbit"); err == nil {
+		t.Errorf("Expected an error when parsing an unknown race, but got none")
+	}
+}
+
 func TestChooseAction(t *testing.T) {
-	char := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", Attributes{})
+	char := newTestCharacter(t)
 	act := action.Action{ActionID: "act1", Name: "Firebolt", BaseXPCost: 5}
-	char.ChooseAction(act, 10)
+	instance, err := char.ChooseAction("game1", act, 10)
 
-	if len(char.ActionInstances) != 1 || char.ActionInstances[0].CustomXPCost != 10 {
+	if err != nil || len(char.ActionInstances) != 1 || char.ActionInstances[0].CustomXPCost != 10 {
 		t.Errorf("ChooseAction did not correctly add an action instance, got: %+v", char.ActionInstances)
 	}
+	if instance.State != action.Proposed {
+		t.Errorf("ChooseAction should propose the action, got state: %v", instance.State)
+	}
+
+	char.SetStatus(Inactive)
+	if _, err := char.ChooseAction("game1", act, 10); err == nil {
+		t.Errorf("Expected an error when an inactive character chooses an action, but got none")
+	}
 }
 
 func TestSetStatus(t *testing.T) {
-	char := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", Attributes{})
+	char := newTestCharacter(t)
 	char.SetStatus(Inactive)
 	if char.Status != Inactive {
 		t.Errorf("SetStatus failed to update character status, expected Inactive, got: %v", char.Status)
@@ -34,7 +66,7 @@ func TestSetStatus(t *testing.T) {
 }
 
 func TestUpdateAttributes(t *testing.T) {
-	char := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", Attributes{Strength: 10})
+	char := newTestCharacter(t)
 	newAttrs := Attributes{Strength: 15}
 	char.UpdateAttributes(newAttrs)
 
@@ -42,3 +74,22 @@ func TestUpdateAttributes(t *testing.T) {
 		t.Errorf("UpdateAttributes failed to update character attributes, expected 15, got: %d", char.Attributes.Strength)
 	}
 }
+
+func TestAdjustXP(t *testing.T) {
+	char := newTestCharacter(t)
+	char.AdjustXP(100)
+	char.AdjustXP(-30)
+
+	if char.XP != 70 {
+		t.Errorf("AdjustXP failed to update character XP, expected 70, got: %d", char.XP)
+	}
+}
+
+func newTestCharacter(t *testing.T) *Character {
+	attrs := Attributes{Strength: 10, Dexterity: 12, Constitution: 14, Intelligence: 18, Wisdom: 13, Charisma: 11}
+	char, err := NewCharacter("char1", "Zanaphia Starfire", Wizard, Human, "A brilliant scholar", attrs)
+	if err != nil {
+		t.Fatalf("NewCharacter failed: %v", err)
+	}
+	return char
+}
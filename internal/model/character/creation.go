@@ -0,0 +1,214 @@
+package character
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+const (
+	MinAttribute       = 3   // lowest value an attribute may have
+	MaxAttribute       = 18  // highest value an attribute may have
+	DefaultPointBudget = 27  // points to spend with the point-buy method
+	DefaultStartingXP  = 100 // XP granted to a new character
+)
+
+// GenerationMethod defines how the attributes of a new character are obtained.
+type GenerationMethod int
+
+const (
+	Roll3d6InOrder      GenerationMethod = iota // roll 3d6 for each attribute, in order
+	Roll4d6DropLowest                           // roll 4d6 and drop the lowest die for each attribute, in order
+	StandardArrayMethod                         // assign the values of the standard array in any order
+	PointBuyMethod                              // buy each attribute from 8 to 15 with a budget of points
+)
+
+// StandardArray is the fixed set of values to distribute with the standard-array method.
+var StandardArray = [6]int{15, 14, 13, 12, 10, 8}
+
+// pointBuyCosts is the price of each attribute value with the point-buy method.
+var pointBuyCosts = map[int]int{8: 0, 9: 1, 10: 2, 11: 3, 12: 4, 13: 5, 14: 7, 15: 9}
+
+// CreationError lists every rule a new character breaks. It matches errs.ErrInvalid.
+type CreationError struct {
+	Violations []string
+}
+
+func (e *CreationError) Error() string {
+	return "invalid character: " + strings.Join(e.Violations, "; ")
+}
+
+func (e *CreationError) Is(target error) bool {
+	return target == errs.ErrInvalid
+}
+
+// Builder creates characters according to the rules of the game.
+// The attributes are rolled or assigned with one of the generation methods and validated by Build.
+type Builder struct {
+	id          string
+	name        string
+	class       CharacterClass
+	race        CharacterRace
+	description string
+	method      GenerationMethod
+	attrs       Attributes
+	attrsSet    bool
+	rolls       []dice.Result
+	pointBudget int
+	startingXP  int
+}
+
+// NewBuilder starts the creation of a character.
+func NewBuilder(id, name string, class CharacterClass, race CharacterRace) *Builder {
+	return &Builder{
+		id:          id,
+		name:        name,
+		class:       class,
+		race:        race,
+		pointBudget: DefaultPointBudget,
+		startingXP:  DefaultStartingXP,
+	}
+}
+
+// WithDescription sets the description of the character.
+func (b *Builder) WithDescription(desc string) *Builder {
+	b.description = desc
+	return b
+}
+
+// WithPointBudget changes the points available with the point-buy method.
+func (b *Builder) WithPointBudget(points int) *Builder {
+	b.pointBudget = points
+	return b
+}
+
+// WithStartingXP changes the XP the character is granted on creation.
+func (b *Builder) WithStartingXP(xp int) *Builder {
+	b.startingXP = xp
+	return b
+}
+
+// RollAttributes rolls every attribute in order with one of the rolling methods.
+func (b *Builder) RollAttributes(roller *dice.Roller, method GenerationMethod) *Builder {
+	notation := "3d6"
+	if method == Roll4d6DropLowest {
+		notation = "4d6dl1"
+	}
+	b.method = method
+	b.rolls = make([]dice.Result, 0, 6)
+	var values [6]int
+	for i := range values {
+		res := roller.MustRoll(notation)
+		b.rolls = append(b.rolls, res)
+		values[i] = res.Total
+	}
+	b.attrs = attributesFromValues(values)
+	b.attrsSet = true
+	return b
+}
+
+// AssignAttributes sets attributes chosen by the player with the standard-array or the point-buy method.
+func (b *Builder) AssignAttributes(method GenerationMethod, attrs Attributes) *Builder {
+	b.method = method
+	b.attrs = attrs
+	b.attrsSet = true
+	b.rolls = nil
+	return b
+}
+
+// Rolls returns the dice rolled for each attribute, in order, if a rolling method was used.
+func (b *Builder) Rolls() []dice.Result {
+	return b.rolls
+}
+
+// StartingXP returns the XP the character is to be granted on creation.
+func (b *Builder) StartingXP() int {
+	return b.startingXP
+}
+
+// Build validates the character against the rules and creates it.
+// Every broken rule is reported at once in a CreationError.
+func (b *Builder) Build() (*Character, error) {
+	var violations []string
+	if b.id == "" {
+		violations = append(violations, "character ID is required")
+	}
+	if b.name == "" {
+		violations = append(violations, "name is required")
+	}
+	if b.startingXP < 0 {
+		violations = append(violations, fmt.Sprintf("starting XP %d must not be negative", b.startingXP))
+	}
+	if !b.attrsSet {
+		violations = append(violations, "attributes have not been rolled or assigned")
+	} else {
+		violations = append(violations, b.attributeViolations()...)
+	}
+	if len(violations) > 0 {
+		return nil, &CreationError{Violations: violations}
+	}
+	return NewCharacter(b.id, b.name, b.class, b.race, b.description, b.attrs)
+}
+
+// attributeViolations checks the attributes against the rules table and the generation method.
+func (b *Builder) attributeViolations() []string {
+	violations := Rules().Violations(b.class, b.race, b.attrs)
+
+	switch b.method {
+	case StandardArrayMethod:
+		values := b.attrs.values()
+		sort.Sort(sort.Reverse(sort.IntSlice(values[:])))
+		if values != StandardArray {
+			violations = append(violations, fmt.Sprintf("attributes must use each value of the standard array %v once", StandardArray))
+		}
+	case PointBuyMethod:
+		spent := 0
+		for _, a := range b.attrs.named() {
+			cost, ok := pointBuyCosts[a.value]
+			if !ok {
+				violations = append(violations, fmt.Sprintf("%s %d cannot be bought, point-buy values range from 8 to 15", a.name, a.value))
+				continue
+			}
+			spent += cost
+		}
+		if spent > b.pointBudget {
+			violations = append(violations, fmt.Sprintf("attributes cost %d points, the budget is %d", spent, b.pointBudget))
+		}
+	}
+	return violations
+}
+
+type namedAttribute struct {
+	name  string
+	value int
+}
+
+// named returns the attributes with their names, in the canonical order.
+func (a Attributes) named() []namedAttribute {
+	return []namedAttribute{
+		{"Strength", a.Strength},
+		{"Dexterity", a.Dexterity},
+		{"Constitution", a.Constitution},
+		{"Intelligence", a.Intelligence},
+		{"Wisdom", a.Wisdom},
+		{"Charisma", a.Charisma},
+	}
+}
+
+func (a Attributes) values() [6]int {
+	return [6]int{a.Strength, a.Dexterity, a.Constitution, a.Intelligence, a.Wisdom, a.Charisma}
+}
+
+func attributesFromValues(v [6]int) Attributes {
+	return Attributes{
+		Strength:     v[0],
+		Dexterity:    v[1],
+		Constitution: v[2],
+		Intelligence: v[3],
+		Wisdom:       v[4],
+		Charisma:     v[5],
+	}
+}
@@ -0,0 +1,75 @@
+package character
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/dice"
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+func TestBuilderRollAttributes(t *testing.T) {
+	// Rolled attributes may miss the class minimums, which are tested with the rules table.
+	previous := SetRules(&RulesTable{Races: map[CharacterRace]RaceRules{Human: {}}, Classes: map[CharacterClass]ClassRules{Ranger: {}}})
+	defer SetRules(previous)
+
+	for _, method := range []GenerationMethod{Roll3d6InOrder, Roll4d6DropLowest} {
+		b := NewBuilder("char1", "Lysias", Ranger, Human).RollAttributes(dice.NewSeededRoller(7), method)
+		char, err := b.Build()
+		if err != nil {
+			t.Fatalf("Build failed for method %v: %v", method, err)
+		}
+		if len(b.Rolls()) != 6 || b.Rolls()[0].Total != char.Attributes.Strength || b.Rolls()[5].Total != char.Attributes.Charisma {
+			t.Errorf("RollAttributes did not assign the rolls in order, got: %+v", char.Attributes)
+		}
+		if b.StartingXP() != DefaultStartingXP {
+			t.Errorf("Builder starting XP expected %d, got: %d", DefaultStartingXP, b.StartingXP())
+		}
+	}
+}
+
+func TestBuilderStandardArray(t *testing.T) {
+	attrs := Attributes{Strength: 8, Dexterity: 15, Constitution: 13, Intelligence: 12, Wisdom: 14, Charisma: 10}
+	if _, err := NewBuilder("char1", "Lysias", Ranger, Human).AssignAttributes(StandardArrayMethod, attrs).Build(); err != nil {
+		t.Errorf("Build failed for a valid standard array: %v", err)
+	}
+
+	attrs.Charisma = 15
+	if _, err := NewBuilder("char1", "Lysias", Ranger, Human).AssignAttributes(StandardArrayMethod, attrs).Build(); err == nil {
+		t.Errorf("Expected an error when a standard array value is used twice, but got none")
+	}
+}
+
+func TestBuilderPointBuy(t *testing.T) {
+	attrs := Attributes{Strength: 15, Dexterity: 14, Constitution: 13, Intelligence: 10, Wisdom: 10, Charisma: 8}
+	if _, err := NewBuilder("char1", "Lysias", Ranger, Human).AssignAttributes(PointBuyMethod, attrs).Build(); err != nil {
+		t.Errorf("Build failed for a point buy within budget: %v", err)
+	}
+	if _, err := NewBuilder("char1", "Lysias", Ranger, Human).WithPointBudget(20).AssignAttributes(PointBuyMethod, attrs).Build(); err == nil {
+		t.Errorf("Expected an error when the point buy exceeds the budget, but got none")
+	}
+}
+
+func TestBuilderReportsEveryViolation(t *testing.T) {
+	attrs := Attributes{Strength: 99, Dexterity: 0, Constitution: 10, Intelligence: 8, Wisdom: 10, Charisma: 10}
+	_, err := NewBuilder("", "", Wizard, Elf).AssignAttributes(PointBuyMethod, attrs).Build()
+
+	var creationErr *CreationError
+	if !errors.As(err, &creationErr) {
+		t.Fatalf("Expected a CreationError, got: %v", err)
+	}
+	if !errors.Is(err, errs.ErrInvalid) {
+		t.Errorf("Expected the CreationError to match ErrInvalid")
+	}
+	// missing ID, missing name, Strength and Dexterity out of range, Intelligence below the Wizard minimum,
+	// and Strength and Dexterity not buyable
+	if len(creationErr.Violations) != 7 {
+		t.Errorf("Expected 7 violations, got %d: %v", len(creationErr.Violations), creationErr.Violations)
+	}
+}
+
+func TestBuilderRequiresAttributes(t *testing.T) {
+	if _, err := NewBuilder("char1", "Lysias", Ranger, Human).Build(); err == nil {
+		t.Errorf("Expected an error when no attributes were rolled or assigned, but got none")
+	}
+}
@@ -0,0 +1,230 @@
+package character
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync/atomic"
+)
+
+// RaceRules defines the game effect of a race. Modifiers are added to the rolled or assigned attributes.
+type RaceRules struct {
+	Modifiers Attributes
+}
+
+// ClassRules defines the requirements of a class. Minimums apply to the attributes after racial modifiers;
+// a zero minimum means no requirement.
+type ClassRules struct {
+	PrimeRequisites []string // attribute names, f.ex. "Wisdom"
+	Minimums        Attributes
+	ForbiddenRaces  []CharacterRace
+}
+
+// RulesTable holds the race and class rules used to validate characters and derive their stats.
+type RulesTable struct {
+	Races   map[CharacterRace]RaceRules
+	Classes map[CharacterClass]ClassRules
+}
+
+// DefaultRules returns the rules table the game starts with.
+func DefaultRules() *RulesTable {
+	return &RulesTable{
+		Races: map[CharacterRace]RaceRules{
+			Human: {},
+			Elf:   {Modifiers: Attributes{Dexterity: 2, Constitution: -2}},
+			Dwarf: {Modifiers: Attributes{Constitution: 2, Charisma: -2}},
+			Orc:   {Modifiers: Attributes{Strength: 2, Intelligence: -2}},
+			Ghost: {Modifiers: Attributes{Wisdom: 2, Strength: -2}},
+		},
+		Classes: map[CharacterClass]ClassRules{
+			Wizard:  {PrimeRequisites: []string{"Intelligence"}, Minimums: Attributes{Intelligence: 9}},
+			Warrior: {PrimeRequisites: []string{"Strength"}, Minimums: Attributes{Strength: 9}},
+			Cleric:  {PrimeRequisites: []string{"Wisdom"}, Minimums: Attributes{Wisdom: 9}, ForbiddenRaces: []CharacterRace{Ghost}},
+			Ranger:  {PrimeRequisites: []string{"Dexterity", "Wisdom"}, Minimums: Attributes{Dexterity: 9, Wisdom: 9}},
+		},
+	}
+}
+
+var activeRules atomic.Pointer[RulesTable]
+
+func init() {
+	activeRules.Store(DefaultRules())
+}
+
+// Rules returns the rules table in use.
+func Rules() *RulesTable {
+	return activeRules.Load()
+}
+
+// SetRules replaces the rules table in use and returns the previous one.
+func SetRules(t *RulesTable) *RulesTable {
+	return activeRules.Swap(t)
+}
+
+// rulesFile is the JSON layout of a rules table, keyed by race and class names.
+type rulesFile struct {
+	Races   map[string]RaceRules `json:"races"`
+	Classes map[string]struct {
+		PrimeRequisites []string   `json:"primeRequisites"`
+		Minimums        Attributes `json:"minimums"`
+		ForbiddenRaces  []string   `json:"forbiddenRaces"`
+	} `json:"classes"`
+}
+
+// LoadRules reads a JSON rules table and applies it on top of the default rules.
+// Every race or class listed in the file replaces its default entry; the others keep their defaults.
+func LoadRules(r io.Reader) (*RulesTable, error) {
+	var f rulesFile
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("invalid rules table: %w", err)
+	}
+
+	t := DefaultRules()
+	for name, rr := range f.Races {
+		race, err := ParseRace(name)
+		if err != nil {
+			return nil, err
+		}
+		t.Races[race] = rr
+	}
+	for name, cf := range f.Classes {
+		class, err := ParseClass(name)
+		if err != nil {
+			return nil, err
+		}
+		cr := ClassRules{PrimeRequisites: cf.PrimeRequisites, Minimums: cf.Minimums}
+		for _, p := range cr.PrimeRequisites {
+			if _, ok := cr.Minimums.value(p); !ok {
+				return nil, fmt.Errorf("unknown prime requisite %q for class %s", p, class)
+			}
+		}
+		for _, raceName := range cf.ForbiddenRaces {
+			race, err := ParseRace(raceName)
+			if err != nil {
+				return nil, err
+			}
+			cr.ForbiddenRaces = append(cr.ForbiddenRaces, race)
+		}
+		t.Classes[class] = cr
+	}
+	return t, nil
+}
+
+// LoadRulesFile reads a JSON rules table from a file, see LoadRules.
+func LoadRulesFile(path string) (*RulesTable, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadRules(file)
+}
+
+// Violations lists every rule a character of the given class, race and base attributes breaks.
+func (t *RulesTable) Violations(class CharacterClass, race CharacterRace, attrs Attributes) []string {
+	var violations []string
+	for _, a := range attrs.named() {
+		if a.value < MinAttribute || a.value > MaxAttribute {
+			violations = append(violations, fmt.Sprintf("%s %d is outside the range %d-%d", a.name, a.value, MinAttribute, MaxAttribute))
+		}
+	}
+
+	raceRules, ok := t.Races[race]
+	if !ok {
+		return append(violations, fmt.Sprintf("unknown race %d", int(race)))
+	}
+	classRules, ok := t.Classes[class]
+	if !ok {
+		return append(violations, fmt.Sprintf("unknown class %d", int(class)))
+	}
+	for _, forbidden := range classRules.ForbiddenRaces {
+		if forbidden == race {
+			violations = append(violations, fmt.Sprintf("a %s cannot be a %s", race, class))
+		}
+	}
+
+	effective := attrs.add(raceRules.Modifiers).named()
+	for i, min := range classRules.Minimums.named() {
+		if min.value > 0 && effective[i].value < min.value {
+			violations = append(violations, fmt.Sprintf("a %s needs %s of at least %d, got %d", class, min.name, min.value, effective[i].value))
+		}
+	}
+	return violations
+}
+
+// DerivedStats are the values computed from the base attributes of a character and the rules.
+type DerivedStats struct {
+	Attributes     Attributes // base attributes plus racial modifiers
+	Modifiers      Attributes // ability modifiers, (attribute - 10) / 2 rounded down
+	XPBonusPercent int        // bonus on earned XP from the lowest prime requisite
+}
+
+// DeriveStats computes the derived stats of a character.
+func (t *RulesTable) DeriveStats(c Character) DerivedStats {
+	effective := c.Attributes.add(t.Races[c.Race].Modifiers)
+	stats := DerivedStats{Attributes: effective}
+
+	named := effective.named()
+	var mods [6]int
+	for i, a := range named {
+		mods[i] = floorDiv(a.value-10, 2)
+	}
+	stats.Modifiers = attributesFromValues(mods)
+
+	primes := t.Classes[c.Class].PrimeRequisites
+	if len(primes) > 0 {
+		lowest := MaxAttribute + 10
+		for _, p := range primes {
+			if v, ok := effective.value(p); ok && v < lowest {
+				lowest = v
+			}
+		}
+		stats.XPBonusPercent = primeRequisiteBonus(lowest)
+	}
+	return stats
+}
+
+// primeRequisiteBonus follows the classic table: 3-5 -20%, 6-8 -10%, 9-12 none, 13-15 +5%, 16 and more +10%.
+func primeRequisiteBonus(score int) int {
+	switch {
+	case score >= 16:
+		return 10
+	case score >= 13:
+		return 5
+	case score >= 9:
+		return 0
+	case score >= 6:
+		return -10
+	default:
+		return -20
+	}
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func (a Attributes) add(mods Attributes) Attributes {
+	v, m := a.values(), mods.values()
+	for i := range v {
+		v[i] += m[i]
+	}
+	return attributesFromValues(v)
+}
+
+// value returns the attribute with the given name.
+func (a Attributes) value(name string) (int, bool) {
+	for _, na := range a.named() {
+		if na.name == name {
+			return na.value, true
+		}
+	}
+	return 0, false
+}
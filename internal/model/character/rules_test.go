@@ -0,0 +1,85 @@
+package character
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDefaultRulesViolations(t *testing.T) {
+	rules := DefaultRules()
+	attrs := Attributes{Strength: 12, Dexterity: 12, Constitution: 12, Intelligence: 12, Wisdom: 8, Charisma: 12}
+
+	if v := rules.Violations(Warrior, Dwarf, attrs); len(v) != 0 {
+		t.Errorf("Expected no violations for a Dwarf Warrior, got: %v", v)
+	}
+	if v := rules.Violations(Cleric, Ghost, attrs); len(v) != 1 || !strings.Contains(v[0], "cannot be a Cleric") {
+		t.Errorf("Expected the Ghost Cleric combination to be forbidden, got: %v", v)
+	}
+	// Wisdom 8 is below the Cleric minimum for a Human, who has no Wisdom modifier
+	if v := rules.Violations(Cleric, Human, attrs); len(v) != 1 || !strings.Contains(v[0], "Wisdom of at least 9") {
+		t.Errorf("Expected the Cleric Wisdom minimum to be enforced, got: %v", v)
+	}
+}
+
+func TestDeriveStats(t *testing.T) {
+	rules := DefaultRules()
+	c := Character{Class: Warrior, Race: Dwarf, Attributes: Attributes{Strength: 16, Dexterity: 9, Constitution: 14, Intelligence: 10, Wisdom: 11, Charisma: 8}}
+	stats := rules.DeriveStats(c)
+
+	if stats.Attributes.Constitution != 16 || stats.Attributes.Charisma != 6 {
+		t.Errorf("DeriveStats did not apply the Dwarf modifiers, got: %+v", stats.Attributes)
+	}
+	if stats.Modifiers.Strength != 3 || stats.Modifiers.Dexterity != -1 || stats.Modifiers.Charisma != -2 {
+		t.Errorf("DeriveStats computed wrong ability modifiers, got: %+v", stats.Modifiers)
+	}
+	if stats.XPBonusPercent != 10 {
+		t.Errorf("DeriveStats expected a 10%% prime requisite bonus, got: %d", stats.XPBonusPercent)
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	table := `{
+		"races": {"Ghost": {"modifiers": {"Wisdom": 1}}},
+		"classes": {"Cleric": {"primeRequisites": ["Wisdom"], "minimums": {"Wisdom": 12}}}
+	}`
+	path := filepath.Join(t.TempDir(), "rules.json")
+	if err := os.WriteFile(path, []byte(table), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := LoadRulesFile(path)
+	if err != nil {
+		t.Fatalf("LoadRulesFile failed: %v", err)
+	}
+
+	if rules.Races[Ghost].Modifiers != (Attributes{Wisdom: 1}) {
+		t.Errorf("LoadRules did not override the Ghost modifiers, got: %+v", rules.Races[Ghost])
+	}
+	if len(rules.Classes[Cleric].ForbiddenRaces) != 0 || rules.Classes[Cleric].Minimums.Wisdom != 12 {
+		t.Errorf("LoadRules did not override the Cleric rules, got: %+v", rules.Classes[Cleric])
+	}
+	if rules.Races[Dwarf].Modifiers.Constitution != 2 {
+		t.Errorf("LoadRules should keep the defaults of races not in the file, got: %+v", rules.Races[Dwarf])
+	}
+
+	previous := SetRules(rules)
+	defer SetRules(previous)
+	attrs := Attributes{Strength: 10, Dexterity: 10, Constitution: 10, Intelligence: 10, Wisdom: 12, Charisma: 10}
+	if _, err := NewCharacter("c1", "Casper", Cleric, Ghost, "", attrs); err != nil {
+		t.Errorf("NewCharacter should use the loaded rules table, got: %v", err)
+	}
+}
+
+func TestLoadRulesInvalid(t *testing.T) {
+	for _, table := range []string{
+		`{"races": {"Hobbit": {}}}`,
+		`{"classes": {"Cleric": {"primeRequisites": ["Luck"]}}}`,
+		`{"classes": {"Cleric": {"forbiddenRaces": ["Vampire"]}}}`,
+		`{"spells": {}}`,
+	} {
+		if _, err := LoadRules(strings.NewReader(table)); err == nil {
+			t.Errorf("LoadRules(%s) expected error, got nil", table)
+		}
+	}
+}
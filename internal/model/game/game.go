@@ -2,21 +2,25 @@
 package game
 
 import (
-	"fmt"
 	"time"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/character"
 	"github.com/jerberlin/dndgame/internal/model/gamemaster"
 	"github.com/jerberlin/dndgame/internal/model/player"
 )
 
-// GameStatus defines possible states of a game
+// GameStatus defines possible states of a game, see lifecycle.go for the allowed transitions.
 type GameStatus int
 
 const (
-	Inactive GameStatus = iota // when deactivated
-	Active                     // after activation
+	Draft    GameStatus = iota // the game master is configuring the game
+	Lobby                      // players join and create characters, waiting for the start
+	Active                     // the game is being played
+	Paused                     // the game is interrupted and will be resumed
+	Ended                      // its end time has passed, or it was ended by the game master
+	Archived                   // kept for the record only
 )
 
 // AdventureType defines different kinds of adventures in the game.
@@ -28,44 +32,110 @@ const (
 	Campaigns                          // Longer adventures that could evolve over multiple gaming sessions.
 )
 
-// Mission represents a specific task or challenge within an adventure.
-type Mission struct {
-	Name        string
-	Description string
-}
-
-// Adventure represents a specific type of game scenario.
+// Adventure represents a specific type of game scenario, played as an ordered list of missions, see mission.go.
 type Adventure struct {
-	Type    AdventureType
-	Mission Mission // singular mission adventure
+	ID       string
+	Type     AdventureType
+	Missions []Mission
+	Outcome  AdventureOutcome // recorded by the game master, see outcome.go
 }
 
 // Game represents the game entity with its list of possible game actions.
 // The Game is directed by a Game Master.
 // The actions are chosen by Players for one of their characters.
 // Each game has at cretion a defined start time and an end time.
-// A game is at given point either the status active or inactive.
+// A game goes through the statuses of its lifecycle, from Draft to Archived.
 type Game struct {
-	GameID     string
-	Name       string
-	StartTime  time.Time
-	EndTime    time.Time
-	Status     GameStatus
-	Players    []player.Player
-	Characters []character.Character
-	GameMaster gamemaster.GameMaster
-	Actions    []action.Action
-	Adventure  Adventure // singular adventure
-}
-
-// SetStatus changes the status of the game.
-func (g *Game) SetStatus(newStatus GameStatus) {
+	GameID              string
+	Name                string
+	StartTime           time.Time
+	EndTime             time.Time
+	Status              GameStatus
+	AllowLateCharacters bool // whether the game master lets players create characters after the game started
+	Players             []player.Player
+	Characters          []character.Character
+	GameMaster          gamemaster.GameMaster
+	Rulebook            gamemaster.Rulebook // limits the game master has to abide by
+	Actions             []action.Action
+	Adventure           Adventure // the adventure currently played
+	Payout              PayoutRules
+	Reports             []AdventureReport // one per ended adventure, in order
+	Version             int               // set by the repository, which rejects updates of an outdated version
+}
+
+// NewScheduledGame creates a game in the lobby that becomes active at start and ends at end.
+// A zero end time means the game runs until it is ended explicitly.
+func NewScheduledGame(gameID, name string, start, end time.Time) (*Game, error) {
+	if start.IsZero() {
+		return nil, errs.Invalidf("a scheduled game needs a start time")
+	}
+	if !end.IsZero() && !end.After(start) {
+		return nil, errs.Invalidf("game end time %v must be after its start time %v", end, start)
+	}
+	return &Game{
+		GameID:    gameID,
+		Name:      name,
+		StartTime: start,
+		EndTime:   end,
+		Status:    Lobby,
+	}, nil
+}
+
+// UpdateSchedule moves a game in the lobby to Active once its start time is reached,
+// and a game in the lobby, active or paused to Ended once its end time is reached. It reports whether the status changed.
+// Games in Draft are still being configured and are never started automatically.
+func (g *Game) UpdateSchedule(now time.Time) bool {
+	previous := g.Status
+	if g.Status == Lobby && !g.StartTime.IsZero() && !now.Before(g.StartTime) {
+		g.Status = Active
+	}
+	if (g.Status == Lobby || g.Status == Active || g.Status == Paused) && !g.EndTime.IsZero() && !now.Before(g.EndTime) {
+		g.Status = Ended
+	}
+	return g.Status != previous
+}
+
+// IsActiveAt reports whether the game accepts actions at the given time:
+// it has to be active and, if its schedule is set, inside its start and end times.
+func (g *Game) IsActiveAt(now time.Time) bool {
+	if g.Status != Active {
+		return false
+	}
+	if !g.StartTime.IsZero() && now.Before(g.StartTime) {
+		return false
+	}
+	if !g.EndTime.IsZero() && !now.Before(g.EndTime) {
+		return false
+	}
+	return true
+}
+
+// SetStatus changes the status of the game, if the lifecycle allows it.
+func (g *Game) SetStatus(newStatus GameStatus) error {
+	if !g.Status.CanTransitionTo(newStatus) {
+		return &InvalidStatusTransitionError{From: g.Status, To: newStatus}
+	}
 	g.Status = newStatus
+	return nil
 }
 
-// AddPlayer adds a new player to the game.
-func (g *Game) AddPlayer(p player.Player) {
+// AddPlayer adds a new player to the game. A player joins a game once.
+func (g *Game) AddPlayer(p player.Player) error {
+	if g.HasPlayer(p.PlayerID) {
+		return errs.Newf(errs.ErrConflict, errs.Player, p.PlayerID, "is already a player of game %s", g.GameID)
+	}
 	g.Players = append(g.Players, p)
+	return nil
+}
+
+// HasPlayer reports whether a player joined the game.
+func (g *Game) HasPlayer(playerID string) bool {
+	for _, p := range g.Players {
+		if p.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
 }
 
 // RemovePlayer removes a player from the game by ID.
@@ -76,7 +146,7 @@ func (g *Game) RemovePlayer(playerID string) (err error) {
 			return nil
 		}
 	}
-	return fmt.Errorf("player with ID %s not found", playerID)
+	return errs.Newf(errs.ErrNotFound, errs.Player, playerID, "is not a player of game %s", g.GameID)
 }
 
 // AddCharacter adds a new character to the game.
@@ -89,12 +159,72 @@ func (g *Game) AddAction(a action.Action) {
 	g.Actions = append(g.Actions, a)
 }
 
-// SetAdventure sets a new adventure to the game.
-func (g *Game) SetAdventure(adventure Adventure) {
+// SetRulebook sets the limits the game master has to abide by.
+func (g *Game) SetRulebook(rb gamemaster.Rulebook) {
+	g.Rulebook = rb
+}
+
+// SetAdventure sets a new adventure to the game, once its missions are validated.
+func (g *Game) SetAdventure(adventure Adventure) error {
+	adventure.Missions = append([]Mission(nil), adventure.Missions...)
+	if err := adventure.Validate(); err != nil {
+		return err
+	}
 	g.Adventure = adventure
+	return nil
 }
 
-// SetMission sets a mission for the current adventure.
-func (g *Game) SetMission(mission Mission) {
-	g.Adventure.Mission = mission
+// SetPayoutRules sets the bonus XP the characters earn when an adventure ends.
+func (g *Game) SetPayoutRules(rules PayoutRules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	g.Payout = rules
+	return nil
+}
+
+// AddMission appends a mission to the current adventure, see Adventure.AddMission.
+func (g *Game) AddMission(mission Mission) error {
+	return g.Adventure.AddMission(mission)
+}
+
+// Clone returns a deep copy of the game, which can be changed without affecting the game.
+func (g *Game) Clone() *Game {
+	clone := *g
+	if g.Players != nil {
+		clone.Players = make([]player.Player, len(g.Players))
+		for i := range g.Players {
+			clone.Players[i] = *g.Players[i].Clone()
+		}
+	}
+	if g.Characters != nil {
+		clone.Characters = make([]character.Character, len(g.Characters))
+		for i := range g.Characters {
+			clone.Characters[i] = *g.Characters[i].Clone()
+		}
+	}
+	if g.Actions != nil {
+		clone.Actions = append(make([]action.Action, 0, len(g.Actions)), g.Actions...)
+	}
+	clone.Adventure = g.Adventure.Clone()
+	clone.Payout = g.Payout.Clone()
+	if g.Reports != nil {
+		clone.Reports = make([]AdventureReport, len(g.Reports))
+		for i := range g.Reports {
+			clone.Reports[i] = g.Reports[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// Clone returns a deep copy of the adventure.
+func (a Adventure) Clone() Adventure {
+	if a.Missions != nil {
+		missions := make([]Mission, len(a.Missions))
+		for i := range a.Missions {
+			missions[i] = a.Missions[i].Clone()
+		}
+		a.Missions = missions
+	}
+	return a
 }
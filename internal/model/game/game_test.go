@@ -1,30 +1,44 @@
 package game
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
 	"github.com/jerberlin/dndgame/internal/model/player"
+	"reflect"
 )
 
 func TestSetStatus(t *testing.T) {
 	g := Game{
-		Status: Inactive,
+		Status: Lobby,
 	}
 	g.SetStatus(Active)
 	if g.Status != Active {
 		t.Errorf("SetStatus failed, expected %v got %v", Active, g.Status)
 	}
+	if err := g.SetStatus(Draft); err == nil || g.Status != Active {
+		t.Errorf("SetStatus should refuse to move an active game back to Draft, got %v", g.Status)
+	}
 }
 
 func TestAddPlayer(t *testing.T) {
 	g := Game{}
 	p := player.Player{PlayerID: "p1", Name: "Thething"}
-	g.AddPlayer(p)
+	if err := g.AddPlayer(p); err != nil {
+		t.Fatalf("AddPlayer failed unexpectedly: %v", err)
+	}
 	if len(g.Players) != 1 || g.Players[0].PlayerID != "p1" {
 		t.Errorf("AddPlayer failed, expected player with ID 'p1', got '%v'", g.Players[0].PlayerID)
 	}
+	// Test for a player already in the game
+	if err := g.AddPlayer(p); !errors.Is(err, errs.ErrConflict) || len(g.Players) != 1 {
+		t.Errorf("AddPlayer of a player already in the game error = %v, want ErrConflict, got players '%v'", err, g.Players)
+	}
 }
 
 func TestRemovePlayer(t *testing.T) {
@@ -60,6 +74,14 @@ func TestAddAction(t *testing.T) {
 	}
 }
 
+func TestSetRulebook(t *testing.T) {
+	g := Game{}
+	g.SetRulebook(gamemaster.DefaultRulebook())
+	if g.Rulebook.MaxXPGrantPerCharacter != gamemaster.DefaultRulebook().MaxXPGrantPerCharacter {
+		t.Errorf("SetRulebook failed, got '%+v'", g.Rulebook)
+	}
+}
+
 func TestSetAdventure(t *testing.T) {
 	g := Game{}
 	ad := Adventure{Type: Quests}
@@ -69,13 +91,74 @@ func TestSetAdventure(t *testing.T) {
 	}
 }
 
-func TestSetMission(t *testing.T) {
+func TestAddMission(t *testing.T) {
 	g := Game{
 		Adventure: Adventure{Type: Quests},
 	}
-	m := Mission{Name: "Rescue", Description: "Save the villagers"}
-	g.SetMission(m)
-	if g.Adventure.Mission.Name != "Rescue" {
-		t.Errorf("SetMission failed, expected mission 'Rescue', got '%v'", g.Adventure.Mission.Name)
+	g.AddMission(Mission{Name: "Rescue", Description: "Save the villagers"})
+	g.AddMission(Mission{Name: "Escort", Description: "Bring the villagers to the city"})
+	if len(g.Adventure.Missions) != 2 || g.Adventure.Missions[0].Name != "Rescue" || g.Adventure.Missions[1].ID != "mission-2" {
+		t.Errorf("AddMission failed, expected missions 'Rescue' and 'Escort', got '%+v'", g.Adventure.Missions)
+	}
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	start := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+	g, err := NewScheduledGame("g1", "Friday Night", start, start.Add(4*time.Hour))
+	if err != nil || g.Status != Lobby {
+		t.Fatalf("NewScheduledGame failed, err: %v, got '%+v'", err, g)
+	}
+
+	if g.UpdateSchedule(start.Add(-time.Minute)) || g.IsActiveAt(start.Add(-time.Minute)) {
+		t.Errorf("UpdateSchedule activated the game before its start time, got '%v'", g.Status)
+	}
+	if !g.UpdateSchedule(start) || g.Status != Active || !g.IsActiveAt(start) {
+		t.Errorf("UpdateSchedule failed to activate the game at its start time, got '%v'", g.Status)
+	}
+	if !g.UpdateSchedule(start.Add(4*time.Hour)) || g.Status != Ended || g.IsActiveAt(start.Add(4*time.Hour)) {
+		t.Errorf("UpdateSchedule failed to end the game at its end time, got '%v'", g.Status)
+	}
+}
+
+func TestNewScheduledGameInvalid(t *testing.T) {
+	start := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+	if _, err := NewScheduledGame("g1", "Backwards", start, start.Add(-time.Hour)); err == nil {
+		t.Errorf("Expected an error when the end time is before the start time, but got none")
+	}
+	if _, err := NewScheduledGame("g1", "Someday", time.Time{}, time.Time{}); err == nil {
+		t.Errorf("Expected an error when the start time is missing, but got none")
+	}
+}
+
+func TestClone(t *testing.T) {
+	g := &Game{
+		GameID:     "g1",
+		Players:    []player.Player{{PlayerID: "p1", Characters: []character.Character{{CharacterID: "c1"}}}},
+		Characters: []character.Character{{CharacterID: "npc1", ActionInstances: []action.ActionInstance{{InstanceID: "i1"}}}},
+		Actions:    []action.Action{{ActionID: "a1"}},
+		Adventure:  Adventure{ID: "adv1", Missions: []Mission{{ID: "m1", Objectives: []Objective{{ID: "o1"}}, Prerequisites: []string{"m0"}}}},
+		Payout:     DefaultPayoutRules(),
+		Reports:    []AdventureReport{{AdventureID: "adv0", Standings: []Standing{{CharacterID: "c1"}}}},
+		Version:    3,
+	}
+	clone := g.Clone()
+	if !reflect.DeepEqual(clone, g) {
+		t.Fatalf("Clone() got = %+v, want %+v", clone, g)
+	}
+
+	clone.Players[0].Characters[0].Name = "Changed"
+	clone.Characters[0].ActionInstances[0].State = action.Approved
+	clone.Actions[0].Name = "Changed"
+	clone.Adventure.Missions[0].Objectives[0].Completed = true
+	clone.Adventure.Missions[0].Prerequisites[0] = "changed"
+	clone.Payout.OutcomeBonus[Victory] = 1000
+	clone.Reports[0].Standings[0].FinalXP = 1000
+	if g.Players[0].Characters[0].Name != "" || g.Characters[0].ActionInstances[0].State != action.Proposed ||
+		g.Actions[0].Name != "" || g.Adventure.Missions[0].Objectives[0].Completed || g.Adventure.Missions[0].Prerequisites[0] != "m0" ||
+		g.Payout.OutcomeBonus[Victory] != 50 || g.Reports[0].Standings[0].FinalXP != 0 {
+		t.Errorf("changing a clone changed the game, got = %+v", g)
+	}
+	if empty := (&Game{}).Clone(); !reflect.DeepEqual(empty, &Game{}) {
+		t.Errorf("Clone() of an empty game got = %+v, want the nil slices kept", empty)
 	}
 }
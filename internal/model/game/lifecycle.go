@@ -0,0 +1,111 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// statusTransitions lists the statuses a game may move to from each status. Archived is final.
+var statusTransitions = map[GameStatus][]GameStatus{
+	Draft:  {Lobby, Archived},
+	Lobby:  {Draft, Active, Ended},
+	Active: {Paused, Ended},
+	Paused: {Active, Ended},
+	Ended:  {Archived},
+}
+
+// InvalidStatusTransitionError is returned when a game is asked to move to a status not reachable from its current one.
+type InvalidStatusTransitionError struct {
+	From GameStatus
+	To   GameStatus
+}
+
+func (e *InvalidStatusTransitionError) Error() string {
+	return fmt.Sprintf("invalid game status transition from %s to %s", e.From, e.To)
+}
+
+func (e *InvalidStatusTransitionError) Is(target error) bool {
+	return target == errs.ErrInvalidTransition
+}
+
+// CanTransitionTo reports whether a game may move from this status to the given one.
+func (s GameStatus) CanTransitionTo(to GameStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Operation defines the things players and the game master can do with a game.
+type Operation int
+
+const (
+	Configure        Operation = iota // set the adventure, its missions and whether late characters are allowed
+	AssignGameMaster                  // set the game master directing the game
+	ChangeRulebook                    // set the limits the game master has to abide by
+	ChangePayout                      // set the bonus XP earned when an adventure ends
+	Join                              // add a player to the game
+	Leave                             // remove a player from the game
+	CreateCharacter                   // create a character for the game
+	ProposeAction                     // choose an action for a character
+	ExecuteAction                     // execute an approved action
+)
+
+// allowedOperations lists the operations each status allows. The game master and the rules they abide by are
+// settled before the game starts. Character creation after the start is handled separately, see Allows.
+var allowedOperations = map[GameStatus][]Operation{
+	Draft:  {Configure, AssignGameMaster, ChangeRulebook, ChangePayout},
+	Lobby:  {Configure, AssignGameMaster, ChangeRulebook, ChangePayout, Join, Leave, CreateCharacter},
+	Active: {Configure, Join, Leave, ProposeAction, ExecuteAction},
+	Paused: {Configure, Leave},
+}
+
+// OperationNotAllowedError is returned when an operation is attempted in a status that does not allow it.
+// It matches errs.ErrConflict: the operation may be allowed once the game is in another status.
+type OperationNotAllowedError struct {
+	Operation Operation
+	Status    GameStatus
+}
+
+func (e *OperationNotAllowedError) Error() string {
+	return fmt.Sprintf("%s is not allowed while the game is %s", e.Operation, e.Status)
+}
+
+func (e *OperationNotAllowedError) Is(target error) bool {
+	return target == errs.ErrConflict
+}
+
+// Allows returns an OperationNotAllowedError if the current status of the game does not allow the operation.
+// Characters may be created in an active or paused game only if the game master allows late characters.
+func (g *Game) Allows(op Operation) error {
+	if op == CreateCharacter && g.AllowLateCharacters && (g.Status == Active || g.Status == Paused) {
+		return nil
+	}
+	for _, allowed := range allowedOperations[g.Status] {
+		if allowed == op {
+			return nil
+		}
+	}
+	return &OperationNotAllowedError{Operation: op, Status: g.Status}
+}
+
+// String returns the string representation of the GameStatus.
+func (s GameStatus) String() string {
+	statusNames := [...]string{"Draft", "Lobby", "Active", "Paused", "Ended", "Archived"}
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("GameStatus(%d)", int(s))
+	}
+	return statusNames[s]
+}
+
+// String returns the string representation of the Operation.
+func (o Operation) String() string {
+	operationNames := [...]string{"Configure", "AssignGameMaster", "ChangeRulebook", "ChangePayout", "Join", "Leave", "CreateCharacter", "ProposeAction", "ExecuteAction"}
+	if o < 0 || int(o) >= len(operationNames) {
+		return fmt.Sprintf("Operation(%d)", int(o))
+	}
+	return operationNames[o]
+}
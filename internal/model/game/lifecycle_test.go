@@ -0,0 +1,69 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStatusTransitions(t *testing.T) {
+	g := Game{}
+	path := []GameStatus{Lobby, Active, Paused, Active, Ended, Archived}
+	for _, status := range path {
+		if err := g.SetStatus(status); err != nil {
+			t.Fatalf("SetStatus(%v) failed: %v", status, err)
+		}
+	}
+
+	tests := []struct {
+		from, to GameStatus
+	}{
+		{Draft, Active},
+		{Lobby, Paused},
+		{Active, Lobby},
+		{Ended, Active},
+		{Archived, Draft},
+	}
+	for _, tt := range tests {
+		g := Game{Status: tt.from}
+		var transitionErr *InvalidStatusTransitionError
+		if err := g.SetStatus(tt.to); !errors.As(err, &transitionErr) {
+			t.Errorf("SetStatus(%v -> %v) expected InvalidStatusTransitionError, got %v", tt.from, tt.to, err)
+		}
+	}
+}
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		status  GameStatus
+		op      Operation
+		allowed bool
+	}{
+		{Draft, Configure, true},
+		{Lobby, ChangeRulebook, true},
+		{Active, Configure, true},
+		{Active, AssignGameMaster, false},
+		{Active, ChangeRulebook, false},
+		{Paused, ChangePayout, false},
+		{Draft, Join, false},
+		{Lobby, Join, true},
+		{Lobby, CreateCharacter, true},
+		{Lobby, ProposeAction, false},
+		{Active, ProposeAction, true},
+		{Active, CreateCharacter, false},
+		{Paused, ExecuteAction, false},
+		{Ended, Configure, false},
+		{Archived, Leave, false},
+	}
+	for _, tt := range tests {
+		g := Game{Status: tt.status}
+		err := g.Allows(tt.op)
+		if (err == nil) != tt.allowed {
+			t.Errorf("Allows(%v) in %v = %v, want allowed %v", tt.op, tt.status, err, tt.allowed)
+		}
+	}
+
+	g := Game{Status: Active, AllowLateCharacters: true}
+	if err := g.Allows(CreateCharacter); err != nil {
+		t.Errorf("Allows(CreateCharacter) should be allowed when the GM allows late characters, got %v", err)
+	}
+}
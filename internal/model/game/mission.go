@@ -0,0 +1,220 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// MissionStatus defines the progress of a mission within its adventure.
+type MissionStatus int
+
+const (
+	Locked     MissionStatus = iota // its prerequisites are not completed yet
+	Available                       // it can be started
+	InProgress                      // at least one objective is being worked on
+	Completed                       // all its required objectives are completed
+	Failed                          // the game master declared it failed
+)
+
+// Objective is a goal of a mission. The criteria describe when the game master should consider it complete.
+// Optional objectives do not count towards the completion of the mission.
+type Objective struct {
+	ID          string
+	Description string
+	Criteria    string
+	Optional    bool
+	Completed   bool
+}
+
+// Mission represents a specific task or challenge within an adventure.
+// A mission becomes available once all the missions listed in its prerequisites are completed.
+type Mission struct {
+	ID            string
+	Name          string
+	Description   string
+	Status        MissionStatus
+	Objectives    []Objective
+	Prerequisites []string // IDs of missions earlier in the adventure
+}
+
+// Clone returns a deep copy of the mission.
+func (m Mission) Clone() Mission {
+	if m.Objectives != nil {
+		m.Objectives = append(make([]Objective, 0, len(m.Objectives)), m.Objectives...)
+	}
+	if m.Prerequisites != nil {
+		m.Prerequisites = append(make([]string, 0, len(m.Prerequisites)), m.Prerequisites...)
+	}
+	return m
+}
+
+// Progress returns the number of completed required objectives and the number of required objectives.
+func (m *Mission) Progress() (completed, required int) {
+	for _, o := range m.Objectives {
+		if o.Optional {
+			continue
+		}
+		required++
+		if o.Completed {
+			completed++
+		}
+	}
+	return completed, required
+}
+
+// IsFinished reports whether the mission is completed or failed.
+func (m *Mission) IsFinished() bool {
+	return m.Status == Completed || m.Status == Failed
+}
+
+// AddMission appends a mission to the adventure. A mission without an ID gets one from its position.
+// Prerequisites have to refer to missions already in the adventure, which keeps them free of cycles.
+func (a *Adventure) AddMission(m Mission) error {
+	if m.ID == "" {
+		m.ID = fmt.Sprintf("mission-%d", len(a.Missions)+1)
+	}
+	if _, err := a.FindMission(m.ID); err == nil {
+		return errs.AlreadyExists(errs.Mission, m.ID)
+	}
+	for _, prereq := range m.Prerequisites {
+		if _, err := a.FindMission(prereq); err != nil {
+			return errs.Invalidf("prerequisite of mission %s: %w", m.ID, err)
+		}
+	}
+	seen := make(map[string]bool, len(m.Objectives))
+	for _, o := range m.Objectives {
+		if o.ID == "" || seen[o.ID] {
+			return errs.Invalidf("objectives of mission %s need unique IDs", m.ID)
+		}
+		seen[o.ID] = true
+	}
+	m.Status = Locked
+	a.Missions = append(a.Missions, m)
+	a.unlockMissions()
+	return nil
+}
+
+// Validate checks that the missions of the adventure have unique IDs and that their prerequisites
+// refer to earlier missions, and makes available every locked mission whose prerequisites are completed.
+func (a *Adventure) Validate() error {
+	missions := a.Missions
+	a.Missions = nil
+	for _, m := range missions {
+		status := m.Status
+		if err := a.AddMission(m); err != nil {
+			a.Missions = missions
+			return err
+		}
+		if status != Locked {
+			a.Missions[len(a.Missions)-1].Status = status
+		}
+	}
+	a.unlockMissions()
+	return nil
+}
+
+// FindMission returns the mission with the given ID.
+func (a *Adventure) FindMission(missionID string) (*Mission, error) {
+	for i := range a.Missions {
+		if a.Missions[i].ID == missionID {
+			return &a.Missions[i], nil
+		}
+	}
+	return nil, errs.NotFound(errs.Mission, missionID)
+}
+
+// StartMission moves an available mission to InProgress.
+func (a *Adventure) StartMission(missionID string) error {
+	m, err := a.FindMission(missionID)
+	if err != nil {
+		return err
+	}
+	if m.Status != Available {
+		return errs.Newf(errs.ErrInvalidTransition, errs.Mission, missionID, "cannot be started while %s", m.Status)
+	}
+	m.Status = InProgress
+	return nil
+}
+
+// CompleteObjective marks an objective of an available or in-progress mission as complete.
+// The mission is completed once all its required objectives are, which can make the missions depending on it available.
+func (a *Adventure) CompleteObjective(missionID, objectiveID string) error {
+	m, err := a.FindMission(missionID)
+	if err != nil {
+		return err
+	}
+	if m.Status != Available && m.Status != InProgress {
+		return errs.Newf(errs.ErrInvalidTransition, errs.Mission, missionID, "cannot have objectives completed while %s", m.Status)
+	}
+	for i := range m.Objectives {
+		if m.Objectives[i].ID != objectiveID {
+			continue
+		}
+		if m.Objectives[i].Completed {
+			return errs.Newf(errs.ErrConflict, errs.Objective, objectiveID, "of mission %s is already completed", missionID)
+		}
+		m.Objectives[i].Completed = true
+		m.Status = InProgress
+		if completed, required := m.Progress(); completed == required {
+			m.Status = Completed
+			a.unlockMissions()
+		}
+		return nil
+	}
+	return errs.Newf(errs.ErrNotFound, errs.Objective, objectiveID, "not found in mission %s", missionID)
+}
+
+// FailMission declares an unfinished mission failed. Missions depending on it stay locked.
+func (a *Adventure) FailMission(missionID string) error {
+	m, err := a.FindMission(missionID)
+	if err != nil {
+		return err
+	}
+	if m.Status == Locked || m.IsFinished() {
+		return errs.Newf(errs.ErrInvalidTransition, errs.Mission, missionID, "cannot fail while %s", m.Status)
+	}
+	m.Status = Failed
+	return nil
+}
+
+// CompletedMissions returns the number of completed missions of the adventure.
+func (a *Adventure) CompletedMissions() int {
+	count := 0
+	for _, m := range a.Missions {
+		if m.Status == Completed {
+			count++
+		}
+	}
+	return count
+}
+
+// unlockMissions makes available every locked mission whose prerequisites are all completed.
+// Prerequisites always refer to earlier missions, so a single pass in order is enough.
+func (a *Adventure) unlockMissions() {
+	for i := range a.Missions {
+		m := &a.Missions[i]
+		if m.Status != Locked {
+			continue
+		}
+		unlocked := true
+		for _, prereq := range m.Prerequisites {
+			if p, err := a.FindMission(prereq); err != nil || p.Status != Completed {
+				unlocked = false
+				break
+			}
+		}
+		if unlocked {
+			m.Status = Available
+		}
+	}
+}
+
+// String returns the string representation of the MissionStatus.
+func (s MissionStatus) String() string {
+	statusNames := [...]string{"Locked", "Available", "InProgress", "Completed", "Failed"}
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("MissionStatus(%d)", int(s))
+	}
+	return statusNames[s]
+}
@@ -0,0 +1,101 @@
+package game
+
+import "testing"
+
+func newTestAdventure(t *testing.T) *Adventure {
+	t.Helper()
+	a := &Adventure{ID: "adv1", Type: Campaigns}
+	missions := []Mission{
+		{ID: "m1", Name: "Find the map", Objectives: []Objective{
+			{ID: "o1", Description: "Search the library", Criteria: "the map is in the party's hands"},
+			{ID: "o2", Description: "Bribe the librarian", Optional: true},
+		}},
+		{ID: "m2", Name: "Cross the swamp", Prerequisites: []string{"m1"}, Objectives: []Objective{
+			{ID: "o1", Description: "Reach the other side"},
+		}},
+		{ID: "m3", Name: "Open the vault", Prerequisites: []string{"m1", "m2"}, Objectives: []Objective{
+			{ID: "o1", Description: "Solve the riddle"},
+		}},
+	}
+	for _, m := range missions {
+		if err := a.AddMission(m); err != nil {
+			t.Fatalf("AddMission(%s) failed: %v", m.ID, err)
+		}
+	}
+	return a
+}
+
+func assertMissionStatus(t *testing.T, a *Adventure, missionID string, expected MissionStatus) {
+	t.Helper()
+	m, err := a.FindMission(missionID)
+	if err != nil {
+		t.Fatalf("FindMission(%s) failed: %v", missionID, err)
+	}
+	if m.Status != expected {
+		t.Errorf("mission %s status got = %v, want %v", missionID, m.Status, expected)
+	}
+}
+
+func TestAdventureAddMission(t *testing.T) {
+	a := newTestAdventure(t)
+	assertMissionStatus(t, a, "m1", Available)
+	assertMissionStatus(t, a, "m2", Locked)
+	assertMissionStatus(t, a, "m3", Locked)
+
+	if err := a.AddMission(Mission{ID: "m1"}); err == nil {
+		t.Errorf("AddMission expected error for a duplicate ID, got nil")
+	}
+	if err := a.AddMission(Mission{ID: "m4", Prerequisites: []string{"m5"}}); err == nil {
+		t.Errorf("AddMission expected error for an unknown prerequisite, got nil")
+	}
+	if err := a.AddMission(Mission{ID: "m4", Objectives: []Objective{{ID: "o1"}, {ID: "o1"}}}); err == nil {
+		t.Errorf("AddMission expected error for duplicate objective IDs, got nil")
+	}
+}
+
+func TestAdventureMissionProgress(t *testing.T) {
+	a := newTestAdventure(t)
+
+	if err := a.CompleteObjective("m2", "o1"); err == nil {
+		t.Errorf("CompleteObjective expected error for a locked mission, got nil")
+	}
+	if err := a.StartMission("m1"); err != nil {
+		t.Fatalf("StartMission failed: %v", err)
+	}
+	if err := a.CompleteObjective("m1", "o1"); err != nil {
+		t.Fatalf("CompleteObjective failed: %v", err)
+	}
+	assertMissionStatus(t, a, "m1", Completed)
+	assertMissionStatus(t, a, "m2", Available)
+	assertMissionStatus(t, a, "m3", Locked)
+
+	if err := a.CompleteObjective("m1", "o2"); err == nil {
+		t.Errorf("CompleteObjective expected error for a completed mission, got nil")
+	}
+
+	if err := a.FailMission("m2"); err != nil {
+		t.Fatalf("FailMission failed: %v", err)
+	}
+	assertMissionStatus(t, a, "m3", Locked)
+	if a.CompletedMissions() != 1 {
+		t.Errorf("CompletedMissions got = %d, want 1", a.CompletedMissions())
+	}
+}
+
+func TestSetAdventureValidatesMissions(t *testing.T) {
+	g := Game{}
+	adventure := Adventure{Missions: []Mission{
+		{ID: "m1", Prerequisites: []string{"m2"}},
+		{ID: "m2"},
+	}}
+	if err := g.SetAdventure(adventure); err == nil {
+		t.Errorf("SetAdventure expected error for a prerequisite on a later mission, got nil")
+	}
+
+	adventure = Adventure{Missions: []Mission{{ID: "m1"}, {ID: "m2", Prerequisites: []string{"m1"}}}}
+	if err := g.SetAdventure(adventure); err != nil {
+		t.Fatalf("SetAdventure failed: %v", err)
+	}
+	assertMissionStatus(t, &g.Adventure, "m1", Available)
+	assertMissionStatus(t, &g.Adventure, "m2", Locked)
+}
@@ -0,0 +1,150 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// AdventureOutcome defines how an adventure ended, as recorded by the game master.
+type AdventureOutcome int
+
+const (
+	Undecided      AdventureOutcome = iota // the adventure is still being played
+	Victory                                // the characters reached the goal of the adventure
+	Defeat                                 // the characters were beaten
+	PartialVictory                         // the characters reached part of the goal
+	Abandoned                              // the adventure was given up
+)
+
+// PayoutRules define the bonus XP every participating character earns when an adventure ends:
+// a fixed amount per completed mission plus a bonus depending on the outcome.
+type PayoutRules struct {
+	XPPerCompletedMission int
+	OutcomeBonus          map[AdventureOutcome]int
+}
+
+// DefaultPayoutRules returns the payout rules games are created with.
+func DefaultPayoutRules() PayoutRules {
+	return PayoutRules{
+		XPPerCompletedMission: 20,
+		OutcomeBonus: map[AdventureOutcome]int{
+			Victory:        50,
+			PartialVictory: 20,
+		},
+	}
+}
+
+// Clone returns a deep copy of the payout rules.
+func (r PayoutRules) Clone() PayoutRules {
+	if r.OutcomeBonus != nil {
+		bonus := make(map[AdventureOutcome]int, len(r.OutcomeBonus))
+		for outcome, xp := range r.OutcomeBonus {
+			bonus[outcome] = xp
+		}
+		r.OutcomeBonus = bonus
+	}
+	return r
+}
+
+// Validate checks that the payout rules never take XP away.
+func (r PayoutRules) Validate() error {
+	if r.XPPerCompletedMission < 0 {
+		return errs.Invalidf("XP per completed mission must not be negative, got %d", r.XPPerCompletedMission)
+	}
+	for outcome, bonus := range r.OutcomeBonus {
+		if bonus < 0 {
+			return errs.Invalidf("%s bonus must not be negative, got %d", outcome, bonus)
+		}
+	}
+	return nil
+}
+
+// Payout returns the bonus XP each participating character earns for the adventure.
+func (r PayoutRules) Payout(a Adventure) int {
+	return a.CompletedMissions()*r.XPPerCompletedMission + r.OutcomeBonus[a.Outcome]
+}
+
+// SetOutcome records how the adventure ended. It can be changed until the adventure is ended.
+func (a *Adventure) SetOutcome(outcome AdventureOutcome) error {
+	if outcome <= Undecided || outcome > Abandoned {
+		return errs.Invalidf("invalid adventure outcome %s", outcome)
+	}
+	a.Outcome = outcome
+	return nil
+}
+
+// MissionResult is the final state of a mission in an adventure report.
+type MissionResult struct {
+	MissionID string
+	Name      string
+	Status    MissionStatus
+}
+
+// Standing is the final state of a participating character in an adventure report.
+type Standing struct {
+	CharacterID string
+	Name        string
+	PayoutXP    int
+	FinalXP     int
+}
+
+// AdventureReport freezes the results of an ended adventure. Reports stay attached to the game.
+type AdventureReport struct {
+	AdventureID string
+	Type        AdventureType
+	Outcome     AdventureOutcome
+	EndedAt     time.Time
+	Missions    []MissionResult
+	Standings   []Standing // ordered by final XP, highest first
+}
+
+// Clone returns a deep copy of the report.
+func (r AdventureReport) Clone() AdventureReport {
+	if r.Missions != nil {
+		r.Missions = append(make([]MissionResult, 0, len(r.Missions)), r.Missions...)
+	}
+	if r.Standings != nil {
+		r.Standings = append(make([]Standing, 0, len(r.Standings)), r.Standings...)
+	}
+	return r
+}
+
+// EndAdventure freezes the current adventure into a report with the given standings, attaches the report
+// to the game and clears the adventure. The outcome of the adventure has to be recorded first.
+func (g *Game) EndAdventure(adventureID string, endedAt time.Time, standings []Standing) (AdventureReport, error) {
+	if g.Adventure.ID == "" || g.Adventure.ID != adventureID {
+		return AdventureReport{}, errs.Newf(errs.ErrConflict, errs.Adventure, adventureID, "is not the current adventure of game %s", g.GameID)
+	}
+	if g.Adventure.Outcome == Undecided {
+		return AdventureReport{}, errs.Newf(errs.ErrConflict, errs.Adventure, adventureID, "has no recorded outcome")
+	}
+	report := AdventureReport{
+		AdventureID: g.Adventure.ID,
+		Type:        g.Adventure.Type,
+		Outcome:     g.Adventure.Outcome,
+		EndedAt:     endedAt,
+		Missions:    make([]MissionResult, 0, len(g.Adventure.Missions)),
+		Standings:   append([]Standing(nil), standings...),
+	}
+	for _, m := range g.Adventure.Missions {
+		report.Missions = append(report.Missions, MissionResult{MissionID: m.ID, Name: m.Name, Status: m.Status})
+	}
+	sort.SliceStable(report.Standings, func(i, j int) bool {
+		return report.Standings[i].FinalXP > report.Standings[j].FinalXP
+	})
+	g.Reports = append(g.Reports, report)
+	g.Adventure = Adventure{}
+	return report, nil
+}
+
+// String returns the string representation of the AdventureOutcome.
+func (o AdventureOutcome) String() string {
+	outcomeNames := [...]string{"Undecided", "Victory", "Defeat", "PartialVictory", "Abandoned"}
+	if o < 0 || int(o) >= len(outcomeNames) {
+		return fmt.Sprintf("AdventureOutcome(%d)", int(o))
+	}
+	return outcomeNames[o]
+}
@@ -0,0 +1,59 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPayoutRules(t *testing.T) {
+	a := Adventure{Missions: []Mission{{ID: "m1", Status: Completed}, {ID: "m2", Status: Completed}, {ID: "m3", Status: Failed}}}
+	rules := DefaultPayoutRules()
+
+	tests := []struct {
+		outcome  AdventureOutcome
+		expected int
+	}{
+		{Victory, 90},
+		{PartialVictory, 60},
+		{Defeat, 40},
+		{Abandoned, 40},
+	}
+	for _, tt := range tests {
+		a.Outcome = tt.outcome
+		if got := rules.Payout(a); got != tt.expected {
+			t.Errorf("Payout(%v) = %d, want %d", tt.outcome, got, tt.expected)
+		}
+	}
+
+	if err := (PayoutRules{XPPerCompletedMission: -1}).Validate(); err == nil {
+		t.Errorf("Validate expected error for a negative payout, got nil")
+	}
+}
+
+func TestEndAdventure(t *testing.T) {
+	g := Game{GameID: "g1", Adventure: Adventure{ID: "adv1", Type: Quests, Missions: []Mission{{ID: "m1", Name: "Rescue", Status: Completed}}}}
+	endedAt := time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC)
+	standings := []Standing{{CharacterID: "c1", FinalXP: 80}, {CharacterID: "c2", FinalXP: 120}}
+
+	if _, err := g.EndAdventure("adv1", endedAt, standings); err == nil {
+		t.Errorf("EndAdventure expected error before the outcome is recorded, got nil")
+	}
+	if err := g.Adventure.SetOutcome(Undecided); err == nil {
+		t.Errorf("SetOutcome expected error for Undecided, got nil")
+	}
+	g.Adventure.SetOutcome(Victory)
+	if _, err := g.EndAdventure("adv2", endedAt, standings); err == nil {
+		t.Errorf("EndAdventure expected error for another adventure, got nil")
+	}
+
+	report, err := g.EndAdventure("adv1", endedAt, standings)
+	if err != nil {
+		t.Fatalf("EndAdventure failed: %v", err)
+	}
+	if report.Outcome != Victory || len(report.Missions) != 1 || report.Standings[0].CharacterID != "c2" {
+		t.Errorf("EndAdventure built an unexpected report: %+v", report)
+	}
+	if len(g.Reports) != 1 || g.Adventure.ID != "" {
+		t.Errorf("EndAdventure failed to attach the report and clear the adventure, got %+v", g)
+	}
+}
@@ -2,8 +2,7 @@
 package gamemaster
 
 import (
-	"errors"
-
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/action"
 )
 
@@ -22,14 +21,14 @@ type GameMaster struct {
 	Status GameMasterStatus
 }
 
-// ApproveAction finds an action instance by ID and sets its Approved status to true. Returns an error if not found.
-// TODO: decouple action instances from approve action function, f.ex. injecting the action manager service or with event-driven aproval.
+// ApproveAction finds an action instance by ID and moves it to the Approved state.
+// Returns an error if not found or if the instance is no longer pending.
+// The game master service publishes the approval on the event bus, see package event.
 func (gm *GameMaster) ApproveAction(instances map[string]*action.ActionInstance, instanceID string) error {
 	if ai, exists := instances[instanceID]; exists {
-		ai.Approved = true
-		return nil
+		return ai.Approve()
 	}
-	return errors.New("action instance not found")
+	return errs.NotFound(errs.ActionInstance, instanceID)
 }
 
 // SetStatus changes the status of the game master.
@@ -7,13 +7,13 @@ import (
 )
 
 func TestApproveAction(t *testing.T) {
-	gm := GameMaster{GMID: "GM1", Name: "Anakin", Status: Active}
+	gm := GameMaster{ID: "GM1", Name: "Anakin", Status: Active}
 	actionInstances := make(map[string]*action.ActionInstance)
 	actionInstance := &action.ActionInstance{
 		Action:       action.Action{ActionID: "act1", Name: "Firebolt", BaseXPCost: 10},
 		CharacterID:  "char1",
 		CustomXPCost: 15,
-		Approved:     false,
+		State:        action.Proposed,
 	}
 	actionInstances["act1"] = actionInstance
 
@@ -22,10 +22,16 @@ func TestApproveAction(t *testing.T) {
 	if err != nil {
 		t.Errorf("ApproveAction failed unexpectedly: %v", err)
 	}
-	if !actionInstance.Approved {
+	if actionInstance.State != action.Approved {
 		t.Error("ApproveAction failed to approve the action")
 	}
 
+	// Test failure to approve an action instance twice
+	err = gm.ApproveAction(actionInstances, "act1")
+	if err == nil {
+		t.Error("ApproveAction did not fail as expected when action instance is already approved")
+	}
+
 	// Test failure to find action instance
 	err = gm.ApproveAction(actionInstances, "nonexistent")
 	if err == nil {
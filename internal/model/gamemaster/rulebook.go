@@ -0,0 +1,100 @@
+package gamemaster
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// Rulebook defines the limits a game master has to abide by in a game.
+// Zero values mean no limit, except AllowNegativeBalance which has to be enabled explicitly.
+type Rulebook struct {
+	MaxXPGrantPerCharacter int     // total XP the GM may grant a character during a game session
+	MinCostMultiplier      float64 // lowest allowed ratio between the custom and the base XP cost of an action
+	MaxCostMultiplier      float64 // highest allowed ratio between the custom and the base XP cost of an action
+	AllowNegativeBalance   bool    // whether GM decisions may leave a character with negative XP
+}
+
+// DefaultRulebook returns the limits a new game starts with.
+func DefaultRulebook() Rulebook {
+	return Rulebook{
+		MaxXPGrantPerCharacter: 100,
+		MinCostMultiplier:      0.5,
+		MaxCostMultiplier:      2,
+	}
+}
+
+// RuleViolationError is returned when a decision of the game master breaks the rulebook. It matches errs.ErrForbidden.
+type RuleViolationError struct {
+	Rule   string
+	Detail string
+}
+
+func (e *RuleViolationError) Error() string {
+	return fmt.Sprintf("game master rule %q violated: %s", e.Rule, e.Detail)
+}
+
+func (e *RuleViolationError) Is(target error) bool {
+	return target == errs.ErrForbidden
+}
+
+// CheckXPGrant verifies that granting amount XP to a character who was already granted alreadyGranted XP
+// in this session stays within the limit. Deductions are not limited by this rule.
+func (r Rulebook) CheckXPGrant(alreadyGranted, amount int) error {
+	if r.MaxXPGrantPerCharacter <= 0 || amount <= 0 {
+		return nil
+	}
+	if alreadyGranted+amount > r.MaxXPGrantPerCharacter {
+		return &RuleViolationError{
+			Rule:   "max XP grant per character",
+			Detail: fmt.Sprintf("granting %d XP on top of %d already granted exceeds the limit of %d", amount, alreadyGranted, r.MaxXPGrantPerCharacter),
+		}
+	}
+	return nil
+}
+
+// CheckCost verifies that a custom XP cost stays within the allowed multipliers of the base cost.
+func (r Rulebook) CheckCost(baseCost, customCost int) error {
+	if r.MinCostMultiplier > 0 {
+		if min := int(math.Ceil(float64(baseCost) * r.MinCostMultiplier)); customCost < min {
+			return &RuleViolationError{
+				Rule:   "min cost multiplier",
+				Detail: fmt.Sprintf("cost %d is below %d, %.2f times the base cost %d", customCost, min, r.MinCostMultiplier, baseCost),
+			}
+		}
+	}
+	if r.MaxCostMultiplier > 0 {
+		if max := int(math.Floor(float64(baseCost) * r.MaxCostMultiplier)); customCost > max {
+			return &RuleViolationError{
+				Rule:   "max cost multiplier",
+				Detail: fmt.Sprintf("cost %d is above %d, %.2f times the base cost %d", customCost, max, r.MaxCostMultiplier, baseCost),
+			}
+		}
+	}
+	return nil
+}
+
+// CheckPenalty verifies that the penalty of a modified action does not exceed the XP the game master may grant
+// a character, so that a failure never costs more than a grant could give back. The penalty of the action itself
+// is always allowed.
+func (r Rulebook) CheckPenalty(basePenalty, penalty int) error {
+	if r.MaxXPGrantPerCharacter <= 0 || penalty <= basePenalty || penalty <= r.MaxXPGrantPerCharacter {
+		return nil
+	}
+	return &RuleViolationError{
+		Rule:   "max penalty",
+		Detail: fmt.Sprintf("penalty %d exceeds the limit of %d", penalty, r.MaxXPGrantPerCharacter),
+	}
+}
+
+// CheckBalance verifies that a change of XP does not leave a character with a negative balance.
+func (r Rulebook) CheckBalance(balance, change int) error {
+	if r.AllowNegativeBalance || balance+change >= 0 {
+		return nil
+	}
+	return &RuleViolationError{
+		Rule:   "no negative balance",
+		Detail: fmt.Sprintf("a change of %d XP would leave the character with %d XP", change, balance+change),
+	}
+}
@@ -0,0 +1,74 @@
+package gamemaster
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckXPGrant(t *testing.T) {
+	rb := DefaultRulebook()
+	if err := rb.CheckXPGrant(60, 40); err != nil {
+		t.Errorf("CheckXPGrant failed unexpectedly: %v", err)
+	}
+	if err := rb.CheckXPGrant(60, 41); err == nil {
+		t.Error("CheckXPGrant did not fail as expected when exceeding the limit")
+	}
+	if err := rb.CheckXPGrant(100, -50); err != nil {
+		t.Errorf("CheckXPGrant should not limit deductions, got: %v", err)
+	}
+	if err := (Rulebook{}).CheckXPGrant(1000, 1000); err != nil {
+		t.Errorf("CheckXPGrant should not limit grants without a maximum, got: %v", err)
+	}
+}
+
+func TestCheckCost(t *testing.T) {
+	rb := DefaultRulebook()
+	tests := []struct {
+		base, custom int
+		wantErr      bool
+	}{
+		{10, 10, false},
+		{10, 5, false},
+		{10, 4, true},
+		{10, 20, false},
+		{10, 21, true},
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		err := rb.CheckCost(tt.base, tt.custom)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckCost(%d, %d) error = %v, wantErr %v", tt.base, tt.custom, err, tt.wantErr)
+		}
+		var violation *RuleViolationError
+		if err != nil && !errors.As(err, &violation) {
+			t.Errorf("CheckCost(%d, %d) expected a RuleViolationError, got %T", tt.base, tt.custom, err)
+		}
+	}
+}
+
+func TestCheckPenalty(t *testing.T) {
+	rb := DefaultRulebook()
+	if err := rb.CheckPenalty(0, 100); err != nil {
+		t.Errorf("CheckPenalty failed unexpectedly: %v", err)
+	}
+	if err := rb.CheckPenalty(0, 101); err == nil {
+		t.Error("CheckPenalty did not fail as expected for a penalty above the limit")
+	}
+	if err := rb.CheckPenalty(150, 150); err != nil {
+		t.Errorf("CheckPenalty should allow the penalty of the action, got: %v", err)
+	}
+}
+
+func TestCheckBalance(t *testing.T) {
+	rb := DefaultRulebook()
+	if err := rb.CheckBalance(10, -10); err != nil {
+		t.Errorf("CheckBalance failed unexpectedly: %v", err)
+	}
+	if err := rb.CheckBalance(10, -11); err == nil {
+		t.Error("CheckBalance did not fail as expected for a negative balance")
+	}
+	rb.AllowNegativeBalance = true
+	if err := rb.CheckBalance(10, -11); err != nil {
+		t.Errorf("CheckBalance should allow negative balances when enabled, got: %v", err)
+	}
+}
@@ -2,8 +2,7 @@
 package player
 
 import (
-	"fmt"
-
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/character"
 )
 
@@ -21,6 +20,19 @@ type Player struct {
 	Name       string
 	Status     PlayerStatus
 	Characters []character.Character
+	Version    int // set by the repository, which rejects updates of an outdated version
+}
+
+// Clone returns a deep copy of the player, which can be changed without affecting the player.
+func (p *Player) Clone() *Player {
+	clone := *p
+	if p.Characters != nil {
+		clone.Characters = make([]character.Character, len(p.Characters))
+		for i := range p.Characters {
+			clone.Characters[i] = *p.Characters[i].Clone()
+		}
+	}
+	return &clone
 }
 
 // AddCharacter adds a new character to the player's list.
@@ -36,7 +48,7 @@ func (p *Player) RemoveCharacter(characterID string) error {
 			return nil
 		}
 	}
-	return fmt.Errorf("character not found")
+	return errs.Newf(errs.ErrNotFound, errs.Character, characterID, "is not a character of player %s", p.PlayerID)
 }
 
 // SetStatus changes the status of the player.
@@ -0,0 +1,98 @@
+// Package xp manages the experience point (XP) ledger of the characters within the game.
+package xp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// TransactionType defines the possible reasons for an XP balance to change.
+type TransactionType int
+
+const (
+	StartingGrant   TransactionType = iota // given to a character when it is created
+	ActionCost                             // spent by a character to perform an action
+	ActionReward                           // earned after a successful action
+	ActionPenalty                          // lost after a failed action
+	GMGrant                                // given or taken by the game master independently of actions
+	AdventurePayout                        // earned by the participants when an adventure ends
+)
+
+// Transaction represents a single, immutable change to the XP balance of a character.
+// Amount is signed: costs and penalties are negative, grants and rewards are positive.
+type Transaction struct {
+	Sequence    int // assigned by the ledger when the transaction is recorded
+	CharacterID string
+	GameID      string
+	ActorID     string // player or game master that caused the change
+	Type        TransactionType
+	Amount      int
+	Reason      string
+	Timestamp   time.Time
+}
+
+// NewTransaction creates a new XP transaction for a character in a game.
+func NewTransaction(txType TransactionType, characterID, gameID, actorID string, amount int, reason string) Transaction {
+	return Transaction{
+		CharacterID: characterID,
+		GameID:      gameID,
+		ActorID:     actorID,
+		Type:        txType,
+		Amount:      amount,
+		Reason:      reason,
+		Timestamp:   time.Now(),
+	}
+}
+
+// Validate checks that the transaction is complete and that the sign of its amount matches its type.
+func (t Transaction) Validate() error {
+	if t.CharacterID == "" {
+		return errs.Invalidf("xp transaction has no character")
+	}
+	if t.GameID == "" {
+		return errs.Invalidf("xp transaction has no game")
+	}
+	if t.ActorID == "" {
+		return errs.Invalidf("xp transaction has no actor")
+	}
+	if t.Reason == "" {
+		return errs.Invalidf("xp transaction has no reason")
+	}
+	switch t.Type {
+	case StartingGrant, ActionReward, AdventurePayout:
+		if t.Amount < 0 {
+			return errs.Invalidf("%s amount must not be negative, got %d", t.Type, t.Amount)
+		}
+	case ActionCost, ActionPenalty:
+		if t.Amount > 0 {
+			return errs.Invalidf("%s amount must not be positive, got %d", t.Type, t.Amount)
+		}
+	case GMGrant:
+		if t.Amount == 0 {
+			return errs.Invalidf("GM grant amount must not be zero")
+		}
+	default:
+		return errs.Invalidf("unknown xp transaction type %d", t.Type)
+	}
+	return nil
+}
+
+// Balance sums the amounts of the given transactions.
+func Balance(txs []Transaction) int {
+	total := 0
+	for _, t := range txs {
+		total += t.Amount
+	}
+	return total
+}
+
+// String returns the string representation of the TransactionType.
+func (t TransactionType) String() string {
+	typeNames := [...]string{"StartingGrant", "ActionCost", "ActionReward", "ActionPenalty", "GMGrant", "AdventurePayout"}
+	if t < 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("TransactionType(%d)", int(t))
+	}
+	return typeNames[t]
+}
@@ -0,0 +1,48 @@
+package xp
+
+import (
+	"testing"
+)
+
+func TestNewTransaction(t *testing.T) {
+	tx := NewTransaction(GMGrant, "char1", "game1", "gm1", 25, "brave roleplay")
+
+	if tx.CharacterID != "char1" || tx.GameID != "game1" || tx.ActorID != "gm1" || tx.Amount != 25 || tx.Timestamp.IsZero() {
+		t.Errorf("NewTransaction did not properly initialize, got: %+v", tx)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tx      Transaction
+		wantErr bool
+	}{
+		{"starting grant", NewTransaction(StartingGrant, "c1", "g1", "gm1", 100, "start"), false},
+		{"action cost", NewTransaction(ActionCost, "c1", "g1", "p1", -10, "Fireball"), false},
+		{"positive cost", NewTransaction(ActionCost, "c1", "g1", "p1", 10, "Fireball"), true},
+		{"negative reward", NewTransaction(ActionReward, "c1", "g1", "gm1", -5, "Fireball"), true},
+		{"positive penalty", NewTransaction(ActionPenalty, "c1", "g1", "gm1", 5, "Fireball"), true},
+		{"GM deduction", NewTransaction(GMGrant, "c1", "g1", "gm1", -5, "cheating"), false},
+		{"zero GM grant", NewTransaction(GMGrant, "c1", "g1", "gm1", 0, "nothing"), true},
+		{"negative payout", NewTransaction(AdventurePayout, "c1", "g1", "gm1", -10, "defeat"), true},
+		{"missing game", NewTransaction(GMGrant, "c1", "", "gm1", 5, "bonus"), true},
+		{"missing reason", NewTransaction(GMGrant, "c1", "g1", "gm1", 5, ""), true},
+	}
+	for _, tt := range tests {
+		if err := tt.tx.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestBalance(t *testing.T) {
+	txs := []Transaction{
+		NewTransaction(StartingGrant, "c1", "g1", "gm1", 100, "start"),
+		NewTransaction(ActionCost, "c1", "g1", "p1", -30, "Fireball"),
+		NewTransaction(ActionReward, "c1", "g1", "gm1", 15, "Fireball"),
+	}
+	if got := Balance(txs); got != 85 {
+		t.Errorf("Balance() = %d, want 85", got)
+	}
+}
@@ -4,6 +4,10 @@ package action
 
 import "github.com/jerberlin/dndgame/internal/model/action"
 
+// ActionRepository stores copies of the actions and the action instances, so that changing them takes effect
+// through the update methods only. CreateActionInstance sets the version of the instance to 1, and
+// UpdateActionInstance increments it. UpdateActionInstance fails with errs.ErrConflict unless the version of
+// the instance is the stored one.
 type ActionRepository interface {
 	CreateAction(a *action.Action) error
 	UpdateAction(a *action.Action) error
@@ -12,6 +16,8 @@ type ActionRepository interface {
 	CreateActionInstance(ai *action.ActionInstance) error
 	UpdateActionInstance(ai *action.ActionInstance) error
 	GetActionInstanceByID(instanceID string) (*action.ActionInstance, error)
-	ListActions() ([]*action.Action, error)                 // Retrieve all actions defined in the game.
-	ListActionInstances() ([]*action.ActionInstance, error) // Retrieve all action instances, possibly with filters for status.
+	ListActions() ([]*action.Action, error)                                              // Retrieve all actions defined in the game.
+	ListActionInstances() ([]*action.ActionInstance, error)                              // Retrieve all action instances, possibly with filters for status.
+	ListActionInstancesByCharacter(characterID string) ([]*action.ActionInstance, error) // Retrieve the action instances of a character, oldest first.
+	ListActionInstancesByGame(gameID string) ([]*action.ActionInstance, error)           // Retrieve the action instances of a game, oldest first.
 }
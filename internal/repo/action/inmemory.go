@@ -6,29 +6,39 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/action"
 )
 
+// InMemoryActionRepository stores action instances by InstanceID, with secondary indexes by character and by game.
 type InMemoryActionRepository struct {
-	actions         map[string]*action.Action
-	actionInstances map[string]*action.ActionInstance
-	mutex           sync.RWMutex
+	actions              map[string]*action.Action
+	actionInstances      map[string]*action.ActionInstance
+	instancesByCharacter map[string][]string
+	instancesByGame      map[string][]string
+	mutex                sync.RWMutex
 }
 
 func NewInMemoryActionRepository() *InMemoryActionRepository {
 	return &InMemoryActionRepository{
-		actions:         make(map[string]*action.Action),
-		actionInstances: make(map[string]*action.ActionInstance),
+		actions:              make(map[string]*action.Action),
+		actionInstances:      make(map[string]*action.ActionInstance),
+		instancesByCharacter: make(map[string][]string),
+		instancesByGame:      make(map[string][]string),
 	}
 }
 
 func (r *InMemoryActionRepository) CreateAction(a *action.Action) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	if a.ActionID == "" {
+		return errors.New("action has no ID")
+	}
 	if _, exists := r.actions[a.ActionID]; exists {
-		return errors.New("action already exists")
+		return errs.AlreadyExists(errs.Action, a.ActionID)
 	}
-	r.actions[a.ActionID] = a
+	clone := *a
+	r.actions[a.ActionID] = &clone
 	return nil
 }
 
@@ -36,9 +46,10 @@ func (r *InMemoryActionRepository) UpdateAction(a *action.Action) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.actions[a.ActionID]; !exists {
-		return errors.New("action not found")
+		return errs.NotFound(errs.Action, a.ActionID)
 	}
-	r.actions[a.ActionID] = a
+	clone := *a
+	r.actions[a.ActionID] = &clone
 	return nil
 }
 
@@ -46,7 +57,7 @@ func (r *InMemoryActionRepository) DeleteAction(actionID string) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.actions[actionID]; !exists {
-		return errors.New("action not found")
+		return errs.NotFound(errs.Action, actionID)
 	}
 	delete(r.actions, actionID)
 	return nil
@@ -56,28 +67,45 @@ func (r *InMemoryActionRepository) GetActionByID(actionID string) (*action.Actio
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 	if a, exists := r.actions[actionID]; exists {
-		return a, nil
+		clone := *a
+		return &clone, nil
 	}
-	return nil, errors.New("action not found")
+	return nil, errs.NotFound(errs.Action, actionID)
 }
 
 func (r *InMemoryActionRepository) CreateActionInstance(ai *action.ActionInstance) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.actionInstances[ai.CharacterID]; exists {
-		return errors.New("action instance already exists")
+	if ai.InstanceID == "" {
+		return errors.New("action instance has no ID")
+	}
+	if _, exists := r.actionInstances[ai.InstanceID]; exists {
+		return errs.AlreadyExists(errs.ActionInstance, ai.InstanceID)
 	}
-	r.actionInstances[ai.CharacterID] = ai
+	ai.Version = 1
+	clone := *ai
+	r.actionInstances[ai.InstanceID] = &clone
+	r.instancesByCharacter[ai.CharacterID] = append(r.instancesByCharacter[ai.CharacterID], ai.InstanceID)
+	r.instancesByGame[ai.GameID] = append(r.instancesByGame[ai.GameID], ai.InstanceID)
 	return nil
 }
 
 func (r *InMemoryActionRepository) UpdateActionInstance(ai *action.ActionInstance) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.actionInstances[ai.CharacterID]; !exists {
-		return errors.New("action instance not found")
+	stored, exists := r.actionInstances[ai.InstanceID]
+	if !exists {
+		return errs.NotFound(errs.ActionInstance, ai.InstanceID)
+	}
+	if stored.CharacterID != ai.CharacterID || stored.GameID != ai.GameID {
+		return errs.Newf(errs.ErrConflict, errs.ActionInstance, ai.InstanceID, "cannot change character or game")
+	}
+	if ai.Version != stored.Version {
+		return errs.VersionConflict(errs.ActionInstance, ai.InstanceID, stored.Version, ai.Version)
 	}
-	r.actionInstances[ai.CharacterID] = ai
+	ai.Version++
+	clone := *ai
+	r.actionInstances[ai.InstanceID] = &clone
 	return nil
 }
 
@@ -85,9 +113,10 @@ func (r *InMemoryActionRepository) GetActionInstanceByID(instanceID string) (*ac
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 	if ai, exists := r.actionInstances[instanceID]; exists {
-		return ai, nil
+		clone := *ai
+		return &clone, nil
 	}
-	return nil, errors.New("action instance not found")
+	return nil, errs.NotFound(errs.ActionInstance, instanceID)
 }
 
 // ListActions returns all actions stored in the repository.
@@ -96,7 +125,8 @@ func (r *InMemoryActionRepository) ListActions() ([]*action.Action, error) {
 	defer r.mutex.RUnlock()
 	allActions := make([]*action.Action, 0, len(r.actions))
 	for _, action := range r.actions {
-		allActions = append(allActions, action)
+		clone := *action
+		allActions = append(allActions, &clone)
 	}
 	return allActions, nil
 }
@@ -107,7 +137,61 @@ func (r *InMemoryActionRepository) ListActionInstances() ([]*action.ActionInstan
 	defer r.mutex.RUnlock()
 	allInstances := make([]*action.ActionInstance, 0, len(r.actionInstances))
 	for _, instance := range r.actionInstances {
-		allInstances = append(allInstances, instance)
+		clone := *instance
+		allInstances = append(allInstances, &clone)
 	}
 	return allInstances, nil
 }
+
+// ListActionInstancesByCharacter returns all action instances of a character, in creation order.
+func (r *InMemoryActionRepository) ListActionInstancesByCharacter(characterID string) ([]*action.ActionInstance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.instancesByID(r.instancesByCharacter[characterID]), nil
+}
+
+// ListActionInstancesByGame returns all action instances of a game, in creation order.
+func (r *InMemoryActionRepository) ListActionInstancesByGame(gameID string) ([]*action.ActionInstance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.instancesByID(r.instancesByGame[gameID]), nil
+}
+
+func (r *InMemoryActionRepository) instancesByID(instanceIDs []string) []*action.ActionInstance {
+	instances := make([]*action.ActionInstance, 0, len(instanceIDs))
+	for _, id := range instanceIDs {
+		clone := *r.actionInstances[id]
+		instances = append(instances, &clone)
+	}
+	return instances
+}
+
+// Checkpoint returns a function restoring the actions and action instances stored now, see uow.Checkpointer.
+func (r *InMemoryActionRepository) Checkpoint() func() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	actions := make(map[string]*action.Action, len(r.actions))
+	for id, a := range r.actions {
+		actions[id] = a
+	}
+	instances := make(map[string]*action.ActionInstance, len(r.actionInstances))
+	for id, ai := range r.actionInstances {
+		instances[id] = ai
+	}
+	byCharacter, byGame := copyIndex(r.instancesByCharacter), copyIndex(r.instancesByGame)
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.actions, r.actionInstances = actions, instances
+		r.instancesByCharacter, r.instancesByGame = byCharacter, byGame
+	}
+}
+
+// copyIndex copies an index. Its slices are only ever appended to, so the copy shares them.
+func copyIndex(index map[string][]string) map[string][]string {
+	clone := make(map[string][]string, len(index))
+	for key, ids := range index {
+		clone[key] = ids
+	}
+	return clone
+}
@@ -0,0 +1,14 @@
+package action_test
+
+import (
+	"testing"
+
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+)
+
+func TestActionRepository(t *testing.T) {
+	repotest.ActionRepositoryContract(t, func(t *testing.T) repoaction.ActionRepository {
+		return repoaction.NewInMemoryActionRepository()
+	})
+}
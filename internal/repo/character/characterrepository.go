@@ -4,6 +4,9 @@ package character
 
 import "github.com/jerberlin/dndgame/internal/model/character"
 
+// CharacterRepository stores copies of the characters, so that changing a character takes effect through
+// UpdateCharacter only. CreateCharacter sets the version of the character to 1, and UpdateCharacter increments it.
+// UpdateCharacter fails with errs.ErrConflict unless the version of the character is the stored one.
 type CharacterRepository interface {
 	CreateCharacter(c *character.Character) error
 	UpdateCharacter(c *character.Character) error
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/character"
 )
 
@@ -21,20 +22,29 @@ func NewInMemoryCharacterRepository() *InMemoryCharacterRepository {
 func (r *InMemoryCharacterRepository) CreateCharacter(c *character.Character) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	if c.CharacterID == "" {
+		return errors.New("character has no ID")
+	}
 	if _, exists := r.characters[c.CharacterID]; exists {
-		return errors.New("character already exists")
+		return errs.AlreadyExists(errs.Character, c.CharacterID)
 	}
-	r.characters[c.CharacterID] = c
+	c.Version = 1
+	r.characters[c.CharacterID] = c.Clone()
 	return nil
 }
 
 func (r *InMemoryCharacterRepository) UpdateCharacter(c *character.Character) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.characters[c.CharacterID]; !exists {
-		return errors.New("character not found")
+	stored, exists := r.characters[c.CharacterID]
+	if !exists {
+		return errs.NotFound(errs.Character, c.CharacterID)
+	}
+	if c.Version != stored.Version {
+		return errs.VersionConflict(errs.Character, c.CharacterID, stored.Version, c.Version)
 	}
-	r.characters[c.CharacterID] = c
+	c.Version++
+	r.characters[c.CharacterID] = c.Clone()
 	return nil
 }
 
@@ -42,7 +52,7 @@ func (r *InMemoryCharacterRepository) DeleteCharacter(characterID string) error
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.characters[characterID]; !exists {
-		return errors.New("character not found")
+		return errs.NotFound(errs.Character, characterID)
 	}
 	delete(r.characters, characterID)
 	return nil
@@ -52,9 +62,9 @@ func (r *InMemoryCharacterRepository) GetCharacterByID(characterID string) (*cha
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 	if character, exists := r.characters[characterID]; exists {
-		return character, nil
+		return character.Clone(), nil
 	}
-	return nil, errors.New("character not found")
+	return nil, errs.NotFound(errs.Character, characterID)
 }
 
 // ListCharacters retrieves all characters stored in the repository.
@@ -63,7 +73,22 @@ func (r *InMemoryCharacterRepository) ListCharacters() ([]*character.Character,
 	defer r.mutex.RUnlock()
 	allCharacters := make([]*character.Character, 0, len(r.characters))
 	for _, char := range r.characters {
-		allCharacters = append(allCharacters, char)
+		allCharacters = append(allCharacters, char.Clone())
 	}
 	return allCharacters, nil
 }
+
+// Checkpoint returns a function restoring the characters stored now, see uow.Checkpointer.
+func (r *InMemoryCharacterRepository) Checkpoint() func() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	characters := make(map[string]*character.Character, len(r.characters))
+	for id, c := range r.characters {
+		characters[id] = c
+	}
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.characters = characters
+	}
+}
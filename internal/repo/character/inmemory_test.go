@@ -0,0 +1,14 @@
+package character_test
+
+import (
+	"testing"
+
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+)
+
+func TestCharacterRepository(t *testing.T) {
+	repotest.CharacterRepositoryContract(t, func(t *testing.T) repocharacter.CharacterRepository {
+		return repocharacter.NewInMemoryCharacterRepository()
+	})
+}
@@ -0,0 +1,33 @@
+package eventsourced
+
+import (
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+)
+
+// newContractRepository opens a repository on a new in-memory store, taking snapshots often so that
+// the contract also covers them.
+func newContractRepository(t *testing.T) *Repository {
+	r, err := NewRepository(NewMemoryStore(), clock.Real{}, 2)
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v, wantErr nil", err)
+	}
+	return r
+}
+
+func TestContracts(t *testing.T) {
+	t.Run("Game", func(t *testing.T) {
+		repotest.GameRepositoryContract(t, func(t *testing.T) repogame.GameRepository { return newContractRepository(t) })
+	})
+	t.Run("Character", func(t *testing.T) {
+		repotest.CharacterRepositoryContract(t, func(t *testing.T) repocharacter.CharacterRepository { return newContractRepository(t) })
+	})
+	t.Run("Action", func(t *testing.T) {
+		repotest.ActionRepositoryContract(t, func(t *testing.T) repoaction.ActionRepository { return newContractRepository(t) })
+	})
+}
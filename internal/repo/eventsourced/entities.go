@@ -0,0 +1,212 @@
+package eventsourced
+
+import (
+	"errors"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+)
+
+// Ensure Repository implements the repositories at compile time.
+var (
+	_ repogame.GameRepository           = &Repository{}
+	_ repocharacter.CharacterRepository = &Repository{}
+	_ repoaction.ActionRepository       = &Repository{}
+)
+
+func (r *Repository) CreateGame(g *game.Game) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if g.GameID == "" {
+		return errors.New("game has no ID")
+	}
+	return r.write(gameStream+g.GameID, g.GameID, Created, &g.Version, g)
+}
+
+func (r *Repository) UpdateGame(gameID string, g *game.Game) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if g.GameID != gameID {
+		return errs.Newf(errs.ErrConflict, errs.Game, gameID, "cannot change its ID")
+	}
+	return r.write(gameStream+gameID, gameID, Updated, &g.Version, g)
+}
+
+func (r *Repository) DeleteGame(gameID string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.write(gameStream+gameID, gameID, Deleted, nil, nil)
+}
+
+func (r *Repository) GetGameByID(gameID string) (*game.Game, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var g game.Game
+	if err := r.get(gameStream+gameID, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// ListGames retrieves all games, in creation order.
+func (r *Repository) ListGames() ([]*game.Game, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return listEntities[game.Game](r, gameStream, nil)
+}
+
+func (r *Repository) CreateCharacter(c *character.Character) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if c.CharacterID == "" {
+		return errors.New("character has no ID")
+	}
+	return r.write(characterStream+c.CharacterID, "", Created, &c.Version, c)
+}
+
+func (r *Repository) UpdateCharacter(c *character.Character) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.write(characterStream+c.CharacterID, "", Updated, &c.Version, c)
+}
+
+func (r *Repository) DeleteCharacter(characterID string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.write(characterStream+characterID, "", Deleted, nil, nil)
+}
+
+func (r *Repository) GetCharacterByID(characterID string) (*character.Character, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var c character.Character
+	if err := r.get(characterStream+characterID, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ListCharacters retrieves all characters, in creation order.
+func (r *Repository) ListCharacters() ([]*character.Character, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return listEntities[character.Character](r, characterStream, nil)
+}
+
+func (r *Repository) CreateAction(a *action.Action) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if a.ActionID == "" {
+		return errors.New("action has no ID")
+	}
+	return r.write(actionStream+a.ActionID, "", Created, nil, a)
+}
+
+func (r *Repository) UpdateAction(a *action.Action) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.write(actionStream+a.ActionID, "", Updated, nil, a)
+}
+
+func (r *Repository) DeleteAction(actionID string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.write(actionStream+actionID, "", Deleted, nil, nil)
+}
+
+func (r *Repository) GetActionByID(actionID string) (*action.Action, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var a action.Action
+	if err := r.get(actionStream+actionID, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListActions retrieves all action templates, in creation order.
+func (r *Repository) ListActions() ([]*action.Action, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return listEntities[action.Action](r, actionStream, nil)
+}
+
+func (r *Repository) CreateActionInstance(ai *action.ActionInstance) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if ai.InstanceID == "" {
+		return errors.New("action instance has no ID")
+	}
+	return r.write(actionInstanceStream+ai.InstanceID, ai.GameID, Created, &ai.Version, ai)
+}
+
+func (r *Repository) UpdateActionInstance(ai *action.ActionInstance) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var stored action.ActionInstance
+	if err := r.get(actionInstanceStream+ai.InstanceID, &stored); err != nil {
+		return err
+	}
+	if stored.CharacterID != ai.CharacterID || stored.GameID != ai.GameID {
+		return errs.Newf(errs.ErrConflict, errs.ActionInstance, ai.InstanceID, "cannot change character or game")
+	}
+	return r.write(actionInstanceStream+ai.InstanceID, ai.GameID, Updated, &ai.Version, ai)
+}
+
+func (r *Repository) GetActionInstanceByID(instanceID string) (*action.ActionInstance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	var ai action.ActionInstance
+	if err := r.get(actionInstanceStream+instanceID, &ai); err != nil {
+		return nil, err
+	}
+	return &ai, nil
+}
+
+// ListActionInstances retrieves all action instances, in creation order.
+func (r *Repository) ListActionInstances() ([]*action.ActionInstance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return listEntities[action.ActionInstance](r, actionInstanceStream, nil)
+}
+
+// ListActionInstancesByCharacter retrieves the action instances of a character, in creation order.
+func (r *Repository) ListActionInstancesByCharacter(characterID string) ([]*action.ActionInstance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return listEntities[action.ActionInstance](r, actionInstanceStream, fieldEquals("CharacterID", characterID))
+}
+
+// ListActionInstancesByGame retrieves the action instances of a game, in creation order.
+func (r *Repository) ListActionInstancesByGame(gameID string) ([]*action.ActionInstance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return listEntities[action.ActionInstance](r, actionInstanceStream, fieldEquals("GameID", gameID))
+}
+
+// listEntities decodes the current state of the live streams with the given prefix. The caller holds the lock.
+func listEntities[T any](r *Repository, prefix string, filter func(doc document) bool) ([]*T, error) {
+	streams := r.list(prefix, filter)
+	entities := make([]*T, 0, len(streams))
+	for _, stream := range streams {
+		var entity T
+		if err := r.get(stream, &entity); err != nil {
+			return nil, err
+		}
+		entities = append(entities, &entity)
+	}
+	return entities, nil
+}
+
+// fieldEquals accepts the documents whose top-level string field has the given value.
+func fieldEquals(field, value string) func(doc document) bool {
+	return func(doc document) bool {
+		v, _ := doc[field].(string)
+		return v == value
+	}
+}
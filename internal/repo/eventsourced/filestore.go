@@ -0,0 +1,149 @@
+package eventsourced
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	eventsFile    = "events.jsonl"
+	snapshotsFile = "snapshots.jsonl"
+)
+
+// FileStore is a Store appending the records and snapshots as JSON lines to two files of a directory,
+// so that the games survive the process. Everything is also kept in memory for reading.
+type FileStore struct {
+	memory    *MemoryStore
+	events    *os.File
+	snapshots *os.File
+	mutex     sync.Mutex // serializes the writes to the files
+}
+
+// Ensure FileStore implements Store at compile time.
+var _ Store = &FileStore{}
+
+// OpenFileStore opens the store of a directory, creating it if needed, and loads its content.
+// A last line left incomplete by a crash is discarded.
+func OpenFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	fs := &FileStore{memory: NewMemoryStore()}
+	var err error
+	fs.events, err = openLines(filepath.Join(dir, eventsFile), func(line []byte) error {
+		var rec Record
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return err
+		}
+		return fs.memory.Append(&rec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	fs.snapshots, err = openLines(filepath.Join(dir, snapshotsFile), func(line []byte) error {
+		var snap Snapshot
+		if err := json.Unmarshal(line, &snap); err != nil {
+			return err
+		}
+		return fs.memory.SaveSnapshot(snap)
+	})
+	if err != nil {
+		fs.events.Close()
+		return nil, err
+	}
+	return fs, nil
+}
+
+// openLines reads every complete line of a file and opens it for appending.
+func openLines(path string, load func(line []byte) error) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(f)
+	var offset int64
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			if err := load(trimmed); err != nil {
+				f.Close()
+				return nil, fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+			}
+		}
+		offset += int64(len(line))
+	}
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func (fs *FileStore) Append(rec *Record) error {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return fs.memory.append(rec, func(rec Record) error {
+		return writeLine(fs.events, rec)
+	})
+}
+
+func (fs *FileStore) SaveSnapshot(snap Snapshot) error {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return fs.memory.saveSnapshot(snap, func(snap Snapshot) error {
+		return writeLine(fs.snapshots, snap)
+	})
+}
+
+func (fs *FileStore) ReadStream(stream string, fromVersion int) ([]Record, error) {
+	return fs.memory.ReadStream(stream, fromVersion)
+}
+
+func (fs *FileStore) ReadAll(afterSequence uint64) ([]Record, error) {
+	return fs.memory.ReadAll(afterSequence)
+}
+
+func (fs *FileStore) Streams() ([]string, error) {
+	return fs.memory.Streams()
+}
+
+func (fs *FileStore) LatestSnapshot(stream string, maxSequence uint64) (Snapshot, bool, error) {
+	return fs.memory.LatestSnapshot(stream, maxSequence)
+}
+
+// Close closes the files of the store.
+func (fs *FileStore) Close() error {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	return errors.Join(fs.events.Close(), fs.snapshots.Close())
+}
+
+// writeLine appends a value as a JSON line and flushes it to the disk.
+func writeLine(f *os.File, v any) error {
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,123 @@
+package eventsourced
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+)
+
+// GameView is a game as it was at a point of its history, with its characters and action instances.
+type GameView struct {
+	Sequence        uint64 // the last record taken into account
+	Game            *game.Game
+	Characters      []*character.Character
+	ActionInstances []*action.ActionInstance
+}
+
+// GameAt rebuilds a game as it was after the given version of its stream: version 1 is the game as created,
+// and every later version is one turn of its history.
+func (r *Repository) GameAt(gameID string, version int) (*GameView, error) {
+	records, err := r.store.ReadStream(gameStream+gameID, version)
+	if err != nil {
+		return nil, err
+	}
+	if version < 1 || len(records) == 0 {
+		return nil, errs.Newf(errs.ErrNotFound, errs.Game, gameID, "has no version %d", version)
+	}
+	return r.viewAt(gameID, records[0].Sequence)
+}
+
+// GameAsOf rebuilds a game as it was at the given time.
+func (r *Repository) GameAsOf(gameID string, at time.Time) (*GameView, error) {
+	records, err := r.store.ReadAll(0)
+	if err != nil {
+		return nil, err
+	}
+	var sequence uint64
+	for _, rec := range records {
+		if rec.Timestamp.After(at) {
+			break
+		}
+		sequence = rec.Sequence
+	}
+	return r.viewAt(gameID, sequence)
+}
+
+// viewAt rebuilds a game, the action instances of the game and the characters involved in it
+// as they were after the record with the given sequence number.
+func (r *Repository) viewAt(gameID string, sequence uint64) (*GameView, error) {
+	state, err := r.replay(gameStream+gameID, sequence)
+	if err != nil {
+		return nil, err
+	}
+	if state.doc == nil {
+		return nil, errs.Newf(errs.ErrNotFound, errs.Game, gameID, "did not exist at record %d", sequence)
+	}
+	view := &GameView{Sequence: sequence, Game: &game.Game{}}
+	if err := fromDocument(state.doc, view.Game); err != nil {
+		return nil, err
+	}
+
+	records, err := r.store.ReadAll(0)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var characterIDs []string
+	addCharacter := func(characterID string) {
+		if characterID != "" && !seen[characterID] {
+			seen[characterID] = true
+			characterIDs = append(characterIDs, characterID)
+		}
+	}
+	for _, c := range view.Game.Characters {
+		addCharacter(c.CharacterID)
+	}
+	for _, p := range view.Game.Players {
+		for _, c := range p.Characters {
+			addCharacter(c.CharacterID)
+		}
+	}
+
+	for _, rec := range records {
+		if rec.Sequence > sequence {
+			break
+		}
+		if rec.Type != Created || rec.GameID != gameID || !strings.HasPrefix(rec.Stream, actionInstanceStream) {
+			continue
+		}
+		instance, err := r.replay(rec.Stream, sequence)
+		if err != nil {
+			return nil, err
+		}
+		if instance.doc == nil {
+			continue
+		}
+		var ai action.ActionInstance
+		if err := fromDocument(instance.doc, &ai); err != nil {
+			return nil, err
+		}
+		view.ActionInstances = append(view.ActionInstances, &ai)
+		addCharacter(ai.CharacterID)
+	}
+
+	for _, characterID := range characterIDs {
+		state, err := r.replay(characterStream+characterID, sequence)
+		if err != nil {
+			return nil, err
+		}
+		if state.doc == nil {
+			continue
+		}
+		var c character.Character
+		if err := fromDocument(state.doc, &c); err != nil {
+			return nil, err
+		}
+		view.Characters = append(view.Characters, &c)
+	}
+	return view, nil
+}
@@ -0,0 +1,83 @@
+package eventsourced
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// document is the generic JSON form of an entity, in which the changes are computed and applied.
+type document = map[string]any
+
+// toDocument converts an entity to its generic JSON form.
+func toDocument(v any) (document, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return parseDocument(data)
+}
+
+// parseDocument decodes a JSON object, keeping the numbers exact.
+func parseDocument(data []byte) (document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc document
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// fromDocument converts the generic JSON form of an entity back to the entity.
+func fromDocument(doc document, v any) error {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// diff returns the JSON merge patch (RFC 7396) turning from into to. Arrays are replaced as a whole.
+func diff(from, to document) document {
+	patch := document{}
+	for key, value := range to {
+		previous, exists := from[key]
+		if exists && reflect.DeepEqual(previous, value) {
+			continue
+		}
+		previousObject, wasObject := previous.(document)
+		object, isObject := value.(document)
+		if exists && wasObject && isObject {
+			patch[key] = diff(previousObject, object)
+			continue
+		}
+		patch[key] = value
+	}
+	for key := range from {
+		if _, exists := to[key]; !exists {
+			patch[key] = nil
+		}
+	}
+	return patch
+}
+
+// applyPatch applies a JSON merge patch (RFC 7396) to a document, in place.
+func applyPatch(doc, patch document) document {
+	if doc == nil {
+		doc = document{}
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(doc, key)
+			continue
+		}
+		if object, isObject := value.(document); isObject {
+			target, _ := doc[key].(document)
+			doc[key] = applyPatch(target, object)
+			continue
+		}
+		doc[key] = value
+	}
+	return doc
+}
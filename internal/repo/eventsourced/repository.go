@@ -0,0 +1,247 @@
+package eventsourced
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// DefaultSnapshotInterval is the number of records of a stream between two snapshots.
+const DefaultSnapshotInterval = 50
+
+// Stream name prefixes of the entities.
+const (
+	gameStream           = "game/"
+	characterStream      = "character/"
+	actionStream         = "action/"
+	actionInstanceStream = "instance/"
+)
+
+// Repository implements the game, character and action repositories on top of a Store.
+// Every create, update and delete appends a record to the stream of the entity; the current state
+// of all streams is kept in memory and rebuilt by replay when the repository is opened.
+// It is safe for concurrent use.
+type Repository struct {
+	store            Store
+	clock            clock.Clock
+	snapshotInterval int
+	streams          map[string]*streamState
+	mutex            sync.RWMutex
+}
+
+// streamState is the state of an entity after the last record of its stream.
+type streamState struct {
+	version int
+	created uint64   // sequence number of the record creating the entity
+	doc     document // nil once deleted
+}
+
+// NewRepository opens a repository on a store, replaying its streams from their latest snapshots.
+// A snapshot of a stream is taken every snapshotInterval records; zero disables snapshots.
+func NewRepository(store Store, clk clock.Clock, snapshotInterval int) (*Repository, error) {
+	r := &Repository{
+		store:            store,
+		clock:            clk,
+		snapshotInterval: snapshotInterval,
+		streams:          make(map[string]*streamState),
+	}
+	streams, err := store.Streams()
+	if err != nil {
+		return nil, err
+	}
+	for _, stream := range streams {
+		state, err := r.replay(stream, math.MaxUint64)
+		if err != nil {
+			return nil, err
+		}
+		r.streams[stream] = state
+	}
+	return r, nil
+}
+
+// replay rebuilds the state of a stream as it was after the record with the given sequence number,
+// starting from the latest snapshot taken until then.
+func (r *Repository) replay(stream string, maxSequence uint64) (*streamState, error) {
+	state := &streamState{}
+	snap, found, err := r.store.LatestSnapshot(stream, maxSequence)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if state.doc, err = parseDocument(snap.Data); err != nil {
+			return nil, fmt.Errorf("snapshot of stream %s at version %d: %w", stream, snap.Version, err)
+		}
+		state.version, state.created = snap.Version, snap.Created
+	}
+	records, err := r.store.ReadStream(stream, state.version+1)
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range records {
+		if rec.Sequence > maxSequence {
+			break
+		}
+		if err := state.apply(rec); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// apply applies a record to the state of its stream.
+func (s *streamState) apply(rec Record) error {
+	switch rec.Type {
+	case Created:
+		doc, err := parseDocument(rec.Data)
+		if err != nil {
+			return fmt.Errorf("record %d of stream %s: %w", rec.Version, rec.Stream, err)
+		}
+		s.doc = doc
+		s.created = rec.Sequence
+	case Updated:
+		patch, err := parseDocument(rec.Data)
+		if err != nil {
+			return fmt.Errorf("record %d of stream %s: %w", rec.Version, rec.Stream, err)
+		}
+		s.doc = applyPatch(s.doc, patch)
+	case Deleted:
+		s.doc = nil
+	default:
+		return fmt.Errorf("record %d of stream %s has unknown type %d", rec.Version, rec.Stream, rec.Type)
+	}
+	s.version = rec.Version
+	return nil
+}
+
+// write appends a record changing an entity to its stream and applies it to the current state.
+// The version of a versioned entity, nil otherwise, is set to 1 by a creation; an update fails unless
+// it is the stored one, and increments it. An update changing nothing appends no record and keeps
+// the version. The caller holds the lock.
+func (r *Repository) write(stream, gameID string, recordType RecordType, version *int, entity any) error {
+	state, exists := r.streams[stream]
+	live := exists && state.doc != nil
+	kind, id := splitStream(stream)
+	switch {
+	case recordType == Created && live:
+		return errs.AlreadyExists(kind, id)
+	case recordType != Created && !live:
+		return errs.NotFound(kind, id)
+	}
+	if !exists {
+		state = &streamState{}
+	}
+	next := 1
+	if recordType == Updated && version != nil {
+		stored := documentVersion(state.doc)
+		if *version != stored {
+			return errs.VersionConflict(kind, id, stored, *version)
+		}
+		next = stored + 1
+	}
+
+	var doc document
+	var data []byte
+	var err error
+	if recordType != Deleted {
+		if doc, err = toDocument(entity); err != nil {
+			return err
+		}
+		change := doc
+		if recordType == Updated {
+			if change = diff(state.doc, doc); len(change) == 0 {
+				return nil
+			}
+		}
+		if version != nil {
+			doc[versionField] = json.Number(strconv.Itoa(next))
+			change[versionField] = doc[versionField]
+		}
+		if data, err = json.Marshal(change); err != nil {
+			return err
+		}
+	}
+
+	rec := Record{
+		Stream:    stream,
+		Version:   state.version + 1,
+		Type:      recordType,
+		GameID:    gameID,
+		Timestamp: r.clock.Now(),
+		Data:      data,
+	}
+	if err := r.store.Append(&rec); err != nil {
+		return err
+	}
+	state.version, state.doc = rec.Version, doc
+	if version != nil && recordType != Deleted {
+		*version = next
+	}
+	if recordType == Created {
+		state.created = rec.Sequence
+	}
+	r.streams[stream] = state
+
+	if r.snapshotInterval > 0 && doc != nil && rec.Version%r.snapshotInterval == 0 {
+		snapData, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		return r.store.SaveSnapshot(Snapshot{Stream: stream, Version: rec.Version, Sequence: rec.Sequence, Created: state.created, Data: snapData})
+	}
+	return nil
+}
+
+// get decodes the current state of an entity into v. The caller holds the lock.
+func (r *Repository) get(stream string, v any) error {
+	state, exists := r.streams[stream]
+	if !exists || state.doc == nil {
+		return errs.NotFound(splitStream(stream))
+	}
+	return fromDocument(state.doc, v)
+}
+
+// list returns the names of the live streams with the given prefix accepted by the filter, if any,
+// in creation order. The caller holds the lock.
+func (r *Repository) list(prefix string, filter func(doc document) bool) []string {
+	var streams []string
+	for stream, state := range r.streams {
+		if state.doc != nil && strings.HasPrefix(stream, prefix) && (filter == nil || filter(state.doc)) {
+			streams = append(streams, stream)
+		}
+	}
+	sort.Slice(streams, func(i, j int) bool {
+		return r.streams[streams[i]].created < r.streams[streams[j]].created
+	})
+	return streams
+}
+
+// versionField is the field of the versioned entities holding their version.
+const versionField = "Version"
+
+// documentVersion returns the version of a versioned entity, 0 for the entities stored before they had one.
+func documentVersion(doc document) int {
+	n, _ := doc[versionField].(json.Number)
+	v, _ := n.Int64()
+	return int(v)
+}
+
+// streamKinds names the kind of the entities of each stream prefix, as errs does.
+var streamKinds = map[string]string{
+	gameStream:           errs.Game,
+	characterStream:      errs.Character,
+	actionStream:         errs.Action,
+	actionInstanceStream: errs.ActionInstance,
+}
+
+// splitStream returns the kind and the ID of the entity of a stream.
+func splitStream(stream string) (kind, id string) {
+	prefix, id, _ := strings.Cut(stream, "/")
+	return streamKinds[prefix+"/"], id
+}
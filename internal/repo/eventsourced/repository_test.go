@@ -0,0 +1,183 @@
+package eventsourced
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+)
+
+var start = time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+
+func newTestRepository(t *testing.T, store Store, interval int) *Repository {
+	t.Helper()
+	r, err := NewRepository(store, clock.NewFake(start), interval)
+	if err != nil {
+		t.Fatalf("NewRepository() error = %v, wantErr nil", err)
+	}
+	return r
+}
+
+// playSession records a short session: a game is created and started, a character proposes an action
+// that the game master approves, and the character earns XP. Every step advances the clock by a minute.
+func playSession(t *testing.T, r *Repository) {
+	t.Helper()
+	clk := r.clock.(*clock.Fake)
+	g := &game.Game{GameID: "g1", Name: "Friday Night", Status: game.Lobby, Rulebook: gamemaster.DefaultRulebook(), Payout: game.DefaultPayoutRules()}
+	hero := &character.Character{CharacterID: "c1", Name: "Hero", XP: 100}
+	sneak := action.Action{ActionID: "a1", Name: "Sneak", BaseXPCost: 10}
+	ai := sneak.CreateInstance("g1", "c1", 10)
+	ai.InstanceID = "i1"
+
+	steps := []func() error{
+		func() error { return r.CreateGame(g) },
+		func() error { return r.CreateCharacter(hero) },
+		func() error { g.AddCharacter(*hero); return r.UpdateGame("g1", g) },
+		func() error { g.SetStatus(game.Active); return r.UpdateGame("g1", g) },
+		func() error { return r.CreateActionInstance(&ai) },
+		func() error { ai.Approve(); return r.UpdateActionInstance(&ai) },
+		func() error { hero.AdjustXP(25); return r.UpdateCharacter(hero) },
+		func() error { g.SetStatus(game.Paused); return r.UpdateGame("g1", g) },
+	}
+	for i, step := range steps {
+		clk.Advance(time.Minute)
+		if err := step(); err != nil {
+			t.Fatalf("step %d error = %v, wantErr nil", i, err)
+		}
+	}
+}
+
+func TestRepositoryCRUD(t *testing.T) {
+	store := NewMemoryStore()
+	r := newTestRepository(t, store, 0)
+	playSession(t, r)
+
+	if err := r.CreateGame(&game.Game{GameID: "g1"}); err == nil {
+		t.Errorf("CreateGame() expected error for an existing game, got nil")
+	}
+	if _, err := r.GetCharacterByID("c2"); err == nil {
+		t.Errorf("GetCharacterByID() expected error for an unknown character, got nil")
+	}
+
+	// Updates changing nothing are not recorded
+	before, _ := store.ReadAll(0)
+	g, _ := r.GetGameByID("g1")
+	if err := r.UpdateGame("g1", g); err != nil {
+		t.Fatalf("UpdateGame() error = %v, wantErr nil", err)
+	}
+	if after, _ := store.ReadAll(0); len(after) != len(before) {
+		t.Errorf("UpdateGame() without changes recorded %d records", len(after)-len(before))
+	}
+
+	// Retrieved entities are copies, changes only take effect through an update
+	g.Name = "Changed"
+	if stored, _ := r.GetGameByID("g1"); stored.Name != "Friday Night" {
+		t.Errorf("GetGameByID() should return a copy, got name %q", stored.Name)
+	}
+
+	if instances, _ := r.ListActionInstancesByGame("g1"); len(instances) != 1 || instances[0].State != action.Approved {
+		t.Errorf("ListActionInstancesByGame() got = %+v", instances)
+	}
+	if err := r.DeleteCharacter("c1"); err != nil {
+		t.Fatalf("DeleteCharacter() error = %v, wantErr nil", err)
+	}
+	if characters, _ := r.ListCharacters(); len(characters) != 0 {
+		t.Errorf("ListCharacters() should not list deleted characters, got = %+v", characters)
+	}
+}
+
+func TestRepositoryReplay(t *testing.T) {
+	dir := t.TempDir()
+	store, err := OpenFileStore(dir)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v, wantErr nil", err)
+	}
+	r := newTestRepository(t, store, 2)
+	playSession(t, r)
+	want, _ := r.GetGameByID("g1")
+	store.Close()
+
+	if snap, found, _ := store.LatestSnapshot("game/g1", 100); !found || snap.Version != 4 {
+		t.Errorf("LatestSnapshot() got = %+v, %v, want a snapshot at version 4", snap, found)
+	}
+
+	// A write interrupted by a crash leaves an incomplete line, which is discarded
+	f, _ := os.OpenFile(filepath.Join(dir, eventsFile), os.O_APPEND|os.O_WRONLY, 0o644)
+	f.WriteString(`{"Sequence":9,"Stream":"game/g1"`)
+	f.Close()
+
+	store, err = OpenFileStore(dir)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v, wantErr nil", err)
+	}
+	defer store.Close()
+	reopened := newTestRepository(t, store, 2)
+	got, err := reopened.GetGameByID("g1")
+	if err != nil {
+		t.Fatalf("GetGameByID() error = %v, wantErr nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed game got = %+v, want %+v", got, want)
+	}
+	if hero, _ := reopened.GetCharacterByID("c1"); hero.XP != 125 {
+		t.Errorf("replayed character XP got = %d, want 125", hero.XP)
+	}
+	if err := reopened.UpdateGame("g1", &game.Game{GameID: "g1", Name: "After the restart", Version: got.Version}); err != nil {
+		t.Errorf("UpdateGame() after replay error = %v, wantErr nil", err)
+	}
+}
+
+func TestRepositoryGameAt(t *testing.T) {
+	r := newTestRepository(t, NewMemoryStore(), 2)
+	playSession(t, r)
+
+	created, err := r.GameAt("g1", 1)
+	if err != nil {
+		t.Fatalf("GameAt() error = %v, wantErr nil", err)
+	}
+	if created.Game.Status != game.Lobby || len(created.Characters) != 0 || len(created.ActionInstances) != 0 {
+		t.Errorf("GameAt(1) got = %+v", created)
+	}
+
+	started, _ := r.GameAt("g1", 3)
+	if started.Game.Status != game.Active || len(started.Characters) != 1 || started.Characters[0].XP != 100 {
+		t.Errorf("GameAt(3) got = %+v", started)
+	}
+
+	// Six minutes in, the action is approved but the XP not earned yet
+	view, err := r.GameAsOf("g1", start.Add(6*time.Minute))
+	if err != nil {
+		t.Fatalf("GameAsOf() error = %v, wantErr nil", err)
+	}
+	if len(view.ActionInstances) != 1 || view.ActionInstances[0].State != action.Approved || view.Characters[0].XP != 100 {
+		t.Errorf("GameAsOf() got = %+v", view)
+	}
+
+	if _, err := r.GameAt("g1", 5); err == nil {
+		t.Errorf("GameAt() expected error for a version not reached, got nil")
+	}
+	if _, err := r.GameAsOf("g1", start); err == nil {
+		t.Errorf("GameAsOf() expected error before the game was created, got nil")
+	}
+}
+
+func TestPatch(t *testing.T) {
+	from, _ := parseDocument([]byte(`{"a":1,"b":{"c":2,"d":[1,2]},"e":"x"}`))
+	to, _ := parseDocument([]byte(`{"a":1,"b":{"c":3,"d":[1,2]},"f":null}`))
+	patch := diff(from, to)
+	expected, _ := parseDocument([]byte(`{"b":{"c":3},"e":null,"f":null}`))
+	if !reflect.DeepEqual(patch, expected) {
+		t.Errorf("diff() got = %v, want %v", patch, expected)
+	}
+	result := applyPatch(from, patch)
+	if want, _ := parseDocument([]byte(`{"a":1,"b":{"c":3,"d":[1,2]}}`)); !reflect.DeepEqual(result, want) {
+		t.Errorf("applyPatch() got = %v, want %v", result, want)
+	}
+}
@@ -0,0 +1,192 @@
+// Package eventsourced persists games, characters and action instances as streams of change events.
+// The current state is rebuilt by replaying the events, snapshots bound the replay, and any past state
+// of a game can be rebuilt to inspect it.
+package eventsourced
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RecordType defines the kind of change a record stores.
+type RecordType int
+
+const (
+	Created RecordType = iota // the data holds the full state of the new entity
+	Updated                   // the data holds a JSON merge patch (RFC 7396) from the previous state
+	Deleted                   // the data is empty
+)
+
+// Record is a single, immutable change of an entity. The records of an entity form its stream, named after
+// the kind and the ID of the entity, e.g. "game/g1". Versions count the records of a stream from 1,
+// sequence numbers count all the records of a store from 1.
+type Record struct {
+	Sequence  uint64
+	Stream    string
+	Version   int
+	Type      RecordType
+	GameID    string // the game the entity belongs to, if any
+	Timestamp time.Time
+	Data      json.RawMessage `json:",omitempty"`
+}
+
+// Snapshot is the full state of an entity after the record of its stream with the given version.
+type Snapshot struct {
+	Stream   string
+	Version  int
+	Sequence uint64
+	Created  uint64 // sequence number of the record creating the entity
+	Data     json.RawMessage
+}
+
+// Store keeps the records and snapshots of all the streams.
+type Store interface {
+	// Append stores a record with the next version of its stream and assigns its sequence number.
+	Append(rec *Record) error
+	// ReadStream returns the records of a stream from the given version on.
+	ReadStream(stream string, fromVersion int) ([]Record, error)
+	// ReadAll returns the records of all streams with a sequence number greater than the given one.
+	ReadAll(afterSequence uint64) ([]Record, error)
+	// Streams returns the names of all streams, sorted.
+	Streams() ([]string, error)
+	SaveSnapshot(s Snapshot) error
+	// LatestSnapshot returns the snapshot of a stream with the highest version taken at or before the given sequence number.
+	LatestSnapshot(stream string, maxSequence uint64) (Snapshot, bool, error)
+}
+
+// VersionConflictError is returned when a record does not carry the next version of its stream.
+type VersionConflictError struct {
+	Stream   string
+	Expected int
+	Actual   int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("stream %s expects version %d, got %d", e.Stream, e.Expected, e.Actual)
+}
+
+// MemoryStore is a Store keeping everything in memory. It is safe for concurrent use.
+type MemoryStore struct {
+	records   []Record
+	streams   map[string][]int // indexes in records, by stream
+	snapshots map[string][]Snapshot
+	mutex     sync.RWMutex
+}
+
+// Ensure MemoryStore implements Store at compile time.
+var _ Store = &MemoryStore{}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		streams:   make(map[string][]int),
+		snapshots: make(map[string][]Snapshot),
+	}
+}
+
+func (s *MemoryStore) Append(rec *Record) error {
+	return s.append(rec, nil)
+}
+
+// append assigns the sequence number of the record and calls persist, if any, before keeping it,
+// so that a record failing to persist is not stored.
+func (s *MemoryStore) append(rec *Record, persist func(rec Record) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if expected := len(s.streams[rec.Stream]) + 1; rec.Version != expected {
+		return &VersionConflictError{Stream: rec.Stream, Expected: expected, Actual: rec.Version}
+	}
+	rec.Sequence = uint64(len(s.records) + 1)
+	if persist != nil {
+		if err := persist(*rec); err != nil {
+			return err
+		}
+	}
+	s.streams[rec.Stream] = append(s.streams[rec.Stream], len(s.records))
+	s.records = append(s.records, *rec)
+	return nil
+}
+
+func (s *MemoryStore) ReadStream(stream string, fromVersion int) ([]Record, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	indexes := s.streams[stream]
+	if fromVersion < 1 {
+		fromVersion = 1
+	}
+	if fromVersion > len(indexes) {
+		return nil, nil
+	}
+	records := make([]Record, 0, len(indexes)-fromVersion+1)
+	for _, i := range indexes[fromVersion-1:] {
+		records = append(records, s.records[i])
+	}
+	return records, nil
+}
+
+func (s *MemoryStore) ReadAll(afterSequence uint64) ([]Record, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if afterSequence >= uint64(len(s.records)) {
+		return nil, nil
+	}
+	return append([]Record(nil), s.records[afterSequence:]...), nil
+}
+
+func (s *MemoryStore) Streams() ([]string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	streams := make([]string, 0, len(s.streams))
+	for stream := range s.streams {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams, nil
+}
+
+func (s *MemoryStore) SaveSnapshot(snap Snapshot) error {
+	return s.saveSnapshot(snap, nil)
+}
+
+func (s *MemoryStore) saveSnapshot(snap Snapshot, persist func(snap Snapshot) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if snap.Version < 1 || snap.Version > len(s.streams[snap.Stream]) {
+		return fmt.Errorf("snapshot of stream %s at unknown version %d", snap.Stream, snap.Version)
+	}
+	snaps := s.snapshots[snap.Stream]
+	if len(snaps) > 0 && snaps[len(snaps)-1].Version >= snap.Version {
+		return fmt.Errorf("snapshot of stream %s at version %d is not newer than the last one", snap.Stream, snap.Version)
+	}
+	if persist != nil {
+		if err := persist(snap); err != nil {
+			return err
+		}
+	}
+	s.snapshots[snap.Stream] = append(snaps, snap)
+	return nil
+}
+
+func (s *MemoryStore) LatestSnapshot(stream string, maxSequence uint64) (Snapshot, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	snaps := s.snapshots[stream]
+	for i := len(snaps) - 1; i >= 0; i-- {
+		if snaps[i].Sequence <= maxSequence {
+			return snaps[i], true, nil
+		}
+	}
+	return Snapshot{}, false, nil
+}
+
+// String returns the string representation of the RecordType.
+func (t RecordType) String() string {
+	typeNames := [...]string{"Created", "Updated", "Deleted"}
+	if t < 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("RecordType(%d)", int(t))
+	}
+	return typeNames[t]
+}
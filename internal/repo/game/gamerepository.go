@@ -5,6 +5,9 @@ package game
 import "github.com/jerberlin/dndgame/internal/model/game"
 
 // GameRepository defines the interface for game data operations.
+// It stores copies of the games, so that changing a game takes effect through UpdateGame only.
+// CreateGame sets the version of the game to 1, and UpdateGame increments it. UpdateGame fails with
+// errs.ErrConflict unless the version of the game is the stored one, i.e. it was not updated since it was read.
 type GameRepository interface {
 	CreateGame(game *game.Game) error
 	UpdateGame(gameID string, game *game.Game) error
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/game"
 )
 
@@ -21,20 +22,32 @@ func NewInMemoryGameRepository() *InMemoryGameRepository {
 func (r *InMemoryGameRepository) CreateGame(g *game.Game) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	if g.GameID == "" {
+		return errors.New("game has no ID")
+	}
 	if _, exists := r.games[g.GameID]; exists {
-		return errors.New("game already exists")
+		return errs.AlreadyExists(errs.Game, g.GameID)
 	}
-	r.games[g.GameID] = g
+	g.Version = 1
+	r.games[g.GameID] = g.Clone()
 	return nil
 }
 
 func (r *InMemoryGameRepository) UpdateGame(gameID string, g *game.Game) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.games[gameID]; !exists {
-		return errors.New("game not found")
+	stored, exists := r.games[gameID]
+	if !exists {
+		return errs.NotFound(errs.Game, gameID)
+	}
+	if g.GameID != gameID {
+		return errs.Newf(errs.ErrConflict, errs.Game, gameID, "cannot change its ID")
 	}
-	r.games[gameID] = g
+	if g.Version != stored.Version {
+		return errs.VersionConflict(errs.Game, gameID, stored.Version, g.Version)
+	}
+	g.Version++
+	r.games[gameID] = g.Clone()
 	return nil
 }
 
@@ -42,7 +55,7 @@ func (r *InMemoryGameRepository) DeleteGame(gameID string) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.games[gameID]; !exists {
-		return errors.New("game not found")
+		return errs.NotFound(errs.Game, gameID)
 	}
 	delete(r.games, gameID)
 	return nil
@@ -52,9 +65,9 @@ func (r *InMemoryGameRepository) GetGameByID(gameID string) (*game.Game, error)
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 	if game, exists := r.games[gameID]; exists {
-		return game, nil
+		return game.Clone(), nil
 	}
-	return nil, errors.New("game not found")
+	return nil, errs.NotFound(errs.Game, gameID)
 }
 
 // ListGames retrieves all games stored in the repository.
@@ -63,7 +76,22 @@ func (r *InMemoryGameRepository) ListGames() ([]*game.Game, error) {
 	defer r.mutex.RUnlock()
 	allGames := make([]*game.Game, 0, len(r.games))
 	for _, game := range r.games {
-		allGames = append(allGames, game)
+		allGames = append(allGames, game.Clone())
 	}
 	return allGames, nil
 }
+
+// Checkpoint returns a function restoring the games stored now, see uow.Checkpointer.
+func (r *InMemoryGameRepository) Checkpoint() func() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	games := make(map[string]*game.Game, len(r.games))
+	for id, g := range r.games {
+		games[id] = g
+	}
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.games = games
+	}
+}
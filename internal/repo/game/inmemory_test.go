@@ -0,0 +1,14 @@
+package game_test
+
+import (
+	"testing"
+
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+)
+
+func TestGameRepository(t *testing.T) {
+	repotest.GameRepositoryContract(t, func(t *testing.T) repogame.GameRepository {
+		return repogame.NewInMemoryGameRepository()
+	})
+}
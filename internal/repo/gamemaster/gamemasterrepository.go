@@ -4,6 +4,8 @@ package gamemaster
 
 import model "github.com/jerberlin/dndgame/internal/model/gamemaster"
 
+// GameMasterRepository stores copies of the game masters, so that changing a game master takes effect through
+// UpdateGameMaster only.
 type GameMasterRepository interface {
 	GetGameMaster(id string) (*model.GameMaster, error)
 	UpdateGameMaster(gm *model.GameMaster) error
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	model "github.com/jerberlin/dndgame/internal/model/gamemaster"
 )
 
@@ -24,28 +25,34 @@ func (r *InMemoryGameMasterRepository) GetGameMaster(id string) (*model.GameMast
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 	if gm, exists := r.masters[id]; exists {
-		return gm, nil
+		clone := *gm
+		return &clone, nil
 	}
-	return nil, errors.New("game master not found")
+	return nil, errs.NotFound(errs.GameMaster, id)
 }
 
 func (r *InMemoryGameMasterRepository) UpdateGameMaster(gm *model.GameMaster) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.masters[gm.ID]; exists {
-		r.masters[gm.ID] = gm
+		clone := *gm
+		r.masters[gm.ID] = &clone
 		return nil
 	}
-	return errors.New("game master not found")
+	return errs.NotFound(errs.GameMaster, gm.ID)
 }
 
 func (r *InMemoryGameMasterRepository) CreateGameMaster(gm *model.GameMaster) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	if gm.ID == "" {
+		return errors.New("game master has no ID")
+	}
 	if _, exists := r.masters[gm.ID]; exists {
-		return errors.New("game master already exists")
+		return errs.AlreadyExists(errs.GameMaster, gm.ID)
 	}
-	r.masters[gm.ID] = gm
+	clone := *gm
+	r.masters[gm.ID] = &clone
 	return nil
 }
 
@@ -53,7 +60,7 @@ func (r *InMemoryGameMasterRepository) DeleteGameMaster(id string) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.masters[id]; !exists {
-		return errors.New("game master not found")
+		return errs.NotFound(errs.GameMaster, id)
 	}
 	delete(r.masters, id)
 	return nil
@@ -65,7 +72,23 @@ func (r *InMemoryGameMasterRepository) ListGameMasters() ([]*model.GameMaster, e
 	defer r.mutex.RUnlock()
 	allGameMasters := make([]*model.GameMaster, 0, len(r.masters))
 	for _, gm := range r.masters {
-		allGameMasters = append(allGameMasters, gm)
+		clone := *gm
+		allGameMasters = append(allGameMasters, &clone)
 	}
 	return allGameMasters, nil
 }
+
+// Checkpoint returns a function restoring the game masters stored now, see uow.Checkpointer.
+func (r *InMemoryGameMasterRepository) Checkpoint() func() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	masters := make(map[string]*model.GameMaster, len(r.masters))
+	for id, gm := range r.masters {
+		masters[id] = gm
+	}
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.masters = masters
+	}
+}
@@ -0,0 +1,14 @@
+package gamemaster_test
+
+import (
+	"testing"
+
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+)
+
+func TestGameMasterRepository(t *testing.T) {
+	repotest.GameMasterRepositoryContract(t, func(t *testing.T) repogamemaster.GameMasterRepository {
+		return repogamemaster.NewInMemoryGameMasterRepository()
+	})
+}
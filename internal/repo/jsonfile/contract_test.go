@@ -0,0 +1,48 @@
+package jsonfile
+
+import (
+	"testing"
+
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// newContractStore opens a store in a new directory, closed at the end of the test.
+func newContractStore(t *testing.T) *Store {
+	s := openTestStore(t, t.TempDir())
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestContracts(t *testing.T) {
+	t.Run("Game", func(t *testing.T) {
+		repotest.GameRepositoryContract(t, func(t *testing.T) repogame.GameRepository { return newContractStore(t).Games() })
+	})
+	t.Run("Player", func(t *testing.T) {
+		repotest.PlayerRepositoryContract(t, func(t *testing.T) repoplayer.PlayerRepository { return newContractStore(t).Players() })
+	})
+	t.Run("Character", func(t *testing.T) {
+		repotest.CharacterRepositoryContract(t, func(t *testing.T) repocharacter.CharacterRepository { return newContractStore(t).Characters() })
+	})
+	t.Run("GameMaster", func(t *testing.T) {
+		repotest.GameMasterRepositoryContract(t, func(t *testing.T) repogamemaster.GameMasterRepository { return newContractStore(t).GameMasters() })
+	})
+	t.Run("Action", func(t *testing.T) {
+		repotest.ActionRepositoryContract(t, func(t *testing.T) repoaction.ActionRepository { return newContractStore(t).Actions() })
+	})
+	t.Run("XP", func(t *testing.T) {
+		repotest.XPRepositoryContract(t, func(t *testing.T) repoxp.XPRepository { return newContractStore(t).XP() })
+	})
+	t.Run("UnitOfWork", func(t *testing.T) {
+		repotest.UnitOfWorkContract(t, func(t *testing.T) (uow.UnitOfWork, uow.Repositories) {
+			s := newContractStore(t)
+			return s, s.Repositories()
+		})
+	})
+}
@@ -0,0 +1,26 @@
+//go:build !unix
+
+package jsonfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// lockDir creates the lock file of a directory exclusively. Unlike on Unix, a lock file left by
+// a process that died has to be removed by hand.
+func lockDir(dir string) (*os.File, error) {
+	f, err := os.OpenFile(filepath.Join(dir, ".lock"), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		return nil, ErrLocked
+	}
+	return f, err
+}
+
+func unlockDir(f *os.File) error {
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.Name())
+}
@@ -0,0 +1,344 @@
+package jsonfile
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// GameRepository stores games under games/ of the data directory.
+type GameRepository struct{ store *Store }
+
+// PlayerRepository stores players under players/ of the data directory.
+type PlayerRepository struct{ store *Store }
+
+// CharacterRepository stores characters under characters/ of the data directory.
+type CharacterRepository struct{ store *Store }
+
+// GameMasterRepository stores game masters under gamemasters/ of the data directory.
+type GameMasterRepository struct{ store *Store }
+
+// ActionRepository stores action templates under actions/ and action instances under instances/ of the data directory.
+type ActionRepository struct{ store *Store }
+
+// XPRepository stores the XP ledger under xp/ of the data directory, one file per transaction.
+type XPRepository struct{ store *Store }
+
+// Ensure the repositories implement their interfaces at compile time.
+var (
+	_ repogame.GameRepository             = &GameRepository{}
+	_ repoplayer.PlayerRepository         = &PlayerRepository{}
+	_ repocharacter.CharacterRepository   = &CharacterRepository{}
+	_ repogamemaster.GameMasterRepository = &GameMasterRepository{}
+	_ repoaction.ActionRepository         = &ActionRepository{}
+	_ repoxp.XPRepository                 = &XPRepository{}
+)
+
+// Games returns the game repository of the store.
+func (s *Store) Games() *GameRepository { return &GameRepository{store: s} }
+
+// Players returns the player repository of the store.
+func (s *Store) Players() *PlayerRepository { return &PlayerRepository{store: s} }
+
+// Characters returns the character repository of the store.
+func (s *Store) Characters() *CharacterRepository { return &CharacterRepository{store: s} }
+
+// GameMasters returns the game master repository of the store.
+func (s *Store) GameMasters() *GameMasterRepository { return &GameMasterRepository{store: s} }
+
+// Actions returns the action repository of the store.
+func (s *Store) Actions() *ActionRepository { return &ActionRepository{store: s} }
+
+// XP returns the XP ledger of the store.
+func (s *Store) XP() *XPRepository { return &XPRepository{store: s} }
+
+// Repositories returns all the repositories of the store.
+func (s *Store) Repositories() uow.Repositories {
+	return uow.Repositories{
+		Games:       s.Games(),
+		Players:     s.Players(),
+		Characters:  s.Characters(),
+		GameMasters: s.GameMasters(),
+		Actions:     s.Actions(),
+		XP:          s.XP(),
+	}
+}
+
+func (r *GameRepository) CreateGame(g *game.Game) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.games.create(g.GameID, &g.Version, g)
+}
+
+func (r *GameRepository) UpdateGame(gameID string, g *game.Game) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	if g.GameID != gameID {
+		return errs.Newf(errs.ErrConflict, errs.Game, gameID, "cannot change its ID")
+	}
+	return r.store.games.update(gameID, &g.Version, g)
+}
+
+func (r *GameRepository) DeleteGame(gameID string) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.games.remove(gameID)
+}
+
+func (r *GameRepository) GetGameByID(gameID string) (*game.Game, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	var g game.Game
+	if err := r.store.games.get(gameID, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// ListGames retrieves all games, in creation order.
+func (r *GameRepository) ListGames() ([]*game.Game, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll[game.Game](r.store.games, nil)
+}
+
+func (r *PlayerRepository) CreatePlayer(p *player.Player) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.players.create(p.PlayerID, &p.Version, p)
+}
+
+func (r *PlayerRepository) UpdatePlayer(playerID string, p *player.Player) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	if p.PlayerID != playerID {
+		return errs.Newf(errs.ErrConflict, errs.Player, playerID, "cannot change its ID")
+	}
+	return r.store.players.update(playerID, &p.Version, p)
+}
+
+func (r *PlayerRepository) DeletePlayer(playerID string) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.players.remove(playerID)
+}
+
+func (r *PlayerRepository) GetPlayerByID(playerID string) (*player.Player, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	var p player.Player
+	if err := r.store.players.get(playerID, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListPlayers retrieves all players, in creation order.
+func (r *PlayerRepository) ListPlayers() ([]*player.Player, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll[player.Player](r.store.players, nil)
+}
+
+func (r *CharacterRepository) CreateCharacter(c *character.Character) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.characters.create(c.CharacterID, &c.Version, c)
+}
+
+func (r *CharacterRepository) UpdateCharacter(c *character.Character) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.characters.update(c.CharacterID, &c.Version, c)
+}
+
+func (r *CharacterRepository) DeleteCharacter(characterID string) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.characters.remove(characterID)
+}
+
+func (r *CharacterRepository) GetCharacterByID(characterID string) (*character.Character, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	var c character.Character
+	if err := r.store.characters.get(characterID, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ListCharacters retrieves all characters, in creation order.
+func (r *CharacterRepository) ListCharacters() ([]*character.Character, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll[character.Character](r.store.characters, nil)
+}
+
+func (r *GameMasterRepository) GetGameMaster(id string) (*gamemaster.GameMaster, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	var gm gamemaster.GameMaster
+	if err := r.store.gameMasters.get(id, &gm); err != nil {
+		return nil, err
+	}
+	return &gm, nil
+}
+
+func (r *GameMasterRepository) UpdateGameMaster(gm *gamemaster.GameMaster) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.gameMasters.update(gm.ID, nil, gm)
+}
+
+func (r *GameMasterRepository) CreateGameMaster(gm *gamemaster.GameMaster) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.gameMasters.create(gm.ID, nil, gm)
+}
+
+func (r *GameMasterRepository) DeleteGameMaster(id string) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.gameMasters.remove(id)
+}
+
+// ListGameMasters retrieves all game masters, in creation order.
+func (r *GameMasterRepository) ListGameMasters() ([]*gamemaster.GameMaster, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll[gamemaster.GameMaster](r.store.gameMasters, nil)
+}
+
+func (r *ActionRepository) CreateAction(a *action.Action) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.actions.create(a.ActionID, nil, a)
+}
+
+func (r *ActionRepository) UpdateAction(a *action.Action) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.actions.update(a.ActionID, nil, a)
+}
+
+func (r *ActionRepository) DeleteAction(actionID string) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.actions.remove(actionID)
+}
+
+func (r *ActionRepository) GetActionByID(actionID string) (*action.Action, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	var a action.Action
+	if err := r.store.actions.get(actionID, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListActions retrieves all action templates, in creation order.
+func (r *ActionRepository) ListActions() ([]*action.Action, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll[action.Action](r.store.actions, nil)
+}
+
+func (r *ActionRepository) CreateActionInstance(ai *action.ActionInstance) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return r.store.instances.create(ai.InstanceID, &ai.Version, ai)
+}
+
+func (r *ActionRepository) UpdateActionInstance(ai *action.ActionInstance) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	var stored action.ActionInstance
+	if err := r.store.instances.get(ai.InstanceID, &stored); err != nil {
+		return err
+	}
+	if stored.CharacterID != ai.CharacterID || stored.GameID != ai.GameID {
+		return errs.Newf(errs.ErrConflict, errs.ActionInstance, ai.InstanceID, "cannot change character or game")
+	}
+	return r.store.instances.update(ai.InstanceID, &ai.Version, ai)
+}
+
+func (r *ActionRepository) GetActionInstanceByID(instanceID string) (*action.ActionInstance, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	var ai action.ActionInstance
+	if err := r.store.instances.get(instanceID, &ai); err != nil {
+		return nil, err
+	}
+	return &ai, nil
+}
+
+// ListActionInstances retrieves all action instances, in creation order.
+func (r *ActionRepository) ListActionInstances() ([]*action.ActionInstance, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll[action.ActionInstance](r.store.instances, nil)
+}
+
+// ListActionInstancesByCharacter retrieves the action instances of a character, in creation order.
+func (r *ActionRepository) ListActionInstancesByCharacter(characterID string) ([]*action.ActionInstance, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll(r.store.instances, func(ai *action.ActionInstance) bool { return ai.CharacterID == characterID })
+}
+
+// ListActionInstancesByGame retrieves the action instances of a game, in creation order.
+func (r *ActionRepository) ListActionInstancesByGame(gameID string) ([]*action.ActionInstance, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll(r.store.instances, func(ai *action.ActionInstance) bool { return ai.GameID == gameID })
+}
+
+// AppendTransaction stores the transaction and assigns its sequence number.
+func (r *XPRepository) AppendTransaction(tx *xp.Transaction) error {
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	if tx.Sequence != 0 {
+		return errors.New("xp transaction already recorded")
+	}
+	stored := *tx
+	stored.Sequence = len(r.store.xp.entities) + 1
+	// Zero-padded IDs keep the files of the ledger sorted by name.
+	if err := r.store.xp.create(fmt.Sprintf("%010d", stored.Sequence), nil, &stored); err != nil {
+		return err
+	}
+	tx.Sequence = stored.Sequence
+	return nil
+}
+
+// ListTransactionsByCharacter retrieves all transactions of a character, oldest first.
+func (r *XPRepository) ListTransactionsByCharacter(characterID string) ([]*xp.Transaction, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll(r.store.xp, func(tx *xp.Transaction) bool { return tx.CharacterID == characterID })
+}
+
+// ListTransactionsByGame retrieves all transactions recorded in a game, oldest first.
+func (r *XPRepository) ListTransactionsByGame(gameID string) ([]*xp.Transaction, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	return listAll(r.store.xp, func(tx *xp.Transaction) bool { return tx.GameID == gameID })
+}
@@ -0,0 +1,301 @@
+// Package jsonfile implements the repositories on JSON files under a data directory, one file per entity,
+// so that games survive restarts without a database.
+package jsonfile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// Store is a data directory opened by a single process. The entities are loaded when it is opened
+// and every change is written through to its file with an atomic replace. It is safe for concurrent use.
+//
+// Store is a uow.UnitOfWork. A unit holds the lock of the store until it ends and keeps its changes in
+// memory; its commit writes them to a redo log first, so that a crash in the middle of the commit is
+// completed when the store is opened again.
+type Store struct {
+	dir         string
+	lock        *os.File // holds the lock of the directory until Close
+	sequence    uint64   // last creation sequence number, to list entities in creation order
+	unit        *unit    // the unit of work running, if any
+	redoPending bool     // the redo log of the last unit committed is not written to the files yet
+	mutex       sync.RWMutex
+
+	games       *collection
+	players     *collection
+	characters  *collection
+	gameMasters *collection
+	actions     *collection
+	instances   *collection
+	xp          *collection
+}
+
+// Open opens a data directory, creating it if needed. It fails if another process has the directory open.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	lock, err := lockDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := redo(dir); err != nil {
+		unlockDir(lock)
+		return nil, err
+	}
+	s := &Store{dir: dir, lock: lock}
+	for _, c := range []struct {
+		target **collection
+		kind   string
+		sub    string
+	}{
+		{&s.games, errs.Game, "games"},
+		{&s.players, errs.Player, "players"},
+		{&s.characters, errs.Character, "characters"},
+		{&s.gameMasters, errs.GameMaster, "gamemasters"},
+		{&s.actions, errs.Action, "actions"},
+		{&s.instances, errs.ActionInstance, "instances"},
+		{&s.xp, "xp transaction", "xp"},
+	} {
+		if *c.target, err = s.load(c.kind, c.sub); err != nil {
+			unlockDir(lock)
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Close releases the lock of the directory. The store must not be used afterwards.
+func (s *Store) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return unlockDir(s.lock)
+}
+
+// envelope is the content of an entity file.
+type envelope struct {
+	Created uint64 // creation sequence number of the entity within the store
+	Version int    `json:",omitempty"` // version of a versioned entity, also stored in the entity
+	Entity  json.RawMessage
+}
+
+// collection is the set of entities of one kind, stored in a subdirectory.
+type collection struct {
+	store    *Store
+	kind     string
+	sub      string // the subdirectory of the data directory
+	dir      string
+	entities map[string]envelope
+}
+
+func (s *Store) load(kind, sub string) (*collection, error) {
+	c := &collection{store: s, kind: kind, sub: sub, dir: filepath.Join(s.dir, sub), entities: make(map[string]envelope)}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue // including the temporary files left by an interrupted write
+		}
+		id, err := url.PathUnescape(strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Join(c.dir, name), err)
+		}
+		data, err := os.ReadFile(filepath.Join(c.dir, name))
+		if err != nil {
+			return nil, err
+		}
+		var env envelope
+		if err := json.Unmarshal(data, &env); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Join(c.dir, name), err)
+		}
+		c.entities[id] = env
+		if env.Created > s.sequence {
+			s.sequence = env.Created
+		}
+	}
+	return c, nil
+}
+
+// The following methods are called with the lock of the store held.
+
+// create stores a new entity. The version of a versioned entity, nil otherwise, is set to 1.
+func (c *collection) create(id string, version *int, entity any) error {
+	if id == "" {
+		return fmt.Errorf("%s has no ID", c.kind)
+	}
+	if _, exists := c.entities[id]; exists {
+		return errs.AlreadyExists(c.kind, id)
+	}
+	return c.writeVersion(id, c.store.sequence+1, version, 1, entity)
+}
+
+// update replaces an entity. The version of a versioned entity, nil otherwise, has to be the stored one
+// and is incremented.
+func (c *collection) update(id string, version *int, entity any) error {
+	env, exists := c.entities[id]
+	if !exists {
+		return errs.NotFound(c.kind, id)
+	}
+	if version != nil && *version != env.Version {
+		return errs.VersionConflict(c.kind, id, env.Version, *version)
+	}
+	return c.writeVersion(id, env.Created, version, env.Version+1, entity)
+}
+
+// writeVersion writes an entity with its version set to next, which is restored if the write fails.
+func (c *collection) writeVersion(id string, created uint64, version *int, next int, entity any) error {
+	if version == nil {
+		return c.write(id, created, 0, entity)
+	}
+	previous := *version
+	*version = next
+	if err := c.write(id, created, next, entity); err != nil {
+		*version = previous
+		return err
+	}
+	return nil
+}
+
+func (c *collection) remove(id string) error {
+	if _, exists := c.entities[id]; !exists {
+		return errs.NotFound(c.kind, id)
+	}
+	if err := c.persist(id, nil); err != nil {
+		return err
+	}
+	delete(c.entities, id)
+	return nil
+}
+
+// get decodes a copy of an entity into v.
+func (c *collection) get(id string, v any) error {
+	env, exists := c.entities[id]
+	if !exists {
+		return errs.NotFound(c.kind, id)
+	}
+	return json.Unmarshal(env.Entity, v)
+}
+
+// ids returns the IDs of the entities in creation order.
+func (c *collection) ids() []string {
+	ids := make([]string, 0, len(c.entities))
+	for id := range c.entities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return c.entities[ids[i]].Created < c.entities[ids[j]].Created
+	})
+	return ids
+}
+
+// write stores an entity in its file, replacing the previous content atomically.
+func (c *collection) write(id string, created uint64, version int, entity any) error {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return err
+	}
+	env := envelope{Created: created, Version: version, Entity: data}
+	if err := c.persist(id, &env); err != nil {
+		return err
+	}
+	c.entities[id] = env
+	if created > c.store.sequence {
+		c.store.sequence = created
+	}
+	return nil
+}
+
+// persist writes the file of an entity, or removes it if env is nil. Within a unit of work, the file is
+// written by the commit of the unit.
+func (c *collection) persist(id string, env *envelope) error {
+	if c.store.unit != nil {
+		c.store.unit.record(c, id)
+		return nil
+	}
+	if err := c.store.finishCommit(); err != nil {
+		return err
+	}
+	if env == nil {
+		return os.Remove(c.path(id))
+	}
+	return writeEnvelope(c.dir, c.path(id), *env)
+}
+
+func (c *collection) path(id string) string {
+	return entityPath(c.dir, id)
+}
+
+func entityPath(dir, id string) string {
+	return filepath.Join(dir, url.PathEscape(id)+".json")
+}
+
+// writeEnvelope writes the file of an entity.
+func writeEnvelope(dir, path string, env envelope) error {
+	content, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeAtomic(dir, path, content)
+}
+
+// writeAtomic writes a file through a temporary file renamed over it, so that readers and crashes
+// only ever see the previous or the new content.
+func writeAtomic(dir, path string, content []byte) (err error) {
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// listAll decodes the entities of a collection accepted by the filter, if any, in creation order.
+func listAll[T any](c *collection, filter func(*T) bool) ([]*T, error) {
+	var result []*T
+	for _, id := range c.ids() {
+		entity := new(T)
+		if err := c.get(id, entity); err != nil {
+			return nil, err
+		}
+		if filter == nil || filter(entity) {
+			result = append(result, entity)
+		}
+	}
+	if result == nil {
+		result = []*T{}
+	}
+	return result, nil
+}
+
+// ErrLocked is returned by Open when another process has the data directory open.
+var ErrLocked = errors.New("data directory is used by another process")
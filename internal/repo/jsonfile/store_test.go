@@ -0,0 +1,156 @@
+package jsonfile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+)
+
+func openTestStore(t *testing.T, dir string) *Store {
+	t.Helper()
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open() error = %v, wantErr nil", err)
+	}
+	return s
+}
+
+func TestStoreSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	s := openTestStore(t, dir)
+
+	s.Games().CreateGame(&game.Game{GameID: "g1", Name: "Friday Night", Status: game.Lobby})
+	s.Players().CreatePlayer(&player.Player{PlayerID: "p/1", Name: "Slashed"})
+	s.Characters().CreateCharacter(&character.Character{CharacterID: "c1", Name: "Hero", XP: 100})
+	for _, id := range []string{"i2", "i1", "i3"} {
+		s.Actions().CreateActionInstance(&action.ActionInstance{InstanceID: id, GameID: "g1", CharacterID: "c1"})
+	}
+	tx := xp.NewTransaction(xp.StartingGrant, "c1", "g1", "p/1", 100, "starting XP")
+	if err := s.XP().AppendTransaction(&tx); err != nil || tx.Sequence != 1 {
+		t.Fatalf("AppendTransaction() got sequence %d, error = %v", tx.Sequence, err)
+	}
+	g, _ := s.Games().GetGameByID("g1")
+	g.Status = game.Active
+	s.Games().UpdateGame("g1", g)
+
+	if _, err := Open(dir); !errors.Is(err, ErrLocked) {
+		t.Errorf("Open() of a directory in use expected ErrLocked, got %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v, wantErr nil", err)
+	}
+
+	// A temporary file left by an interrupted write is ignored
+	os.WriteFile(filepath.Join(dir, "games", ".tmp-123"), []byte("{"), 0o644)
+
+	s = openTestStore(t, dir)
+	defer s.Close()
+	if g, err := s.Games().GetGameByID("g1"); err != nil || g.Status != game.Active || g.Name != "Friday Night" {
+		t.Errorf("GetGameByID() after restart got = %+v, %v", g, err)
+	}
+	if p, err := s.Players().GetPlayerByID("p/1"); err != nil || p.Name != "Slashed" {
+		t.Errorf("GetPlayerByID() after restart got = %+v, %v", p, err)
+	}
+	instances, _ := s.Actions().ListActionInstancesByCharacter("c1")
+	if len(instances) != 3 || instances[0].InstanceID != "i2" || instances[2].InstanceID != "i3" {
+		t.Errorf("ListActionInstancesByCharacter() should keep the creation order, got = %+v", instances)
+	}
+	tx = xp.NewTransaction(xp.GMGrant, "c1", "g1", "gm1", 5, "bonus")
+	s.XP().AppendTransaction(&tx)
+	if ledger, _ := s.XP().ListTransactionsByCharacter("c1"); len(ledger) != 2 || ledger[1].Sequence != 2 {
+		t.Errorf("ListTransactionsByCharacter() after restart got = %+v", ledger)
+	}
+}
+
+func TestStoreReturnsCopies(t *testing.T) {
+	s := openTestStore(t, t.TempDir())
+	defer s.Close()
+
+	c := &character.Character{CharacterID: "c1", Name: "Hero"}
+	s.Characters().CreateCharacter(c)
+	c.Name = "Changed"
+	stored, _ := s.Characters().GetCharacterByID("c1")
+	stored.XP = 1000
+	if again, _ := s.Characters().GetCharacterByID("c1"); again.Name != "Hero" || again.XP != 0 {
+		t.Errorf("changes outside of UpdateCharacter() must not be stored, got = %+v", again)
+	}
+	if err := s.Characters().DeleteCharacter("c1"); err != nil {
+		t.Fatalf("DeleteCharacter() error = %v, wantErr nil", err)
+	}
+	if _, err := os.Stat(filepath.Join(s.dir, "characters", "c1.json")); !os.IsNotExist(err) {
+		t.Errorf("DeleteCharacter() should remove the file, got %v", err)
+	}
+}
+
+func TestUnitOfWorkSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	s := openTestStore(t, dir)
+	s.Characters().CreateCharacter(&character.Character{CharacterID: "c1", Name: "Hero", XP: 100})
+	s.Characters().CreateCharacter(&character.Character{CharacterID: "c2", Name: "Sidekick"})
+
+	err := s.Do(func(r uow.Repositories) error {
+		c, _ := r.Characters.GetCharacterByID("c1")
+		c.XP = 80
+		if err := r.Characters.UpdateCharacter(c); err != nil {
+			return err
+		}
+		tx := xp.NewTransaction(xp.ActionCost, "c1", "g1", "p1", -20, "Sneak")
+		return r.XP.AppendTransaction(&tx)
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v, wantErr nil", err)
+	}
+	s.Do(func(r uow.Repositories) error {
+		r.Characters.DeleteCharacter("c2")
+		return errors.New("rollback")
+	})
+	if _, err := os.Stat(filepath.Join(dir, redoLog)); !os.IsNotExist(err) {
+		t.Errorf("Do() should remove the redo log once committed, got %v", err)
+	}
+	s.Close()
+
+	s = openTestStore(t, dir)
+	if c, err := s.Characters().GetCharacterByID("c1"); err != nil || c.XP != 80 || c.Version != 2 {
+		t.Errorf("GetCharacterByID() of a character committed by a unit got = %+v, %v", c, err)
+	}
+	if ledger, _ := s.XP().ListTransactionsByCharacter("c1"); len(ledger) != 1 {
+		t.Errorf("ListTransactionsByCharacter() of a transaction committed by a unit got = %+v", ledger)
+	}
+	if _, err := s.Characters().GetCharacterByID("c2"); err != nil {
+		t.Errorf("GetCharacterByID() of a character deleted by a unit rolled back error = %v, wantErr nil", err)
+	}
+
+	// A crash after the redo log of a unit is written: the next Open writes its entities
+	c, _ := s.Characters().GetCharacterByID("c1")
+	c.XP = 60
+	c.Version = 3
+	entity, _ := json.Marshal(c)
+	created := s.characters.entities["c1"].Created
+	s.Close()
+	log, _ := json.Marshal([]logEntry{
+		{Collection: "characters", ID: "c1", Envelope: &envelope{Created: created, Version: 3, Entity: entity}},
+		{Collection: "characters", ID: "c2"},
+	})
+	os.WriteFile(filepath.Join(dir, redoLog), log, 0o644)
+
+	s = openTestStore(t, dir)
+	defer s.Close()
+	if c, err := s.Characters().GetCharacterByID("c1"); err != nil || c.XP != 60 || c.Version != 3 {
+		t.Errorf("GetCharacterByID() after a redo got = %+v, %v", c, err)
+	}
+	if _, err := s.Characters().GetCharacterByID("c2"); err == nil {
+		t.Errorf("GetCharacterByID() of a character deleted by a redo expected error, got nil")
+	}
+	if _, err := os.Stat(filepath.Join(dir, redoLog)); !os.IsNotExist(err) {
+		t.Errorf("Open() should remove the redo log once written, got %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package jsonfile
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+)
+
+// redoLog is the file of the data directory holding the changes of the unit of work being committed.
+const redoLog = "commit.json"
+
+// Ensure Store implements uow.UnitOfWork at compile time.
+var _ uow.UnitOfWork = &Store{}
+
+// Do runs fn within a unit of work, see uow.UnitOfWork. The unit holds the lock of the store, so that
+// its changes are isolated until the commit; the entities are written to their files by the commit only.
+func (s *Store) Do(fn func(r uow.Repositories) error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.finishCommit(); err != nil {
+		return err
+	}
+	u := &unit{sequence: s.sequence, changed: make(map[entityKey]bool)}
+	s.unit = u
+	committed := false
+	defer func() {
+		s.unit = nil
+		if !committed {
+			u.rollback()
+			s.sequence = u.sequence
+		}
+	}()
+
+	// The repositories of the unit share the collections of the store, with a lock of their own since
+	// the unit holds the lock of the store.
+	view := &Store{
+		dir:         s.dir,
+		games:       s.games,
+		players:     s.players,
+		characters:  s.characters,
+		gameMasters: s.gameMasters,
+		actions:     s.actions,
+		instances:   s.instances,
+		xp:          s.xp,
+	}
+	if err := fn(view.Repositories()); err != nil {
+		return err
+	}
+
+	entries := u.entries()
+	if len(entries) == 0 {
+		committed = true
+		return nil
+	}
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	if err := writeAtomic(s.dir, filepath.Join(s.dir, redoLog), content); err != nil {
+		return err
+	}
+	// The unit is committed once its redo log is written: if writing the entities fails, the log is kept
+	// and completed by the next write or the next Open.
+	committed = true
+	s.redoPending = true
+	return s.finishCommit()
+}
+
+// finishCommit writes the entities of the redo log left by the commit of a unit of work, if any, before
+// the store changes them again.
+func (s *Store) finishCommit() error {
+	if !s.redoPending {
+		return nil
+	}
+	if err := redo(s.dir); err != nil {
+		return err
+	}
+	s.redoPending = false
+	return nil
+}
+
+// unit is the journal of a unit of work: the state of the entities it changed before it began.
+type unit struct {
+	sequence uint64 // the sequence of the store when the unit began
+	changes  []change
+	changed  map[entityKey]bool
+}
+
+type entityKey struct {
+	c  *collection
+	id string
+}
+
+// change is the state of an entity before a unit of work changed it.
+type change struct {
+	entityKey
+	previous envelope
+	existed  bool
+}
+
+// record records the state of an entity about to be changed, unless it was changed already.
+func (u *unit) record(c *collection, id string) {
+	key := entityKey{c: c, id: id}
+	if u.changed[key] {
+		return
+	}
+	u.changed[key] = true
+	previous, existed := c.entities[id]
+	u.changes = append(u.changes, change{entityKey: key, previous: previous, existed: existed})
+}
+
+// rollback restores the state of the entities changed by the unit.
+func (u *unit) rollback() {
+	for _, ch := range u.changes {
+		if ch.existed {
+			ch.c.entities[ch.id] = ch.previous
+		} else {
+			delete(ch.c.entities, ch.id)
+		}
+	}
+}
+
+// entries returns the state of the entities changed by the unit, as written to the redo log.
+func (u *unit) entries() []logEntry {
+	entries := make([]logEntry, 0, len(u.changes))
+	for _, ch := range u.changes {
+		entry := logEntry{Collection: ch.c.sub, ID: ch.id}
+		if env, exists := ch.c.entities[ch.id]; exists {
+			entry.Envelope = &env
+		} else if !ch.existed {
+			continue // created and removed by the unit
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// logEntry is the state of an entity committed by a unit of work.
+type logEntry struct {
+	Collection string // the subdirectory of the collection
+	ID         string
+	Envelope   *envelope `json:",omitempty"` // nil if the entity was removed
+}
+
+// redo writes the entities of the redo log of a data directory, if any, and removes the log.
+func redo(dir string) error {
+	path := filepath.Join(dir, redoLog)
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var entries []logEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		sub := filepath.Join(dir, entry.Collection)
+		if err := os.MkdirAll(sub, 0o755); err != nil {
+			return err
+		}
+		if entry.Envelope == nil {
+			if err := os.Remove(entityPath(sub, entry.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		} else if err := writeEnvelope(sub, entityPath(sub, entry.ID), *entry.Envelope); err != nil {
+			return err
+		}
+	}
+	return os.Remove(path)
+}
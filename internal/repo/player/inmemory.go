@@ -4,6 +4,7 @@ import (
 	"errors"
 	"sync"
 
+	"github.com/jerberlin/dndgame/internal/errs"
 	"github.com/jerberlin/dndgame/internal/model/player"
 )
 
@@ -21,20 +22,32 @@ func NewInMemoryPlayerRepository() *InMemoryPlayerRepository {
 func (r *InMemoryPlayerRepository) CreatePlayer(p *player.Player) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
+	if p.PlayerID == "" {
+		return errors.New("player has no ID")
+	}
 	if _, exists := r.players[p.PlayerID]; exists {
-		return errors.New("player already exists")
+		return errs.AlreadyExists(errs.Player, p.PlayerID)
 	}
-	r.players[p.PlayerID] = p
+	p.Version = 1
+	r.players[p.PlayerID] = p.Clone()
 	return nil
 }
 
 func (r *InMemoryPlayerRepository) UpdatePlayer(playerID string, p *player.Player) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
-	if _, exists := r.players[playerID]; !exists {
-		return errors.New("player not found")
+	stored, exists := r.players[playerID]
+	if !exists {
+		return errs.NotFound(errs.Player, playerID)
+	}
+	if p.PlayerID != playerID {
+		return errs.Newf(errs.ErrConflict, errs.Player, playerID, "cannot change its ID")
 	}
-	r.players[playerID] = p
+	if p.Version != stored.Version {
+		return errs.VersionConflict(errs.Player, playerID, stored.Version, p.Version)
+	}
+	p.Version++
+	r.players[playerID] = p.Clone()
 	return nil
 }
 
@@ -42,7 +55,7 @@ func (r *InMemoryPlayerRepository) DeletePlayer(playerID string) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 	if _, exists := r.players[playerID]; !exists {
-		return errors.New("player not found")
+		return errs.NotFound(errs.Player, playerID)
 	}
 	delete(r.players, playerID)
 	return nil
@@ -52,9 +65,9 @@ func (r *InMemoryPlayerRepository) GetPlayerByID(playerID string) (*player.Playe
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 	if player, exists := r.players[playerID]; exists {
-		return player, nil
+		return player.Clone(), nil
 	}
-	return nil, errors.New("player not found")
+	return nil, errs.NotFound(errs.Player, playerID)
 }
 
 // ListPlayers retrieves all players stored in the repository.
@@ -63,7 +76,22 @@ func (r *InMemoryPlayerRepository) ListPlayers() ([]*player.Player, error) {
 	defer r.mutex.RUnlock()
 	allPlayers := make([]*player.Player, 0, len(r.players))
 	for _, p := range r.players {
-		allPlayers = append(allPlayers, p)
+		allPlayers = append(allPlayers, p.Clone())
 	}
 	return allPlayers, nil
 }
+
+// Checkpoint returns a function restoring the players stored now, see uow.Checkpointer.
+func (r *InMemoryPlayerRepository) Checkpoint() func() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	players := make(map[string]*player.Player, len(r.players))
+	for id, p := range r.players {
+		players[id] = p
+	}
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.players = players
+	}
+}
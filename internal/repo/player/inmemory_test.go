@@ -0,0 +1,14 @@
+package player_test
+
+import (
+	"testing"
+
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+)
+
+func TestPlayerRepository(t *testing.T) {
+	repotest.PlayerRepositoryContract(t, func(t *testing.T) repoplayer.PlayerRepository {
+		return repoplayer.NewInMemoryPlayerRepository()
+	})
+}
@@ -5,6 +5,9 @@ import (
 )
 
 // PlayerRepository defines the interface for player data operations.
+// It stores copies of the players, so that changing a player takes effect through UpdatePlayer only.
+// CreatePlayer sets the version of the player to 1, and UpdatePlayer increments it. UpdatePlayer fails
+// with errs.ErrConflict unless the version of the player is the stored one.
 type PlayerRepository interface {
 	CreatePlayer(p *player.Player) error
 	UpdatePlayer(playerID string, p *player.Player) error
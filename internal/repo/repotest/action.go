@@ -0,0 +1,209 @@
+package repotest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+)
+
+// The action instances of the contract belong to these games and characters. The factory of a backend
+// referencing games and characters has to store them before returning the repository.
+var (
+	InstanceGameIDs      = []string{"g1", "g2"}
+	InstanceCharacterIDs = []string{"c1", "c2"}
+)
+
+func newAction(id string) *action.Action {
+	return &action.Action{ActionID: id, Name: "Action " + id, BaseXPCost: 10, RewardXP: 25, PenaltyXP: 5}
+}
+
+// newInstance returns an action instance of a game and a character of the contract.
+func newInstance(id, gameID, characterID string) *action.ActionInstance {
+	return &action.ActionInstance{
+		InstanceID:   id,
+		Action:       *newAction("a1"),
+		GameID:       gameID,
+		CharacterID:  characterID,
+		CustomXPCost: 12,
+		RewardXP:     25,
+		PenaltyXP:    5,
+		State:        action.Proposed,
+	}
+}
+
+// ActionRepositoryContract runs the contract of repoaction.ActionRepository against the repositories
+// returned by newRepo, which must hold no action nor action instance.
+func ActionRepositoryContract(t *testing.T, newRepo func(t *testing.T) repoaction.ActionRepository) {
+	g1, g2 := InstanceGameIDs[0], InstanceGameIDs[1]
+	c1, c2 := InstanceCharacterIDs[0], InstanceCharacterIDs[1]
+
+	t.Run("Actions", func(t *testing.T) {
+		runCRUD(t, func(t *testing.T) crud[action.Action] {
+			r := newRepo(t)
+			return crud[action.Action]{
+				kind:      errs.Action,
+				newEntity: newAction,
+				id:        func(a *action.Action) string { return a.ActionID },
+				change:    func(a *action.Action) { a.Name, a.BaseXPCost = "Renamed", 15 },
+				create:    r.CreateAction,
+				update:    r.UpdateAction,
+				remove:    r.DeleteAction,
+				get:       r.GetActionByID,
+				list:      r.ListActions,
+			}
+		})
+	})
+
+	t.Run("InstanceCreateAndGet", func(t *testing.T) {
+		r := newRepo(t)
+		created := newInstance("i1", g1, c1)
+		if err := r.CreateActionInstance(created); err != nil {
+			t.Fatalf("CreateActionInstance() error = %v, wantErr nil", err)
+		}
+		if created.Version != 1 {
+			t.Errorf("CreateActionInstance() should set the version to 1, got %d", created.Version)
+		}
+		created.State = action.Approved
+		got, err := r.GetActionInstanceByID("i1")
+		if err != nil {
+			t.Fatalf("GetActionInstanceByID() error = %v, wantErr nil", err)
+		}
+		want := newInstance("i1", g1, c1)
+		want.Version = 1
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetActionInstanceByID() got = %+v, want %+v", got, want)
+		}
+		got.State = action.Approved
+		if got, _ := r.GetActionInstanceByID("i1"); !reflect.DeepEqual(got, want) {
+			t.Errorf("changing an action instance should not change the stored one, got = %+v", got)
+		}
+		got, err = r.GetActionInstanceByID("unknown")
+		if got != nil {
+			t.Errorf("GetActionInstanceByID() of an unknown instance got = %+v, want nil", got)
+		}
+		checkEntityError(t, "GetActionInstanceByID", err, errs.ErrNotFound, errs.ActionInstance, "unknown")
+	})
+
+	t.Run("InstanceCreateErrors", func(t *testing.T) {
+		r := newRepo(t)
+		r.CreateActionInstance(newInstance("i1", g1, c1))
+		checkEntityError(t, "CreateActionInstance", r.CreateActionInstance(newInstance("i1", g2, c2)), errs.ErrAlreadyExists, errs.ActionInstance, "i1")
+		if got, _ := r.GetActionInstanceByID("i1"); got == nil || got.GameID != g1 {
+			t.Errorf("CreateActionInstance() of an existing instance should keep the stored one, got = %+v", got)
+		}
+		if instances, _ := r.ListActionInstancesByGame(g2); len(instances) != 0 {
+			t.Errorf("CreateActionInstance() of an existing instance should not index it, got = %+v", instances)
+		}
+		if err := r.CreateActionInstance(newInstance("", g1, c1)); err == nil {
+			t.Errorf("CreateActionInstance() without ID expected error, got nil")
+		}
+	})
+
+	t.Run("InstanceUpdate", func(t *testing.T) {
+		r := newRepo(t)
+		r.CreateActionInstance(newInstance("i1", g1, c1))
+		updated := newInstance("i1", g1, c1)
+		updated.State, updated.Outcome, updated.Version = action.Resolved, action.Success, 1
+		if err := r.UpdateActionInstance(updated); err != nil {
+			t.Fatalf("UpdateActionInstance() error = %v, wantErr nil", err)
+		}
+		if updated.Version != 2 {
+			t.Errorf("UpdateActionInstance() should increment the version, got %d", updated.Version)
+		}
+		if got, _ := r.GetActionInstanceByID("i1"); !reflect.DeepEqual(got, updated) {
+			t.Errorf("GetActionInstanceByID() after UpdateActionInstance() got = %+v, want %+v", got, updated)
+		}
+		outdated := newInstance("i1", g1, c1)
+		outdated.State, outdated.Version = action.Rejected, 1
+		checkEntityError(t, "UpdateActionInstance", r.UpdateActionInstance(outdated), errs.ErrConflict, errs.ActionInstance, "i1")
+		if got, _ := r.GetActionInstanceByID("i1"); !reflect.DeepEqual(got, updated) {
+			t.Errorf("UpdateActionInstance() of an outdated version should keep the stored one, got = %+v", got)
+		}
+		checkEntityError(t, "UpdateActionInstance", r.UpdateActionInstance(newInstance("unknown", g1, c1)), errs.ErrNotFound, errs.ActionInstance, "unknown")
+		checkEntityError(t, "UpdateActionInstance", r.UpdateActionInstance(newInstance("i1", g2, c1)), errs.ErrConflict, errs.ActionInstance, "i1")
+		checkEntityError(t, "UpdateActionInstance", r.UpdateActionInstance(newInstance("i1", g1, c2)), errs.ErrConflict, errs.ActionInstance, "i1")
+		if instances, _ := r.ListActionInstancesByGame(g1); len(instances) != 1 {
+			t.Errorf("UpdateActionInstance() changing the game should keep the instance in its game, got = %+v", instances)
+		}
+	})
+
+	t.Run("InstanceLists", func(t *testing.T) {
+		r := newRepo(t)
+		if instances, err := r.ListActionInstances(); err != nil || len(instances) != 0 {
+			t.Errorf("ListActionInstances() of an empty repository got = %+v, %v", instances, err)
+		}
+		for _, ai := range []*action.ActionInstance{
+			newInstance("i3", g1, c1),
+			newInstance("i1", g2, c1),
+			newInstance("i5", g1, c2),
+			newInstance("i2", g1, c1),
+			newInstance("i4", g2, c2),
+		} {
+			if err := r.CreateActionInstance(ai); err != nil {
+				t.Fatalf("CreateActionInstance() error = %v, wantErr nil", err)
+			}
+		}
+		all, err := r.ListActionInstances()
+		if err != nil {
+			t.Fatalf("ListActionInstances() error = %v, wantErr nil", err)
+		}
+		checkIDs(t, "ListActionInstances", instanceIDs(all), []string{"i1", "i2", "i3", "i4", "i5"})
+		byCharacter, _ := r.ListActionInstancesByCharacter(c1)
+		checkOrder(t, "ListActionInstancesByCharacter", instanceIDs(byCharacter), []string{"i3", "i1", "i2"})
+		byGame, _ := r.ListActionInstancesByGame(g1)
+		checkOrder(t, "ListActionInstancesByGame", instanceIDs(byGame), []string{"i3", "i5", "i2"})
+		if instances, err := r.ListActionInstancesByGame("unknown"); err != nil || len(instances) != 0 {
+			t.Errorf("ListActionInstancesByGame() of an unknown game got = %+v, %v", instances, err)
+		}
+	})
+
+	t.Run("InstanceConcurrent", func(t *testing.T) {
+		r := newRepo(t)
+		concurrently(t, func(worker, op int) error {
+			id := entityID("i", worker, op)
+			if err := r.CreateActionInstance(newInstance(id, InstanceGameIDs[worker%2], InstanceCharacterIDs[op%2])); err != nil {
+				return err
+			}
+			ai, err := r.GetActionInstanceByID(id)
+			if err != nil {
+				return err
+			}
+			updated := *ai
+			updated.State = action.Approved
+			if err := r.UpdateActionInstance(&updated); err != nil {
+				return err
+			}
+			if _, err := r.ListActionInstancesByCharacter(updated.CharacterID); err != nil {
+				return err
+			}
+			_, err = r.ListActionInstancesByGame(updated.GameID)
+			return err
+		})
+		all, _ := r.ListActionInstances()
+		checkIDs(t, "ListActionInstances", instanceIDs(all), allEntityIDs("i"))
+		for _, ai := range all {
+			if ai.State != action.Approved {
+				t.Errorf("UpdateActionInstance() of instance %s was lost", ai.InstanceID)
+			}
+		}
+		count := 0
+		for _, gameID := range InstanceGameIDs {
+			instances, _ := r.ListActionInstancesByGame(gameID)
+			count += len(instances)
+		}
+		if count != len(all) {
+			t.Errorf("ListActionInstancesByGame() should list every instance once, got %d of %d", count, len(all))
+		}
+	})
+}
+
+func instanceIDs(instances []*action.ActionInstance) []string {
+	ids := make([]string, 0, len(instances))
+	for _, ai := range instances {
+		ids = append(ids, ai.InstanceID)
+	}
+	return ids
+}
@@ -0,0 +1,44 @@
+package repotest
+
+import (
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+)
+
+// newCharacter returns a character with every scalar field set.
+func newCharacter(id string) *character.Character {
+	return &character.Character{
+		CharacterID: id,
+		Name:        "Character " + id,
+		Class:       character.Ranger,
+		Race:        character.Elf,
+		Description: "Keeps the contract",
+		Attributes:  character.Attributes{Strength: 10, Dexterity: 14, Constitution: 12, Intelligence: 8, Wisdom: 13, Charisma: 11},
+		XP:          120,
+		Status:      character.Active,
+	}
+}
+
+// CharacterRepositoryContract runs the contract of repocharacter.CharacterRepository against the
+// repositories returned by newRepo, which must be empty.
+func CharacterRepositoryContract(t *testing.T, newRepo func(t *testing.T) repocharacter.CharacterRepository) {
+	runCRUD(t, func(t *testing.T) crud[character.Character] {
+		r := newRepo(t)
+		return crud[character.Character]{
+			kind:      errs.Character,
+			newEntity: newCharacter,
+			id:        func(c *character.Character) string { return c.CharacterID },
+			change:    func(c *character.Character) { c.Name, c.XP = "Renamed", 75 },
+			create:    r.CreateCharacter,
+			update:    r.UpdateCharacter,
+			remove:    r.DeleteCharacter,
+			get:       r.GetCharacterByID,
+			list:      r.ListCharacters,
+			version:   func(c *character.Character) *int { return &c.Version },
+			touch:     func(c *character.Character) { c.XP++ },
+		}
+	})
+}
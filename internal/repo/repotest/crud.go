@@ -0,0 +1,244 @@
+package repotest
+
+import (
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// crud adapts the methods of a repository managing one kind of entity to the CRUD contract,
+// which all the repositories share.
+type crud[T any] struct {
+	kind      string                // the kind of the entity, as named by its errors
+	newEntity func(id string) *T    // an entity with every field set, equal for equal IDs
+	id        func(e *T) string     // the ID of an entity
+	change    func(e *T)            // changes a field of an entity but its ID
+	create    func(e *T) error      // the create method
+	update    func(e *T) error      // the update method
+	remove    func(id string) error // the delete method
+	get       func(id string) (*T, error)
+	list      func() ([]*T, error)
+
+	// Versioned entities only, nil for the others.
+	version func(e *T) *int // the version of an entity
+	touch   func(e *T)      // changes a field of an entity but its ID to a new value at every call
+}
+
+// runCRUD runs the CRUD contract on the repositories adapted by newCRUD, which must be empty.
+func runCRUD[T any](t *testing.T, newCRUD func(t *testing.T) crud[T]) {
+	t.Run("CreateAndGet", func(t *testing.T) {
+		c := newCRUD(t)
+		created := c.newEntity("e1")
+		if err := c.create(created); err != nil {
+			t.Fatalf("create %s error = %v, wantErr nil", c.kind, err)
+		}
+		if c.version != nil && *c.version(created) != 1 {
+			t.Errorf("create %s should set the version to 1, got %d", c.kind, *c.version(created))
+		}
+		got, err := c.get("e1")
+		if err != nil {
+			t.Fatalf("get %s error = %v, wantErr nil", c.kind, err)
+		}
+		if want := c.stored("e1", 1); !reflect.DeepEqual(got, want) {
+			t.Errorf("get %s got = %+v, want %+v", c.kind, got, want)
+		}
+	})
+
+	t.Run("CreateErrors", func(t *testing.T) {
+		c := newCRUD(t)
+		c.create(c.newEntity("e1"))
+		duplicate := c.newEntity("e1")
+		c.change(duplicate)
+		checkEntityError(t, "create "+c.kind, c.create(duplicate), errs.ErrAlreadyExists, c.kind, "e1")
+		if got, _ := c.get("e1"); !reflect.DeepEqual(got, c.stored("e1", 1)) {
+			t.Errorf("create of an existing %s should keep the stored one, got = %+v", c.kind, got)
+		}
+		if err := c.create(c.newEntity("")); err == nil {
+			t.Errorf("create %s without ID expected error, got nil", c.kind)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		c := newCRUD(t)
+		c.create(c.newEntity("e1"))
+		updated := c.stored("e1", 1)
+		c.change(updated)
+		if err := c.update(updated); err != nil {
+			t.Fatalf("update %s error = %v, wantErr nil", c.kind, err)
+		}
+		want := c.stored("e1", 2)
+		c.change(want)
+		if !reflect.DeepEqual(updated, want) {
+			t.Errorf("update %s should increment the version of the entity, got = %+v", c.kind, updated)
+		}
+		if got, _ := c.get("e1"); !reflect.DeepEqual(got, want) {
+			t.Errorf("get %s after update got = %+v, want %+v", c.kind, got, want)
+		}
+
+		checkEntityError(t, "update "+c.kind, c.update(c.newEntity("unknown")), errs.ErrNotFound, c.kind, "unknown")
+		if _, err := c.get("unknown"); err == nil {
+			t.Errorf("update of an unknown %s should not create it", c.kind)
+		}
+	})
+
+	if c := newCRUD(t); c.version != nil {
+		t.Run("UpdateOutdated", func(t *testing.T) {
+			c := newCRUD(t)
+			c.create(c.newEntity("e1"))
+			first, _ := c.get("e1")
+			second, _ := c.get("e1")
+			c.touch(first)
+			if err := c.update(first); err != nil {
+				t.Fatalf("update %s error = %v, wantErr nil", c.kind, err)
+			}
+			c.change(second)
+			checkEntityError(t, "update "+c.kind, c.update(second), errs.ErrConflict, c.kind, "e1")
+			if got, _ := c.get("e1"); !reflect.DeepEqual(got, first) {
+				t.Errorf("update of an outdated %s should keep the stored one, got = %+v, want %+v", c.kind, got, first)
+			}
+		})
+
+		t.Run("ConcurrentUpdates", func(t *testing.T) {
+			c := newCRUD(t)
+			c.create(c.newEntity("e1"))
+			var conflicts atomic.Int64
+			concurrently(t, func(worker, op int) error {
+				for {
+					e, err := c.get("e1")
+					if err != nil {
+						return err
+					}
+					c.touch(e)
+					err = c.update(e)
+					if !errors.Is(err, errs.ErrConflict) {
+						return err
+					}
+					conflicts.Add(1)
+				}
+			})
+			got, _ := c.get("e1")
+			if want := 1 + Workers*OperationsPerWorker; *c.version(got) != want {
+				t.Errorf("concurrent updates of a %s got version %d, want %d", c.kind, *c.version(got), want)
+			}
+			t.Logf("%d updates retried after a conflict", conflicts.Load())
+		})
+	}
+
+	t.Run("Copies", func(t *testing.T) {
+		c := newCRUD(t)
+		created := c.newEntity("e1")
+		c.create(created)
+		c.change(created)
+		got, _ := c.get("e1")
+		if want := c.stored("e1", 1); !reflect.DeepEqual(got, want) {
+			t.Errorf("changing a %s after create should not change the stored one, got = %+v", c.kind, got)
+		}
+		c.change(got)
+		all, _ := c.list()
+		for _, e := range all {
+			c.change(e)
+		}
+		if got, _ := c.get("e1"); !reflect.DeepEqual(got, c.stored("e1", 1)) {
+			t.Errorf("changing a retrieved %s should not change the stored one, got = %+v", c.kind, got)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		c := newCRUD(t)
+		c.create(c.newEntity("e1"))
+		c.create(c.newEntity("e2"))
+		if err := c.remove("e1"); err != nil {
+			t.Fatalf("delete %s error = %v, wantErr nil", c.kind, err)
+		}
+		if got, err := c.get("e1"); err == nil || got != nil {
+			t.Errorf("get of a deleted %s got = %+v, %v, want an error", c.kind, got, err)
+		}
+		checkEntityError(t, "delete "+c.kind, c.remove("e1"), errs.ErrNotFound, c.kind, "e1")
+		checkEntityError(t, "delete "+c.kind, c.remove("unknown"), errs.ErrNotFound, c.kind, "unknown")
+		all, _ := c.list()
+		checkIDs(t, "list "+c.kind, c.ids(all), []string{"e2"})
+		if err := c.create(c.newEntity("e1")); err != nil {
+			t.Errorf("create of a deleted %s error = %v, wantErr nil", c.kind, err)
+		}
+	})
+
+	t.Run("GetUnknown", func(t *testing.T) {
+		c := newCRUD(t)
+		got, err := c.get("unknown")
+		if got != nil {
+			t.Errorf("get of an unknown %s got = %+v, want nil", c.kind, got)
+		}
+		checkEntityError(t, "get "+c.kind, err, errs.ErrNotFound, c.kind, "unknown")
+	})
+
+	t.Run("List", func(t *testing.T) {
+		c := newCRUD(t)
+		if all, err := c.list(); err != nil || len(all) != 0 {
+			t.Errorf("list %s of an empty repository got = %+v, %v", c.kind, all, err)
+		}
+		want := []string{"e3", "e1", "e5", "e2", "e4"}
+		for _, id := range want {
+			c.create(c.newEntity(id))
+		}
+		all, err := c.list()
+		if err != nil {
+			t.Fatalf("list %s error = %v, wantErr nil", c.kind, err)
+		}
+		checkIDs(t, "list "+c.kind, c.ids(all), want)
+		for _, e := range all {
+			if want := c.stored(c.id(e), 1); !reflect.DeepEqual(e, want) {
+				t.Errorf("list %s got = %+v, want %+v", c.kind, e, want)
+			}
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		c := newCRUD(t)
+		concurrently(t, func(worker, op int) error {
+			id := entityID("e", worker, op)
+			if err := c.create(c.newEntity(id)); err != nil {
+				return err
+			}
+			e, err := c.get(id)
+			if err != nil {
+				return err
+			}
+			updated := *e
+			c.change(&updated)
+			if err := c.update(&updated); err != nil {
+				return err
+			}
+			_, err = c.list()
+			return err
+		})
+		all, _ := c.list()
+		checkIDs(t, "list "+c.kind, c.ids(all), allEntityIDs("e"))
+		for _, e := range all {
+			want := c.stored(c.id(e), 2)
+			c.change(want)
+			if !reflect.DeepEqual(e, want) {
+				t.Errorf("update of %s %s was lost, got = %+v", c.kind, c.id(e), e)
+			}
+		}
+	})
+}
+
+// stored returns the entity with the given ID as stored once created, at the given version if it is versioned.
+func (c crud[T]) stored(id string, version int) *T {
+	e := c.newEntity(id)
+	if c.version != nil {
+		*c.version(e) = version
+	}
+	return e
+}
+
+func (c crud[T]) ids(entities []*T) []string {
+	ids := make([]string, 0, len(entities))
+	for _, e := range entities {
+		ids = append(ids, c.id(e))
+	}
+	return ids
+}
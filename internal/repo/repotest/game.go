@@ -0,0 +1,79 @@
+package repotest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+)
+
+// newGame returns a game with every scalar field set. It has no game master and no players, so that
+// backends referencing players can store it, see the GameMaster subtest for a game with a game master.
+func newGame(id string) *game.Game {
+	return &game.Game{
+		GameID:              id,
+		Name:                "Game " + id,
+		StartTime:           contractTime,
+		EndTime:             contractTime.Add(4 * time.Hour),
+		Status:              game.Lobby,
+		AllowLateCharacters: true,
+		Payout:              game.DefaultPayoutRules(),
+	}
+}
+
+// GameRepositoryContract runs the contract of repogame.GameRepository against the repositories returned
+// by newRepo, which must be empty.
+func GameRepositoryContract(t *testing.T, newRepo func(t *testing.T) repogame.GameRepository) {
+	runCRUD(t, func(t *testing.T) crud[game.Game] {
+		r := newRepo(t)
+		return crud[game.Game]{
+			kind:      errs.Game,
+			newEntity: newGame,
+			id:        func(g *game.Game) string { return g.GameID },
+			change:    func(g *game.Game) { g.Name, g.Status = "Renamed", game.Active },
+			create:    r.CreateGame,
+			update:    func(g *game.Game) error { return r.UpdateGame(g.GameID, g) },
+			remove:    r.DeleteGame,
+			get:       r.GetGameByID,
+			list:      r.ListGames,
+			version:   func(g *game.Game) *int { return &g.Version },
+			touch:     func(g *game.Game) { g.Payout.XPPerCompletedMission++ },
+		}
+	})
+
+	t.Run("GameMaster", func(t *testing.T) {
+		r := newRepo(t)
+		g := newGame("g1")
+		g.GameMaster = gamemaster.GameMaster{ID: "gm1", Name: "Anakin", Status: gamemaster.Active}
+		if err := r.CreateGame(g); err != nil {
+			t.Fatalf("CreateGame() with a game master error = %v, wantErr nil", err)
+		}
+		if stored, err := r.GetGameByID("g1"); err != nil || stored.GameMaster != g.GameMaster {
+			t.Errorf("GetGameByID() game master got = %+v, %v, want %+v", stored.GameMaster, err, g.GameMaster)
+		}
+		g.GameMaster = gamemaster.GameMaster{ID: "gm2", Name: "Obi-Wan", Status: gamemaster.Active}
+		if err := r.UpdateGame("g1", g); err != nil {
+			t.Fatalf("UpdateGame() changing the game master error = %v, wantErr nil", err)
+		}
+		if stored, err := r.GetGameByID("g1"); err != nil || stored.GameMaster != g.GameMaster {
+			t.Errorf("GetGameByID() game master got = %+v, %v, want %+v", stored.GameMaster, err, g.GameMaster)
+		}
+	})
+
+	t.Run("UpdateChangingID", func(t *testing.T) {
+		r := newRepo(t)
+		r.CreateGame(newGame("g1"))
+		r.CreateGame(newGame("g2"))
+		checkEntityError(t, "UpdateGame", r.UpdateGame("g1", newGame("g2")), errs.ErrConflict, errs.Game, "g1")
+		checkEntityError(t, "UpdateGame", r.UpdateGame("g1", newGame("g3")), errs.ErrConflict, errs.Game, "g1")
+		if _, err := r.GetGameByID("g3"); err == nil {
+			t.Errorf("UpdateGame() changing the ID should not create a game")
+		}
+		if g, err := r.GetGameByID("g1"); err != nil || g.GameID != "g1" {
+			t.Errorf("UpdateGame() changing the ID should keep the stored game, got = %+v, %v", g, err)
+		}
+	})
+}
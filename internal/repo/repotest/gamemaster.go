@@ -0,0 +1,32 @@
+package repotest
+
+import (
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+)
+
+func newGameMaster(id string) *gamemaster.GameMaster {
+	return &gamemaster.GameMaster{ID: id, Name: "Game master " + id, Status: gamemaster.Active}
+}
+
+// GameMasterRepositoryContract runs the contract of repogamemaster.GameMasterRepository against the
+// repositories returned by newRepo, which must be empty.
+func GameMasterRepositoryContract(t *testing.T, newRepo func(t *testing.T) repogamemaster.GameMasterRepository) {
+	runCRUD(t, func(t *testing.T) crud[gamemaster.GameMaster] {
+		r := newRepo(t)
+		return crud[gamemaster.GameMaster]{
+			kind:      errs.GameMaster,
+			newEntity: newGameMaster,
+			id:        func(gm *gamemaster.GameMaster) string { return gm.ID },
+			change:    func(gm *gamemaster.GameMaster) { gm.Name, gm.Status = "Renamed", gamemaster.Inactive },
+			create:    r.CreateGameMaster,
+			update:    r.UpdateGameMaster,
+			remove:    r.DeleteGameMaster,
+			get:       r.GetGameMaster,
+			list:      r.ListGameMasters,
+		}
+	})
+}
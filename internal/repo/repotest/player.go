@@ -0,0 +1,47 @@
+package repotest
+
+import (
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+)
+
+// newPlayer returns a player without characters, so that backends referencing them can store it.
+func newPlayer(id string) *player.Player {
+	return &player.Player{PlayerID: id, Name: "Player " + id, Status: player.Active}
+}
+
+// PlayerRepositoryContract runs the contract of repoplayer.PlayerRepository against the repositories
+// returned by newRepo, which must be empty.
+func PlayerRepositoryContract(t *testing.T, newRepo func(t *testing.T) repoplayer.PlayerRepository) {
+	runCRUD(t, func(t *testing.T) crud[player.Player] {
+		r := newRepo(t)
+		return crud[player.Player]{
+			kind:      errs.Player,
+			newEntity: newPlayer,
+			id:        func(p *player.Player) string { return p.PlayerID },
+			change:    func(p *player.Player) { p.Name, p.Status = "Renamed", player.Inactive },
+			create:    r.CreatePlayer,
+			update:    func(p *player.Player) error { return r.UpdatePlayer(p.PlayerID, p) },
+			remove:    r.DeletePlayer,
+			get:       r.GetPlayerByID,
+			list:      r.ListPlayers,
+			version:   func(p *player.Player) *int { return &p.Version },
+			touch:     func(p *player.Player) { p.Name += "+" },
+		}
+	})
+
+	t.Run("UpdateChangingID", func(t *testing.T) {
+		r := newRepo(t)
+		r.CreatePlayer(newPlayer("p1"))
+		checkEntityError(t, "UpdatePlayer", r.UpdatePlayer("p1", newPlayer("p2")), errs.ErrConflict, errs.Player, "p1")
+		if _, err := r.GetPlayerByID("p2"); err == nil {
+			t.Errorf("UpdatePlayer() changing the ID should not create a player")
+		}
+		if p, err := r.GetPlayerByID("p1"); err != nil || p.PlayerID != "p1" {
+			t.Errorf("UpdatePlayer() changing the ID should keep the stored player, got = %+v, %v", p, err)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+// Package repotest provides the contract test suites of the repository interfaces, so that every backend
+// is verified the same way. A backend runs a suite from its own tests with a factory returning an empty
+// repository for each subtest:
+//
+//	func TestGameRepository(t *testing.T) {
+//		repotest.GameRepositoryContract(t, func(t *testing.T) repogame.GameRepository {
+//			return NewInMemoryGameRepository()
+//		})
+//	}
+//
+// The suites check the CRUD semantics, the errors on unknown entities, duplicates and missing IDs, that
+// lists are complete, and concurrent access, which is meaningful under the race detector.
+// They do not rely on the wording of the errors, only on the errs sentinels they match and the entity
+// they name, nor on the order of the lists, but where the interface documents one.
+package repotest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+)
+
+// Workers and OperationsPerWorker size the concurrent access checks.
+const (
+	Workers             = 8
+	OperationsPerWorker = 10
+)
+
+// contractTime is the time set on the entities of the suites. It has no monotonic reading, so that it
+// survives a round trip through any encoding unchanged.
+var contractTime = time.Date(2024, 5, 1, 18, 30, 0, 0, time.UTC)
+
+// concurrently runs fn for every operation of every worker, each worker in its own goroutine, and
+// reports the errors.
+func concurrently(t *testing.T, fn func(worker, op int) error) {
+	t.Helper()
+	var wg sync.WaitGroup
+	errs := make(chan error, Workers*OperationsPerWorker)
+	for w := 0; w < Workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for op := 0; op < OperationsPerWorker; op++ {
+				if err := fn(worker, op); err != nil {
+					errs <- fmt.Errorf("worker %d, operation %d: %w", worker, op, err)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// checkIDs reports the IDs missing from a list or listed more than once, and those not expected.
+func checkIDs(t *testing.T, method string, got []string, want []string) {
+	t.Helper()
+	seen := make(map[string]int, len(got))
+	for _, id := range got {
+		seen[id]++
+	}
+	for _, id := range want {
+		if seen[id] != 1 {
+			t.Errorf("%s() should list %s once, got it %d times", method, id, seen[id])
+		}
+		delete(seen, id)
+	}
+	for id := range seen {
+		t.Errorf("%s() listed the unexpected %s", method, id)
+	}
+}
+
+// checkOrder reports a list whose IDs are not exactly the expected ones in the expected order.
+func checkOrder(t *testing.T, method string, got []string, want []string) {
+	t.Helper()
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("%s() got = %v, want %v", method, got, want)
+	}
+}
+
+// checkEntityError reports an error that does not match the sentinel want or does not name the entity
+// of the given kind and ID.
+func checkEntityError(t *testing.T, method string, err, want error, kind, id string) {
+	t.Helper()
+	var entityErr *errs.EntityError
+	if !errors.Is(err, want) || !errors.As(err, &entityErr) || entityErr.Kind != kind || entityErr.ID != id {
+		t.Errorf("%s() error = %v, want %s %s %v", method, err, kind, id, want)
+	}
+}
+
+// entityID names the entity created by an operation of a worker in the concurrent access checks.
+func entityID(prefix string, worker, op int) string {
+	return fmt.Sprintf("%s-%d-%d", prefix, worker, op)
+}
+
+// allEntityIDs lists the IDs of every entity created by the concurrent access checks.
+func allEntityIDs(prefix string) []string {
+	ids := make([]string, 0, Workers*OperationsPerWorker)
+	for w := 0; w < Workers; w++ {
+		for op := 0; op < OperationsPerWorker; op++ {
+			ids = append(ids, entityID(prefix, w, op))
+		}
+	}
+	return ids
+}
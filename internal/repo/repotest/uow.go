@@ -0,0 +1,243 @@
+package repotest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+)
+
+// errRollback is the error of the units of work the contract rolls back.
+var errRollback = errors.New("rollback")
+
+// UnitOfWorkContract runs the contract of uow.UnitOfWork against the units of work returned by newUnits,
+// along with the repositories they write through, which must be empty.
+func UnitOfWorkContract(t *testing.T, newUnits func(t *testing.T) (uow.UnitOfWork, uow.Repositories)) {
+	// setup stores the game g1 and the characters c1 and c2 the units of work change.
+	setup := func(t *testing.T) (uow.UnitOfWork, uow.Repositories) {
+		units, r := newUnits(t)
+		for _, err := range []error{
+			r.Games.CreateGame(newGame("g1")),
+			r.Characters.CreateCharacter(newCharacter("c1")),
+			r.Characters.CreateCharacter(newCharacter("c2")),
+		} {
+			if err != nil {
+				t.Fatalf("setup error = %v, wantErr nil", err)
+			}
+		}
+		return units, r
+	}
+
+	t.Run("Commit", func(t *testing.T) {
+		units, r := setup(t)
+		err := units.Do(func(r uow.Repositories) error {
+			char, err := r.Characters.GetCharacterByID("c1")
+			if err != nil {
+				return err
+			}
+			char.XP -= 12
+			if err := r.Characters.UpdateCharacter(char); err != nil {
+				return err
+			}
+			if err := r.Actions.CreateActionInstance(newInstance("i1", "g1", "c1")); err != nil {
+				return err
+			}
+			if err := r.XP.AppendTransaction(newTransaction("c1", "g1", -12)); err != nil {
+				return err
+			}
+			if err := r.Characters.DeleteCharacter("c2"); err != nil {
+				return err
+			}
+			return r.Players.CreatePlayer(newPlayer("p1"))
+		})
+		if err != nil {
+			t.Fatalf("Do() error = %v, wantErr nil", err)
+		}
+		if char, _ := r.Characters.GetCharacterByID("c1"); char == nil || char.XP != 108 || char.Version != 2 {
+			t.Errorf("Do() should commit the update of the character, got = %+v", char)
+		}
+		if ai, err := r.Actions.GetActionInstanceByID("i1"); err != nil || ai.Version != 1 {
+			t.Errorf("Do() should commit the creation of the action instance, got = %+v, %v", ai, err)
+		}
+		if txs, _ := r.XP.ListTransactionsByCharacter("c1"); len(txs) != 1 || txs[0].Amount != -12 {
+			t.Errorf("Do() should commit the XP transaction, got = %+v", txs)
+		}
+		if _, err := r.Characters.GetCharacterByID("c2"); !errors.Is(err, errs.ErrNotFound) {
+			t.Errorf("Do() should commit the deletion of the character, got error = %v", err)
+		}
+		if _, err := r.Players.GetPlayerByID("p1"); err != nil {
+			t.Errorf("Do() should commit the creation of the player, got error = %v", err)
+		}
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		units, r := setup(t)
+		r.GameMasters.CreateGameMaster(newGameMaster("gm1"))
+		err := units.Do(func(r uow.Repositories) error {
+			char, err := r.Characters.GetCharacterByID("c1")
+			if err != nil {
+				return err
+			}
+			char.XP -= 12
+			if err := r.Characters.UpdateCharacter(char); err != nil {
+				return err
+			}
+			if err := r.Actions.CreateActionInstance(newInstance("i1", "g1", "c1")); err != nil {
+				return err
+			}
+			if err := r.XP.AppendTransaction(newTransaction("c1", "g1", -12)); err != nil {
+				return err
+			}
+			if err := r.GameMasters.DeleteGameMaster("gm1"); err != nil {
+				return err
+			}
+			if err := r.Players.CreatePlayer(newPlayer("p1")); err != nil {
+				return err
+			}
+			// The writes of the unit are visible within it
+			if got, err := r.Characters.GetCharacterByID("c1"); err != nil || got.XP != 108 {
+				t.Errorf("GetCharacterByID() within the unit got = %+v, %v", got, err)
+			}
+			return errRollback
+		})
+		if !errors.Is(err, errRollback) {
+			t.Fatalf("Do() error = %v, want the error of fn", err)
+		}
+		checkRolledBack(t, r)
+
+		// The repositories go on from the state before the unit
+		tx := newTransaction("c1", "g1", 5)
+		if err := r.XP.AppendTransaction(tx); err != nil || tx.Sequence != 1 {
+			t.Errorf("AppendTransaction() after a rollback got sequence %d, error = %v, want 1", tx.Sequence, err)
+		}
+		char, _ := r.Characters.GetCharacterByID("c1")
+		char.XP++
+		if err := r.Characters.UpdateCharacter(char); err != nil || char.Version != 2 {
+			t.Errorf("UpdateCharacter() after a rollback got version %d, error = %v, want 2", char.Version, err)
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		units, r := setup(t)
+		r.GameMasters.CreateGameMaster(newGameMaster("gm1"))
+		panicked := func() (recovered any) {
+			defer func() { recovered = recover() }()
+			units.Do(func(r uow.Repositories) error {
+				char, _ := r.Characters.GetCharacterByID("c1")
+				char.XP -= 12
+				r.Characters.UpdateCharacter(char)
+				r.Actions.CreateActionInstance(newInstance("i1", "g1", "c1"))
+				r.XP.AppendTransaction(newTransaction("c1", "g1", -12))
+				r.GameMasters.DeleteGameMaster("gm1")
+				r.Players.CreatePlayer(newPlayer("p1"))
+				panic(errRollback)
+			})
+			return nil
+		}()
+		if panicked != errRollback {
+			t.Errorf("Do() should let the panic of fn through, recovered %v", panicked)
+		}
+		checkRolledBack(t, r)
+		if err := units.Do(func(r uow.Repositories) error { return r.Players.CreatePlayer(newPlayer("p1")) }); err != nil {
+			t.Errorf("Do() after a panic error = %v, wantErr nil", err)
+		}
+	})
+
+	t.Run("Within", func(t *testing.T) {
+		units, r := setup(t)
+		err := units.Do(func(r uow.Repositories) error {
+			if err := uow.Within(r).Do(func(r uow.Repositories) error {
+				return r.Players.CreatePlayer(newPlayer("p1"))
+			}); err != nil {
+				return err
+			}
+			return errRollback
+		})
+		if !errors.Is(err, errRollback) {
+			t.Fatalf("Do() error = %v, want the error of fn", err)
+		}
+		if _, err := r.Players.GetPlayerByID("p1"); !errors.Is(err, errs.ErrNotFound) {
+			t.Errorf("a unit rolled back should roll back the writes of the units within it, got error = %v", err)
+		}
+	})
+
+	t.Run("WriteOutsideUnit", func(t *testing.T) {
+		units, r := setup(t)
+		written := make(chan error, 1)
+		err := units.Do(func(unit uow.Repositories) error {
+			if err := unit.Players.CreatePlayer(newPlayer("p1")); err != nil {
+				return err
+			}
+			// A write outside of the unit while it runs, which the backend may hold until the unit ends
+			go func() { written <- r.Players.CreatePlayer(newPlayer("p2")) }()
+			select {
+			case err := <-written:
+				written <- err
+			case <-time.After(50 * time.Millisecond):
+			}
+			return errRollback
+		})
+		if !errors.Is(err, errRollback) {
+			t.Fatalf("Do() error = %v, want the error of fn", err)
+		}
+		if err := <-written; err != nil {
+			t.Fatalf("CreatePlayer() outside of the unit error = %v, wantErr nil", err)
+		}
+		if _, err := r.Players.GetPlayerByID("p1"); !errors.Is(err, errs.ErrNotFound) {
+			t.Errorf("a unit rolled back should not create the player, got error = %v", err)
+		}
+		if _, err := r.Players.GetPlayerByID("p2"); err != nil {
+			t.Errorf("a unit rolled back should keep the writes made outside of it meanwhile, got error = %v", err)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		units, r := setup(t)
+		concurrently(t, func(worker, op int) error {
+			return units.Do(func(r uow.Repositories) error {
+				char, err := r.Characters.GetCharacterByID("c1")
+				if err != nil {
+					return err
+				}
+				char.XP++
+				if err := r.Characters.UpdateCharacter(char); err != nil {
+					return err
+				}
+				return r.XP.AppendTransaction(newTransaction("c1", "g1", 1))
+			})
+		})
+		char, _ := r.Characters.GetCharacterByID("c1")
+		if want := newCharacter("c1").XP + Workers*OperationsPerWorker; char.XP != want {
+			t.Errorf("concurrent units of work got XP %d, want %d", char.XP, want)
+		}
+		if txs, _ := r.XP.ListTransactionsByCharacter("c1"); len(txs) != Workers*OperationsPerWorker {
+			t.Errorf("concurrent units of work recorded %d transactions, want %d", len(txs), Workers*OperationsPerWorker)
+		}
+	})
+}
+
+// checkRolledBack reports the writes of the units of work of the Rollback and Panic checks that were not
+// rolled back.
+func checkRolledBack(t *testing.T, r uow.Repositories) {
+	t.Helper()
+	want := newCharacter("c1")
+	want.Version = 1
+	if got, _ := r.Characters.GetCharacterByID("c1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("a unit rolled back should restore the character, got = %+v, want %+v", got, want)
+	}
+	if _, err := r.Actions.GetActionInstanceByID("i1"); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("a unit rolled back should not create the action instance, got error = %v", err)
+	}
+	if txs, _ := r.XP.ListTransactionsByCharacter("c1"); len(txs) != 0 {
+		t.Errorf("a unit rolled back should not record the XP transaction, got = %+v", txs)
+	}
+	if _, err := r.GameMasters.GetGameMaster("gm1"); err != nil {
+		t.Errorf("a unit rolled back should not delete the game master, got error = %v", err)
+	}
+	if _, err := r.Players.GetPlayerByID("p1"); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("a unit rolled back should not create the player, got error = %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package repotest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+func newTransaction(characterID, gameID string, amount int) *xp.Transaction {
+	tx := xp.NewTransaction(xp.GMGrant, characterID, gameID, "gm1", amount, "contract")
+	tx.Timestamp = contractTime
+	return &tx
+}
+
+// XPRepositoryContract runs the contract of repoxp.XPRepository against the repositories returned by
+// newRepo, which must hold no transaction.
+func XPRepositoryContract(t *testing.T, newRepo func(t *testing.T) repoxp.XPRepository) {
+	t.Run("AppendAndList", func(t *testing.T) {
+		r := newRepo(t)
+		for i, tx := range []*xp.Transaction{
+			newTransaction("c1", "g1", 1),
+			newTransaction("c2", "g1", 2),
+			newTransaction("c1", "g2", 3),
+		} {
+			if err := r.AppendTransaction(tx); err != nil {
+				t.Fatalf("AppendTransaction() error = %v, wantErr nil", err)
+			}
+			if tx.Sequence != i+1 {
+				t.Errorf("AppendTransaction() assigned sequence %d, want %d", tx.Sequence, i+1)
+			}
+		}
+		byCharacter, err := r.ListTransactionsByCharacter("c1")
+		if err != nil {
+			t.Fatalf("ListTransactionsByCharacter() error = %v, wantErr nil", err)
+		}
+		want := newTransaction("c1", "g1", 1)
+		want.Sequence = 1
+		if len(byCharacter) != 2 || !reflect.DeepEqual(byCharacter[0], want) || byCharacter[1].Amount != 3 {
+			t.Errorf("ListTransactionsByCharacter() got = %+v", byCharacter)
+		}
+		if byGame, _ := r.ListTransactionsByGame("g1"); len(byGame) != 2 || byGame[0].Sequence != 1 || byGame[1].Sequence != 2 {
+			t.Errorf("ListTransactionsByGame() got = %+v", byGame)
+		}
+		if txs, err := r.ListTransactionsByGame("unknown"); err != nil || len(txs) != 0 {
+			t.Errorf("ListTransactionsByGame() of an unknown game got = %+v, %v", txs, err)
+		}
+	})
+
+	t.Run("AppendErrors", func(t *testing.T) {
+		r := newRepo(t)
+		tx := newTransaction("c1", "g1", 1)
+		r.AppendTransaction(tx)
+		if err := r.AppendTransaction(tx); err == nil {
+			t.Errorf("AppendTransaction() of a recorded transaction expected error, got nil")
+		}
+		invalid := newTransaction("c1", "g1", 1)
+		invalid.Reason = ""
+		if err := r.AppendTransaction(invalid); err == nil {
+			t.Errorf("AppendTransaction() of an invalid transaction expected error, got nil")
+		}
+		if txs, _ := r.ListTransactionsByCharacter("c1"); len(txs) != 1 {
+			t.Errorf("AppendTransaction() failing should record nothing, got = %+v", txs)
+		}
+	})
+
+	t.Run("ListsReturnCopies", func(t *testing.T) {
+		r := newRepo(t)
+		r.AppendTransaction(newTransaction("c1", "g1", 1))
+		txs, _ := r.ListTransactionsByCharacter("c1")
+		txs[0].Amount = 1000
+		if txs, _ := r.ListTransactionsByCharacter("c1"); txs[0].Amount != 1 {
+			t.Errorf("ListTransactionsByCharacter() should not let callers rewrite the ledger, got = %+v", txs[0])
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		r := newRepo(t)
+		concurrently(t, func(worker, op int) error {
+			if err := r.AppendTransaction(newTransaction(entityID("c", worker, 0), "g1", op+1)); err != nil {
+				return err
+			}
+			_, err := r.ListTransactionsByGame("g1")
+			return err
+		})
+		txs, _ := r.ListTransactionsByGame("g1")
+		if len(txs) != Workers*OperationsPerWorker {
+			t.Fatalf("ListTransactionsByGame() got %d transactions, want %d", len(txs), Workers*OperationsPerWorker)
+		}
+		for i, tx := range txs {
+			if tx.Sequence != i+1 {
+				t.Errorf("ListTransactionsByGame() should list sequences 1 to %d in order, got %d at %d", len(txs), tx.Sequence, i)
+			}
+		}
+		for w := 0; w < Workers; w++ {
+			byCharacter, _ := r.ListTransactionsByCharacter(entityID("c", w, 0))
+			for op, tx := range byCharacter {
+				if tx.Amount != op+1 {
+					t.Errorf("ListTransactionsByCharacter() should keep the order of the appends of worker %d, got = %+v", w, byCharacter)
+					break
+				}
+			}
+		}
+	})
+}
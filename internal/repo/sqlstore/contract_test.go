@@ -0,0 +1,64 @@
+package sqlstore
+
+import (
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// newContractStore opens a new in-memory database, closed at the end of the test.
+func newContractStore(t *testing.T) *Store {
+	s := openTestStore(t, ":memory:")
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func TestContracts(t *testing.T) {
+	t.Run("Game", func(t *testing.T) {
+		repotest.GameRepositoryContract(t, func(t *testing.T) repogame.GameRepository { return newContractStore(t).Games() })
+	})
+	t.Run("Player", func(t *testing.T) {
+		repotest.PlayerRepositoryContract(t, func(t *testing.T) repoplayer.PlayerRepository { return newContractStore(t).Players() })
+	})
+	t.Run("Character", func(t *testing.T) {
+		repotest.CharacterRepositoryContract(t, func(t *testing.T) repocharacter.CharacterRepository { return newContractStore(t).Characters() })
+	})
+	t.Run("GameMaster", func(t *testing.T) {
+		repotest.GameMasterRepositoryContract(t, func(t *testing.T) repogamemaster.GameMasterRepository { return newContractStore(t).GameMasters() })
+	})
+	t.Run("Action", func(t *testing.T) {
+		repotest.ActionRepositoryContract(t, func(t *testing.T) repoaction.ActionRepository {
+			s := newContractStore(t)
+			// Action instances reference their game and character
+			for _, id := range repotest.InstanceGameIDs {
+				if err := s.Games().CreateGame(&game.Game{GameID: id}); err != nil {
+					t.Fatalf("CreateGame() error = %v, wantErr nil", err)
+				}
+			}
+			for _, id := range repotest.InstanceCharacterIDs {
+				if err := s.Characters().CreateCharacter(&character.Character{CharacterID: id}); err != nil {
+					t.Fatalf("CreateCharacter() error = %v, wantErr nil", err)
+				}
+			}
+			return s.Actions()
+		})
+	})
+	t.Run("XP", func(t *testing.T) {
+		repotest.XPRepositoryContract(t, func(t *testing.T) repoxp.XPRepository { return newContractStore(t).XP() })
+	})
+	t.Run("UnitOfWork", func(t *testing.T) {
+		repotest.UnitOfWorkContract(t, func(t *testing.T) (uow.UnitOfWork, uow.Repositories) {
+			s := newContractStore(t)
+			return s, s.Repositories()
+		})
+	})
+}
@@ -0,0 +1,9 @@
+DROP TABLE xp_transactions;
+DROP TABLE action_instances;
+DROP TABLE actions;
+DROP TABLE game_players;
+DROP TABLE games;
+DROP TABLE player_characters;
+DROP TABLE characters;
+DROP TABLE players;
+DROP TABLE game_masters;
@@ -0,0 +1,106 @@
+-- Entities referenced by the others come first. Lists keep the order of their slices in a position column;
+-- values without their own table are stored as JSON documents.
+
+CREATE TABLE game_masters (
+    id     TEXT PRIMARY KEY,
+    name   TEXT NOT NULL,
+    status INTEGER NOT NULL
+);
+
+CREATE TABLE players (
+    id     TEXT PRIMARY KEY,
+    name   TEXT NOT NULL,
+    status INTEGER NOT NULL
+);
+
+CREATE TABLE characters (
+    id               TEXT PRIMARY KEY,
+    name             TEXT NOT NULL,
+    class            INTEGER NOT NULL,
+    race             INTEGER NOT NULL,
+    description      TEXT NOT NULL,
+    strength         INTEGER NOT NULL,
+    dexterity        INTEGER NOT NULL,
+    constitution     INTEGER NOT NULL,
+    intelligence     INTEGER NOT NULL,
+    wisdom           INTEGER NOT NULL,
+    charisma         INTEGER NOT NULL,
+    xp               INTEGER NOT NULL,
+    status           INTEGER NOT NULL,
+    action_instances TEXT NOT NULL -- JSON
+);
+
+CREATE TABLE player_characters (
+    player_id    TEXT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    character_id TEXT NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    position     INTEGER NOT NULL,
+    PRIMARY KEY (player_id, character_id)
+);
+
+CREATE INDEX player_characters_character ON player_characters (character_id);
+
+CREATE TABLE games (
+    id                    TEXT PRIMARY KEY,
+    name                  TEXT NOT NULL,
+    start_time            TEXT NOT NULL,
+    end_time              TEXT NOT NULL,
+    status                INTEGER NOT NULL,
+    allow_late_characters INTEGER NOT NULL,
+    game_master_id        TEXT REFERENCES game_masters (id) ON DELETE SET NULL,
+    rulebook              TEXT NOT NULL, -- JSON
+    characters            TEXT NOT NULL, -- JSON
+    actions               TEXT NOT NULL, -- JSON
+    adventure             TEXT NOT NULL, -- JSON
+    payout                TEXT NOT NULL, -- JSON
+    reports               TEXT NOT NULL  -- JSON
+);
+
+CREATE INDEX games_game_master ON games (game_master_id);
+
+CREATE TABLE game_players (
+    game_id   TEXT NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    player_id TEXT NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+    position  INTEGER NOT NULL,
+    PRIMARY KEY (game_id, player_id)
+);
+
+CREATE INDEX game_players_player ON game_players (player_id);
+
+CREATE TABLE actions (
+    id           TEXT PRIMARY KEY,
+    name         TEXT NOT NULL,
+    base_xp_cost INTEGER NOT NULL,
+    reward_xp    INTEGER NOT NULL,
+    penalty_xp   INTEGER NOT NULL
+);
+
+-- An instance keeps a copy of its action template as it was proposed, so the template is not referenced.
+CREATE TABLE action_instances (
+    id             TEXT PRIMARY KEY,
+    game_id        TEXT NOT NULL REFERENCES games (id) ON DELETE CASCADE,
+    character_id   TEXT NOT NULL REFERENCES characters (id) ON DELETE CASCADE,
+    action         TEXT NOT NULL, -- JSON
+    custom_xp_cost INTEGER NOT NULL,
+    reward_xp      INTEGER NOT NULL,
+    penalty_xp     INTEGER NOT NULL,
+    state          INTEGER NOT NULL,
+    outcome        INTEGER NOT NULL
+);
+
+CREATE INDEX action_instances_game ON action_instances (game_id);
+CREATE INDEX action_instances_character ON action_instances (character_id);
+
+-- The XP ledger is history: it outlives the characters and games it mentions, so nothing is referenced.
+CREATE TABLE xp_transactions (
+    sequence     INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id TEXT NOT NULL,
+    game_id      TEXT NOT NULL,
+    actor_id     TEXT NOT NULL,
+    type         INTEGER NOT NULL,
+    amount       INTEGER NOT NULL,
+    reason       TEXT NOT NULL,
+    timestamp    TEXT NOT NULL
+);
+
+CREATE INDEX xp_transactions_game ON xp_transactions (game_id);
+CREATE INDEX xp_transactions_character ON xp_transactions (character_id);
@@ -0,0 +1,4 @@
+ALTER TABLE action_instances DROP COLUMN version;
+ALTER TABLE characters DROP COLUMN version;
+ALTER TABLE players DROP COLUMN version;
+ALTER TABLE games DROP COLUMN version;
@@ -0,0 +1,7 @@
+-- Versions of the entities updated concurrently: an update increments the version of its row and is
+-- rejected if it is based on another version. The rows stored before start at version 1.
+
+ALTER TABLE games ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE players ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE characters ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE action_instances ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,816 @@
+package sqlstore
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// GameRepository stores games in the games table and their players in game_players.
+type GameRepository struct{ store *Store }
+
+// PlayerRepository stores players in the players table and their characters in player_characters.
+type PlayerRepository struct{ store *Store }
+
+// CharacterRepository stores characters in the characters table.
+type CharacterRepository struct{ store *Store }
+
+// GameMasterRepository stores game masters in the game_masters table.
+type GameMasterRepository struct{ store *Store }
+
+// ActionRepository stores action templates in the actions table and action instances in action_instances.
+type ActionRepository struct{ store *Store }
+
+// XPRepository stores the XP ledger in the xp_transactions table.
+type XPRepository struct{ store *Store }
+
+// Ensure the repositories implement their interfaces at compile time.
+var (
+	_ repogame.GameRepository             = &GameRepository{}
+	_ repoplayer.PlayerRepository         = &PlayerRepository{}
+	_ repocharacter.CharacterRepository   = &CharacterRepository{}
+	_ repogamemaster.GameMasterRepository = &GameMasterRepository{}
+	_ repoaction.ActionRepository         = &ActionRepository{}
+	_ repoxp.XPRepository                 = &XPRepository{}
+)
+
+// Games returns the game repository of the store.
+func (s *Store) Games() *GameRepository { return &GameRepository{store: s} }
+
+// Players returns the player repository of the store.
+func (s *Store) Players() *PlayerRepository { return &PlayerRepository{store: s} }
+
+// Characters returns the character repository of the store.
+func (s *Store) Characters() *CharacterRepository { return &CharacterRepository{store: s} }
+
+// GameMasters returns the game master repository of the store.
+func (s *Store) GameMasters() *GameMasterRepository { return &GameMasterRepository{store: s} }
+
+// Actions returns the action repository of the store.
+func (s *Store) Actions() *ActionRepository { return &ActionRepository{store: s} }
+
+// XP returns the XP ledger of the store.
+func (s *Store) XP() *XPRepository { return &XPRepository{store: s} }
+
+// Repositories returns all the repositories of the store.
+func (s *Store) Repositories() uow.Repositories {
+	return uow.Repositories{
+		Games:       s.Games(),
+		Players:     s.Players(),
+		Characters:  s.Characters(),
+		GameMasters: s.GameMasters(),
+		Actions:     s.Actions(),
+		XP:          s.XP(),
+	}
+}
+
+// Rows are listed in creation order: SQLite never reuses the rowid of a row that is still there and
+// updates keep it, so it grows with every insert.
+
+const gameColumns = `id, name, start_time, end_time, status, allow_late_characters, game_master_id,
+	rulebook, characters, actions, adventure, payout, reports, version`
+
+// CreateGame stores a game. Its players have to be stored already, its game master is registered if it is not yet.
+func (r *GameRepository) CreateGame(g *game.Game) error {
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		if err := checkCreate(tx, "games", errs.Game, g.GameID); err != nil {
+			return err
+		}
+		return writeGame(tx, g, true)
+	})
+	if err == nil {
+		g.Version = 1
+	}
+	return err
+}
+
+func (r *GameRepository) UpdateGame(gameID string, g *game.Game) error {
+	if g.GameID != gameID {
+		return errs.Newf(errs.ErrConflict, errs.Game, gameID, "cannot change its ID")
+	}
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		return writeGame(tx, g, false)
+	})
+	if err == nil {
+		g.Version++
+	}
+	return err
+}
+
+func (r *GameRepository) DeleteGame(gameID string) error {
+	result, err := r.store.queries().Exec(`DELETE FROM games WHERE id = ?`, gameID)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.Game, gameID)
+}
+
+func (r *GameRepository) GetGameByID(gameID string) (*game.Game, error) {
+	var g *game.Game
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		row := gameRow{}
+		if err := row.scan(tx.QueryRow(`SELECT `+gameColumns+` FROM games WHERE id = ?`, gameID)); err != nil {
+			return notFound(err, errs.Game, gameID)
+		}
+		var err error
+		g, err = row.load(tx)
+		return err
+	})
+	return g, err
+}
+
+// ListGames retrieves all games, in creation order.
+func (r *GameRepository) ListGames() ([]*game.Game, error) {
+	var games []*game.Game
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		rows, err := queryAll[gameRow](tx, `SELECT `+gameColumns+` FROM games ORDER BY rowid`)
+		if err != nil {
+			return err
+		}
+		games = make([]*game.Game, 0, len(rows))
+		for _, row := range rows {
+			g, err := row.load(tx)
+			if err != nil {
+				return err
+			}
+			games = append(games, g)
+		}
+		return nil
+	})
+	return games, err
+}
+
+// writeGame inserts or updates the row of a game, stores its game master and replaces its players. An update is
+// based on the version of g, which the caller increments once the transaction is committed.
+func writeGame(tx *sql.Tx, g *game.Game, insert bool) error {
+	var gameMasterID sql.NullString
+	if g.GameMaster.ID != "" {
+		gameMasterID = sql.NullString{String: g.GameMaster.ID, Valid: true}
+		// A game master not registered yet is registered as the game knows it, so that the game can reference it.
+		// A registered one is kept as it is, and read back from its table with the game.
+		_, err := tx.Exec(`INSERT INTO game_masters (`+gameMasterColumns+`) VALUES (?, ?, ?) ON CONFLICT (id) DO NOTHING`,
+			g.GameMaster.ID, g.GameMaster.Name, int(g.GameMaster.Status))
+		if err != nil {
+			return fmt.Errorf("storing game master %s of game %s: %w", g.GameMaster.ID, g.GameID, err)
+		}
+	}
+	documents := make([]any, 0, 6)
+	for _, v := range []any{g.Rulebook, g.Characters, g.Actions, g.Adventure, g.Payout, g.Reports} {
+		document, err := toJSON(v)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, document)
+	}
+	args := append([]any{g.Name, formatTime(g.StartTime), formatTime(g.EndTime), int(g.Status), g.AllowLateCharacters, gameMasterID}, documents...)
+
+	if insert {
+		_, err := tx.Exec(`INSERT INTO games (`+gameColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`, append([]any{g.GameID}, args...)...)
+		if err != nil {
+			return fmt.Errorf("storing game %s: %w", g.GameID, err)
+		}
+	} else {
+		result, err := tx.Exec(`UPDATE games SET name = ?, start_time = ?, end_time = ?, status = ?, allow_late_characters = ?,
+			game_master_id = ?, rulebook = ?, characters = ?, actions = ?, adventure = ?, payout = ?, reports = ?, version = version + 1
+			WHERE id = ? AND version = ?`, append(args, g.GameID, g.Version)...)
+		if err != nil {
+			return fmt.Errorf("storing game %s: %w", g.GameID, err)
+		}
+		if err := checkVersion(tx, result, "games", errs.Game, g.GameID, g.Version); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM game_players WHERE game_id = ?`, g.GameID); err != nil {
+			return err
+		}
+	}
+	for i, p := range g.Players {
+		if _, err := tx.Exec(`INSERT INTO game_players (game_id, player_id, position) VALUES (?, ?, ?)`, g.GameID, p.PlayerID, i); err != nil {
+			return fmt.Errorf("storing player %s of game %s: %w", p.PlayerID, g.GameID, err)
+		}
+	}
+	return nil
+}
+
+// gameRow holds the columns of a game row until its relations are loaded.
+type gameRow struct {
+	game                game.Game
+	startTime, endTime  string
+	gameMasterID        sql.NullString
+	rulebook, chars     string
+	actions, adventure  string
+	payout, reports     string
+	status              int
+	allowLateCharacters bool
+}
+
+func (row *gameRow) scan(s scanner) error {
+	return s.Scan(&row.game.GameID, &row.game.Name, &row.startTime, &row.endTime, &row.status, &row.allowLateCharacters,
+		&row.gameMasterID, &row.rulebook, &row.chars, &row.actions, &row.adventure, &row.payout, &row.reports, &row.game.Version)
+}
+
+// load decodes the columns of the row and reads the game master and the players of the game.
+func (row *gameRow) load(q querier) (*game.Game, error) {
+	g := row.game
+	var err error
+	if g.StartTime, err = parseTime("start_time", row.startTime); err != nil {
+		return nil, err
+	}
+	if g.EndTime, err = parseTime("end_time", row.endTime); err != nil {
+		return nil, err
+	}
+	g.Status = game.GameStatus(row.status)
+	g.AllowLateCharacters = row.allowLateCharacters
+	for _, document := range []struct {
+		column, data string
+		target       any
+	}{
+		{"rulebook", row.rulebook, &g.Rulebook},
+		{"characters", row.chars, &g.Characters},
+		{"actions", row.actions, &g.Actions},
+		{"adventure", row.adventure, &g.Adventure},
+		{"payout", row.payout, &g.Payout},
+		{"reports", row.reports, &g.Reports},
+	} {
+		if err := fromJSON(document.column, document.data, document.target); err != nil {
+			return nil, err
+		}
+	}
+	if row.gameMasterID.Valid {
+		gm, err := getGameMaster(q, row.gameMasterID.String)
+		if err != nil {
+			return nil, err
+		}
+		g.GameMaster = *gm
+	}
+	players, err := queryAll[playerRow](q, `SELECT `+prefixed("p", playerColumns)+` FROM game_players gp JOIN players p ON p.id = gp.player_id
+		WHERE gp.game_id = ? ORDER BY gp.position`, g.GameID)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range players {
+		p, err := row.load(q)
+		if err != nil {
+			return nil, err
+		}
+		g.Players = append(g.Players, *p)
+	}
+	return &g, nil
+}
+
+const playerColumns = `id, name, status, version`
+
+// CreatePlayer stores a player. Its characters have to be stored already.
+func (r *PlayerRepository) CreatePlayer(p *player.Player) error {
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		if err := checkCreate(tx, "players", errs.Player, p.PlayerID); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO players (`+playerColumns+`) VALUES (?, ?, ?, 1)`, p.PlayerID, p.Name, int(p.Status)); err != nil {
+			return err
+		}
+		return writePlayerCharacters(tx, p)
+	})
+	if err == nil {
+		p.Version = 1
+	}
+	return err
+}
+
+func (r *PlayerRepository) UpdatePlayer(playerID string, p *player.Player) error {
+	if p.PlayerID != playerID {
+		return errs.Newf(errs.ErrConflict, errs.Player, playerID, "cannot change its ID")
+	}
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		result, err := tx.Exec(`UPDATE players SET name = ?, status = ?, version = version + 1 WHERE id = ? AND version = ?`,
+			p.Name, int(p.Status), playerID, p.Version)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(tx, result, "players", errs.Player, playerID, p.Version); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM player_characters WHERE player_id = ?`, playerID); err != nil {
+			return err
+		}
+		return writePlayerCharacters(tx, p)
+	})
+	if err == nil {
+		p.Version++
+	}
+	return err
+}
+
+func (r *PlayerRepository) DeletePlayer(playerID string) error {
+	result, err := r.store.queries().Exec(`DELETE FROM players WHERE id = ?`, playerID)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.Player, playerID)
+}
+
+func (r *PlayerRepository) GetPlayerByID(playerID string) (*player.Player, error) {
+	var p *player.Player
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		row := playerRow{}
+		if err := row.scan(tx.QueryRow(`SELECT `+playerColumns+` FROM players WHERE id = ?`, playerID)); err != nil {
+			return notFound(err, errs.Player, playerID)
+		}
+		var err error
+		p, err = row.load(tx)
+		return err
+	})
+	return p, err
+}
+
+// ListPlayers retrieves all players, in creation order.
+func (r *PlayerRepository) ListPlayers() ([]*player.Player, error) {
+	var players []*player.Player
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		rows, err := queryAll[playerRow](tx, `SELECT `+playerColumns+` FROM players ORDER BY rowid`)
+		if err != nil {
+			return err
+		}
+		players = make([]*player.Player, 0, len(rows))
+		for _, row := range rows {
+			p, err := row.load(tx)
+			if err != nil {
+				return err
+			}
+			players = append(players, p)
+		}
+		return nil
+	})
+	return players, err
+}
+
+func writePlayerCharacters(tx *sql.Tx, p *player.Player) error {
+	for i, c := range p.Characters {
+		if _, err := tx.Exec(`INSERT INTO player_characters (player_id, character_id, position) VALUES (?, ?, ?)`, p.PlayerID, c.CharacterID, i); err != nil {
+			return fmt.Errorf("storing character %s of player %s: %w", c.CharacterID, p.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+// playerRow holds the columns of a player row until its characters are loaded.
+type playerRow struct {
+	player player.Player
+	status int
+}
+
+func (row *playerRow) scan(s scanner) error {
+	return s.Scan(&row.player.PlayerID, &row.player.Name, &row.status, &row.player.Version)
+}
+
+// load reads the characters of the player.
+func (row *playerRow) load(q querier) (*player.Player, error) {
+	p := row.player
+	p.Status = player.PlayerStatus(row.status)
+	characters, err := queryAll[characterRow](q, `SELECT `+prefixed("c", characterColumns)+` FROM player_characters pc JOIN characters c ON c.id = pc.character_id
+		WHERE pc.player_id = ? ORDER BY pc.position`, p.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range characters {
+		c, err := row.load()
+		if err != nil {
+			return nil, err
+		}
+		p.Characters = append(p.Characters, *c)
+	}
+	return &p, nil
+}
+
+const characterColumns = `id, name, class, race, description, strength, dexterity, constitution, intelligence, wisdom, charisma,
+	xp, status, action_instances, version`
+
+func (r *CharacterRepository) CreateCharacter(c *character.Character) error {
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		if err := checkCreate(tx, "characters", errs.Character, c.CharacterID); err != nil {
+			return err
+		}
+		args, err := characterArgs(c)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO characters (`+characterColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+			append([]any{c.CharacterID}, args...)...)
+		return err
+	})
+	if err == nil {
+		c.Version = 1
+	}
+	return err
+}
+
+func (r *CharacterRepository) UpdateCharacter(c *character.Character) error {
+	args, err := characterArgs(c)
+	if err != nil {
+		return err
+	}
+	err = r.store.inTx(func(tx *sql.Tx) error {
+		result, err := tx.Exec(`UPDATE characters SET name = ?, class = ?, race = ?, description = ?, strength = ?, dexterity = ?,
+			constitution = ?, intelligence = ?, wisdom = ?, charisma = ?, xp = ?, status = ?, action_instances = ?, version = version + 1
+			WHERE id = ? AND version = ?`, append(args, c.CharacterID, c.Version)...)
+		if err != nil {
+			return err
+		}
+		return checkVersion(tx, result, "characters", errs.Character, c.CharacterID, c.Version)
+	})
+	if err == nil {
+		c.Version++
+	}
+	return err
+}
+
+func (r *CharacterRepository) DeleteCharacter(characterID string) error {
+	result, err := r.store.queries().Exec(`DELETE FROM characters WHERE id = ?`, characterID)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.Character, characterID)
+}
+
+func (r *CharacterRepository) GetCharacterByID(characterID string) (*character.Character, error) {
+	row := characterRow{}
+	if err := row.scan(r.store.queries().QueryRow(`SELECT `+characterColumns+` FROM characters WHERE id = ?`, characterID)); err != nil {
+		return nil, notFound(err, errs.Character, characterID)
+	}
+	return row.load()
+}
+
+// ListCharacters retrieves all characters, in creation order.
+func (r *CharacterRepository) ListCharacters() ([]*character.Character, error) {
+	rows, err := queryAll[characterRow](r.store.queries(), `SELECT `+characterColumns+` FROM characters ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	characters := make([]*character.Character, 0, len(rows))
+	for _, row := range rows {
+		c, err := row.load()
+		if err != nil {
+			return nil, err
+		}
+		characters = append(characters, c)
+	}
+	return characters, nil
+}
+
+// characterArgs returns the column values of a character, but its ID.
+func characterArgs(c *character.Character) ([]any, error) {
+	instances, err := toJSON(c.ActionInstances)
+	if err != nil {
+		return nil, err
+	}
+	a := c.Attributes
+	return []any{c.Name, int(c.Class), int(c.Race), c.Description, a.Strength, a.Dexterity, a.Constitution, a.Intelligence, a.Wisdom, a.Charisma,
+		c.XP, int(c.Status), instances}, nil
+}
+
+// characterRow holds the columns of a character row until they are decoded.
+type characterRow struct {
+	character           character.Character
+	class, race, status int
+	actionInstances     string
+}
+
+func (row *characterRow) scan(s scanner) error {
+	c, a := &row.character, &row.character.Attributes
+	return s.Scan(&c.CharacterID, &c.Name, &row.class, &row.race, &c.Description, &a.Strength, &a.Dexterity, &a.Constitution,
+		&a.Intelligence, &a.Wisdom, &a.Charisma, &c.XP, &row.status, &row.actionInstances, &c.Version)
+}
+
+func (row *characterRow) load() (*character.Character, error) {
+	c := row.character
+	c.Class, c.Race, c.Status = character.CharacterClass(row.class), character.CharacterRace(row.race), character.CharacterStatus(row.status)
+	if err := fromJSON("action_instances", row.actionInstances, &c.ActionInstances); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+const gameMasterColumns = `id, name, status`
+
+func (r *GameMasterRepository) GetGameMaster(id string) (*gamemaster.GameMaster, error) {
+	return getGameMaster(r.store.queries(), id)
+}
+
+func (r *GameMasterRepository) UpdateGameMaster(gm *gamemaster.GameMaster) error {
+	result, err := r.store.queries().Exec(`UPDATE game_masters SET name = ?, status = ? WHERE id = ?`, gm.Name, int(gm.Status), gm.ID)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.GameMaster, gm.ID)
+}
+
+func (r *GameMasterRepository) CreateGameMaster(gm *gamemaster.GameMaster) error {
+	return r.store.inTx(func(tx *sql.Tx) error {
+		if err := checkCreate(tx, "game_masters", errs.GameMaster, gm.ID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`INSERT INTO game_masters (`+gameMasterColumns+`) VALUES (?, ?, ?)`, gm.ID, gm.Name, int(gm.Status))
+		return err
+	})
+}
+
+// DeleteGameMaster deletes a game master. The games it ran keep no game master.
+func (r *GameMasterRepository) DeleteGameMaster(id string) error {
+	result, err := r.store.queries().Exec(`DELETE FROM game_masters WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.GameMaster, id)
+}
+
+// ListGameMasters retrieves all game masters, in creation order.
+func (r *GameMasterRepository) ListGameMasters() ([]*gamemaster.GameMaster, error) {
+	rows, err := queryAll[gameMasterRow](r.store.queries(), `SELECT `+gameMasterColumns+` FROM game_masters ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	gameMasters := make([]*gamemaster.GameMaster, 0, len(rows))
+	for _, row := range rows {
+		gameMasters = append(gameMasters, row.load())
+	}
+	return gameMasters, nil
+}
+
+func getGameMaster(q querier, id string) (*gamemaster.GameMaster, error) {
+	row := gameMasterRow{}
+	if err := row.scan(q.QueryRow(`SELECT `+gameMasterColumns+` FROM game_masters WHERE id = ?`, id)); err != nil {
+		return nil, notFound(err, errs.GameMaster, id)
+	}
+	return row.load(), nil
+}
+
+type gameMasterRow struct {
+	gameMaster gamemaster.GameMaster
+	status     int
+}
+
+func (row *gameMasterRow) scan(s scanner) error {
+	return s.Scan(&row.gameMaster.ID, &row.gameMaster.Name, &row.status)
+}
+
+func (row *gameMasterRow) load() *gamemaster.GameMaster {
+	gm := row.gameMaster
+	gm.Status = gamemaster.GameMasterStatus(row.status)
+	return &gm
+}
+
+const actionColumns = `id, name, base_xp_cost, reward_xp, penalty_xp`
+
+func (r *ActionRepository) CreateAction(a *action.Action) error {
+	return r.store.inTx(func(tx *sql.Tx) error {
+		if err := checkCreate(tx, "actions", errs.Action, a.ActionID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`INSERT INTO actions (`+actionColumns+`) VALUES (?, ?, ?, ?, ?)`, a.ActionID, a.Name, a.BaseXPCost, a.RewardXP, a.PenaltyXP)
+		return err
+	})
+}
+
+func (r *ActionRepository) UpdateAction(a *action.Action) error {
+	result, err := r.store.queries().Exec(`UPDATE actions SET name = ?, base_xp_cost = ?, reward_xp = ?, penalty_xp = ? WHERE id = ?`,
+		a.Name, a.BaseXPCost, a.RewardXP, a.PenaltyXP, a.ActionID)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.Action, a.ActionID)
+}
+
+func (r *ActionRepository) DeleteAction(actionID string) error {
+	result, err := r.store.queries().Exec(`DELETE FROM actions WHERE id = ?`, actionID)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result, errs.Action, actionID)
+}
+
+func (r *ActionRepository) GetActionByID(actionID string) (*action.Action, error) {
+	row := actionRow{}
+	if err := row.scan(r.store.queries().QueryRow(`SELECT `+actionColumns+` FROM actions WHERE id = ?`, actionID)); err != nil {
+		return nil, notFound(err, errs.Action, actionID)
+	}
+	a := action.Action(row)
+	return &a, nil
+}
+
+// ListActions retrieves all action templates, in creation order.
+func (r *ActionRepository) ListActions() ([]*action.Action, error) {
+	rows, err := queryAll[actionRow](r.store.queries(), `SELECT `+actionColumns+` FROM actions ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	actions := make([]*action.Action, 0, len(rows))
+	for _, row := range rows {
+		a := action.Action(*row)
+		actions = append(actions, &a)
+	}
+	return actions, nil
+}
+
+// actionRow scans an action template, whose columns need no decoding.
+type actionRow action.Action
+
+func (row *actionRow) scan(s scanner) error {
+	return s.Scan(&row.ActionID, &row.Name, &row.BaseXPCost, &row.RewardXP, &row.PenaltyXP)
+}
+
+const instanceColumns = `id, game_id, character_id, action, custom_xp_cost, reward_xp, penalty_xp, state, outcome, version`
+
+// CreateActionInstance stores an action instance. Its game and character have to be stored already.
+func (r *ActionRepository) CreateActionInstance(ai *action.ActionInstance) error {
+	err := r.store.inTx(func(tx *sql.Tx) error {
+		if err := checkCreate(tx, "action_instances", errs.ActionInstance, ai.InstanceID); err != nil {
+			return err
+		}
+		args, err := instanceArgs(ai)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO action_instances (`+instanceColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+			append([]any{ai.InstanceID}, args...)...); err != nil {
+			return fmt.Errorf("storing action instance %s: %w", ai.InstanceID, err)
+		}
+		return nil
+	})
+	if err == nil {
+		ai.Version = 1
+	}
+	return err
+}
+
+func (r *ActionRepository) UpdateActionInstance(ai *action.ActionInstance) error {
+	args, err := instanceArgs(ai)
+	if err != nil {
+		return err
+	}
+	err = r.store.inTx(func(tx *sql.Tx) error {
+		var gameID, characterID string
+		err := tx.QueryRow(`SELECT game_id, character_id FROM action_instances WHERE id = ?`, ai.InstanceID).Scan(&gameID, &characterID)
+		if err != nil {
+			return notFound(err, errs.ActionInstance, ai.InstanceID)
+		}
+		if gameID != ai.GameID || characterID != ai.CharacterID {
+			return errs.Newf(errs.ErrConflict, errs.ActionInstance, ai.InstanceID, "cannot change character or game")
+		}
+		result, err := tx.Exec(`UPDATE action_instances SET action = ?, custom_xp_cost = ?, reward_xp = ?, penalty_xp = ?, state = ?, outcome = ?,
+			version = version + 1 WHERE id = ? AND version = ?`, append(args[2:], ai.InstanceID, ai.Version)...)
+		if err != nil {
+			return err
+		}
+		return checkVersion(tx, result, "action_instances", errs.ActionInstance, ai.InstanceID, ai.Version)
+	})
+	if err == nil {
+		ai.Version++
+	}
+	return err
+}
+
+func (r *ActionRepository) GetActionInstanceByID(instanceID string) (*action.ActionInstance, error) {
+	row := instanceRow{}
+	if err := row.scan(r.store.queries().QueryRow(`SELECT `+instanceColumns+` FROM action_instances WHERE id = ?`, instanceID)); err != nil {
+		return nil, notFound(err, errs.ActionInstance, instanceID)
+	}
+	return row.load()
+}
+
+// ListActionInstances retrieves all action instances, in creation order.
+func (r *ActionRepository) ListActionInstances() ([]*action.ActionInstance, error) {
+	return r.listInstances(`SELECT ` + instanceColumns + ` FROM action_instances ORDER BY rowid`)
+}
+
+// ListActionInstancesByCharacter retrieves the action instances of a character, in creation order.
+func (r *ActionRepository) ListActionInstancesByCharacter(characterID string) ([]*action.ActionInstance, error) {
+	return r.listInstances(`SELECT `+instanceColumns+` FROM action_instances WHERE character_id = ? ORDER BY rowid`, characterID)
+}
+
+// ListActionInstancesByGame retrieves the action instances of a game, in creation order.
+func (r *ActionRepository) ListActionInstancesByGame(gameID string) ([]*action.ActionInstance, error) {
+	return r.listInstances(`SELECT `+instanceColumns+` FROM action_instances WHERE game_id = ? ORDER BY rowid`, gameID)
+}
+
+func (r *ActionRepository) listInstances(query string, args ...any) ([]*action.ActionInstance, error) {
+	rows, err := queryAll[instanceRow](r.store.queries(), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	instances := make([]*action.ActionInstance, 0, len(rows))
+	for _, row := range rows {
+		ai, err := row.load()
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, ai)
+	}
+	return instances, nil
+}
+
+// instanceArgs returns the column values of an action instance, but its ID.
+func instanceArgs(ai *action.ActionInstance) ([]any, error) {
+	template, err := toJSON(ai.Action)
+	if err != nil {
+		return nil, err
+	}
+	return []any{ai.GameID, ai.CharacterID, template, ai.CustomXPCost, ai.RewardXP, ai.PenaltyXP, int(ai.State), int(ai.Outcome)}, nil
+}
+
+// instanceRow holds the columns of an action instance row until they are decoded.
+type instanceRow struct {
+	instance       action.ActionInstance
+	template       string
+	state, outcome int
+}
+
+func (row *instanceRow) scan(s scanner) error {
+	ai := &row.instance
+	return s.Scan(&ai.InstanceID, &ai.GameID, &ai.CharacterID, &row.template, &ai.CustomXPCost, &ai.RewardXP, &ai.PenaltyXP,
+		&row.state, &row.outcome, &ai.Version)
+}
+
+func (row *instanceRow) load() (*action.ActionInstance, error) {
+	ai := row.instance
+	ai.State, ai.Outcome = action.ActionState(row.state), action.Outcome(row.outcome)
+	if err := fromJSON("action", row.template, &ai.Action); err != nil {
+		return nil, err
+	}
+	return &ai, nil
+}
+
+const transactionColumns = `sequence, character_id, game_id, actor_id, type, amount, reason, timestamp`
+
+// AppendTransaction stores the transaction and assigns its sequence number.
+func (r *XPRepository) AppendTransaction(tx *xp.Transaction) error {
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+	if tx.Sequence != 0 {
+		return errors.New("xp transaction already recorded")
+	}
+	result, err := r.store.queries().Exec(`INSERT INTO xp_transactions (character_id, game_id, actor_id, type, amount, reason, timestamp)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`, tx.CharacterID, tx.GameID, tx.ActorID, int(tx.Type), tx.Amount, tx.Reason, formatTime(tx.Timestamp))
+	if err != nil {
+		return err
+	}
+	sequence, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	tx.Sequence = int(sequence)
+	return nil
+}
+
+// ListTransactionsByCharacter retrieves all transactions of a character, oldest first.
+func (r *XPRepository) ListTransactionsByCharacter(characterID string) ([]*xp.Transaction, error) {
+	return r.listTransactions(`SELECT `+transactionColumns+` FROM xp_transactions WHERE character_id = ? ORDER BY sequence`, characterID)
+}
+
+// ListTransactionsByGame retrieves all transactions recorded in a game, oldest first.
+func (r *XPRepository) ListTransactionsByGame(gameID string) ([]*xp.Transaction, error) {
+	return r.listTransactions(`SELECT `+transactionColumns+` FROM xp_transactions WHERE game_id = ? ORDER BY sequence`, gameID)
+}
+
+func (r *XPRepository) listTransactions(query string, args ...any) ([]*xp.Transaction, error) {
+	rows, err := queryAll[transactionRow](r.store.queries(), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]*xp.Transaction, 0, len(rows))
+	for _, row := range rows {
+		tx := row.transaction
+		tx.Type = xp.TransactionType(row.transactionType)
+		if tx.Timestamp, err = parseTime("timestamp", row.timestamp); err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, &tx)
+	}
+	return transactions, nil
+}
+
+// transactionRow holds the columns of an XP transaction row until they are decoded.
+type transactionRow struct {
+	transaction     xp.Transaction
+	transactionType int
+	timestamp       string
+}
+
+func (row *transactionRow) scan(s scanner) error {
+	tx := &row.transaction
+	return s.Scan(&tx.Sequence, &tx.CharacterID, &tx.GameID, &tx.ActorID, &row.transactionType, &tx.Amount, &tx.Reason, &row.timestamp)
+}
@@ -0,0 +1,237 @@
+// Package sqlstore implements the repositories on a SQL database through database/sql, with SQLite as the
+// engine. The driver is pure Go, so no cgo is needed.
+//
+// Entities and the relations between them live in their own tables, tied by foreign keys: a game refers to
+// its game master and its players through the game_players join table, a player to its characters through
+// player_characters, and an action instance to its game and character. Those relations are stored by
+// reference, so a game read back carries the current state of its game master and players. Values without
+// a table of their own, such as the rulebook or the adventure of a game, are stored as JSON documents.
+// The schema is created and upgraded by the versioned migrations of the package, see Migrate.
+package sqlstore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // registers the "sqlite" driver
+
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+)
+
+// Store is a SQL database holding the entities of the game. It is safe for concurrent use.
+//
+// Store is a uow.UnitOfWork: the writes of a unit run in a single database transaction.
+type Store struct {
+	db *sql.DB
+	tx *sql.Tx // the transaction of the unit of work of a store passed to uow.UnitOfWork.Do, nil otherwise
+}
+
+// Open opens the SQLite database of a data source name, a file path or ":memory:", with its foreign keys
+// enforced, and migrates its schema to the latest version.
+func Open(dataSourceName string) (*Store, error) {
+	db, err := sql.Open("sqlite", dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite writes one transaction at a time anyway. A single connection serializes them without busy
+	// errors, keeps a ":memory:" database alive and shared, and holds the per-connection pragmas below.
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	if _, err := db.Exec(`PRAGMA foreign_keys = ON`); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := Migrate(db, -1); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// DB returns the underlying database, e.g. to run the migrations to another version.
+func (s *Store) DB() *sql.DB {
+	return s.db
+}
+
+// Close closes the database. The store must not be used afterwards.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Ensure Store implements uow.UnitOfWork at compile time.
+var _ uow.UnitOfWork = &Store{}
+
+// Do runs fn within a transaction, see uow.UnitOfWork. Within a unit of work, fn joins its transaction.
+func (s *Store) Do(fn func(r uow.Repositories) error) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		unit := &Store{db: s.db, tx: tx}
+		return fn(unit.Repositories())
+	})
+}
+
+// querier runs statements on a database or within a transaction.
+type querier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// scanner reads the columns of a row, from a *sql.Row or *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// queries returns what runs the statements of the store: the transaction of its unit of work, if any,
+// or the database.
+func (s *Store) queries() querier {
+	if s.tx != nil {
+		return s.tx
+	}
+	return s.db
+}
+
+// inTx runs fn within a transaction, committed if fn succeeds and rolled back otherwise. Within a unit
+// of work, fn runs in its transaction, which the unit commits or rolls back.
+func (s *Store) inTx(fn func(tx *sql.Tx) error) error {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// exists tells whether a table has a row with the given ID.
+func exists(q querier, table, id string) (bool, error) {
+	var one int
+	err := q.QueryRow(`SELECT 1 FROM `+table+` WHERE id = ?`, id).Scan(&one)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// checkCreate fails if the entity to create has no ID or already exists.
+func checkCreate(q querier, table, kind, id string) error {
+	if id == "" {
+		return fmt.Errorf("%s has no ID", kind)
+	}
+	found, err := exists(q, table, id)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errs.AlreadyExists(kind, id)
+	}
+	return nil
+}
+
+// checkAffected turns the result of a statement changing a single entity into a not found error
+// if no row was affected.
+func checkAffected(result sql.Result, kind, id string) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errs.NotFound(kind, id)
+	}
+	return nil
+}
+
+// checkVersion turns the result of an update of a versioned entity, conditioned on the version it is
+// based on, into a not found or a version conflict error if no row was affected.
+func checkVersion(q querier, result sql.Result, table, kind, id string, version int) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	var stored int
+	if err := q.QueryRow(`SELECT version FROM `+table+` WHERE id = ?`, id).Scan(&stored); err != nil {
+		return notFound(err, kind, id)
+	}
+	return errs.VersionConflict(kind, id, stored, version)
+}
+
+// notFound turns sql.ErrNoRows into a not found error of the entity.
+func notFound(err error, kind, id string) error {
+	if err == sql.ErrNoRows {
+		return errs.NotFound(kind, id)
+	}
+	return err
+}
+
+// toJSON encodes a value stored in a JSON column.
+func toJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), err
+}
+
+// fromJSON decodes a JSON column into v.
+func fromJSON(column, data string, v any) error {
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		return fmt.Errorf("column %s: %w", column, err)
+	}
+	return nil
+}
+
+// Times are stored as RFC 3339 text, which keeps their offset and sorts chronologically within one offset.
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseTime(column, s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("column %s: %w", column, err)
+	}
+	return t, nil
+}
+
+// row is the pointer to a row type scanning the columns of a query.
+type row[T any] interface {
+	*T
+	scan(s scanner) error
+}
+
+// queryAll reads every row of a query before returning, so that the single connection of the store is
+// free again for the queries loading their relations.
+func queryAll[T any, P row[T]](q querier, query string, args ...any) ([]P, error) {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var all []P
+	for rows.Next() {
+		r := P(new(T))
+		if err := r.scan(rows); err != nil {
+			return nil, err
+		}
+		all = append(all, r)
+	}
+	return all, rows.Err()
+}
+
+// prefixed qualifies a list of columns with a table alias.
+func prefixed(alias, columns string) string {
+	fields := strings.Split(columns, ",")
+	for i, field := range fields {
+		fields[i] = alias + "." + strings.TrimSpace(field)
+	}
+	return strings.Join(fields, ", ")
+}
@@ -0,0 +1,265 @@
+package sqlstore
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+)
+
+func openTestStore(t *testing.T, dataSourceName string) *Store {
+	t.Helper()
+	s, err := Open(dataSourceName)
+	if err != nil {
+		t.Fatalf("Open() error = %v, wantErr nil", err)
+	}
+	return s
+}
+
+func TestStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dndgame.db")
+	s := openTestStore(t, path)
+
+	start := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+	s.GameMasters().CreateGameMaster(&gamemaster.GameMaster{ID: "gm1", Name: "Anakin", Status: gamemaster.Active})
+	hero := character.Character{CharacterID: "c1", Name: "Hero", Class: character.Cleric, Attributes: character.Attributes{Strength: 12, Wisdom: 16}, XP: 100}
+	s.Characters().CreateCharacter(&hero)
+	s.Players().CreatePlayer(&player.Player{PlayerID: "p1", Name: "Luke", Characters: []character.Character{hero}})
+	g := &game.Game{
+		GameID:     "g1",
+		Name:       "Friday Night",
+		StartTime:  start,
+		Status:     game.Lobby,
+		GameMaster: gamemaster.GameMaster{ID: "gm1"},
+		Players:    []player.Player{{PlayerID: "p1"}},
+		Rulebook:   gamemaster.Rulebook{MaxXPGrantPerCharacter: 50},
+		Payout:     game.DefaultPayoutRules(),
+	}
+	if err := s.Games().CreateGame(g); err != nil {
+		t.Fatalf("CreateGame() error = %v, wantErr nil", err)
+	}
+	for _, id := range []string{"i2", "i1", "i3"} {
+		s.Actions().CreateActionInstance(&action.ActionInstance{InstanceID: id, GameID: "g1", CharacterID: "c1", Action: action.Action{ActionID: "a1"}})
+	}
+	tx := xp.NewTransaction(xp.StartingGrant, "c1", "g1", "p1", 100, "starting XP")
+	if err := s.XP().AppendTransaction(&tx); err != nil || tx.Sequence != 1 {
+		t.Fatalf("AppendTransaction() got sequence %d, error = %v", tx.Sequence, err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v, wantErr nil", err)
+	}
+
+	s = openTestStore(t, path)
+	defer s.Close()
+	stored, err := s.Games().GetGameByID("g1")
+	if err != nil {
+		t.Fatalf("GetGameByID() after restart error = %v, wantErr nil", err)
+	}
+	if !stored.StartTime.Equal(start) || stored.Rulebook.MaxXPGrantPerCharacter != 50 || !reflect.DeepEqual(stored.Payout, game.DefaultPayoutRules()) {
+		t.Errorf("GetGameByID() after restart got = %+v", stored)
+	}
+	// The game master and the players are read back from their own tables
+	if stored.GameMaster.Name != "Anakin" || len(stored.Players) != 1 || stored.Players[0].Name != "Luke" {
+		t.Errorf("GetGameByID() should load the game master and the players, got = %+v, %+v", stored.GameMaster, stored.Players)
+	}
+	if chars := stored.Players[0].Characters; len(chars) != 1 || chars[0].Attributes.Wisdom != 16 || chars[0].Class != character.Cleric {
+		t.Errorf("GetGameByID() should load the characters of the players, got = %+v", chars)
+	}
+	instances, _ := s.Actions().ListActionInstancesByCharacter("c1")
+	if len(instances) != 3 || instances[0].InstanceID != "i2" || instances[2].InstanceID != "i3" || instances[0].Action.ActionID != "a1" {
+		t.Errorf("ListActionInstancesByCharacter() should keep the creation order, got = %+v", instances)
+	}
+	tx = xp.NewTransaction(xp.GMGrant, "c1", "g1", "gm1", 5, "bonus")
+	s.XP().AppendTransaction(&tx)
+	if ledger, _ := s.XP().ListTransactionsByGame("g1"); len(ledger) != 2 || ledger[1].Sequence != 2 || ledger[1].Type != xp.GMGrant {
+		t.Errorf("ListTransactionsByGame() after restart got = %+v", ledger)
+	}
+}
+
+func TestStoreCRUD(t *testing.T) {
+	s := openTestStore(t, ":memory:")
+	defer s.Close()
+
+	gm := &gamemaster.GameMaster{ID: "gm1", Name: "Anakin"}
+	if err := s.GameMasters().CreateGameMaster(gm); err != nil {
+		t.Fatalf("CreateGameMaster() error = %v, wantErr nil", err)
+	}
+	if err := s.GameMasters().CreateGameMaster(gm); err == nil {
+		t.Errorf("CreateGameMaster() of an existing game master expected error, got nil")
+	}
+	if err := s.GameMasters().CreateGameMaster(&gamemaster.GameMaster{}); err == nil {
+		t.Errorf("CreateGameMaster() without ID expected error, got nil")
+	}
+	gm.Status = gamemaster.Active
+	if err := s.GameMasters().UpdateGameMaster(gm); err != nil {
+		t.Errorf("UpdateGameMaster() error = %v, wantErr nil", err)
+	}
+	if stored, err := s.GameMasters().GetGameMaster("gm1"); err != nil || stored.Status != gamemaster.Active {
+		t.Errorf("GetGameMaster() got = %+v, %v", stored, err)
+	}
+	if err := s.GameMasters().UpdateGameMaster(&gamemaster.GameMaster{ID: "unknown"}); err == nil {
+		t.Errorf("UpdateGameMaster() of an unknown game master expected error, got nil")
+	}
+
+	a := &action.Action{ActionID: "a1", Name: "Fireball", BaseXPCost: 10, RewardXP: 20, PenaltyXP: 5}
+	s.Actions().CreateAction(a)
+	s.Actions().CreateAction(&action.Action{ActionID: "a0", Name: "Sneak"})
+	a.BaseXPCost = 15
+	s.Actions().UpdateAction(a)
+	if actions, _ := s.Actions().ListActions(); len(actions) != 2 || actions[0].ActionID != "a1" || actions[0].BaseXPCost != 15 {
+		t.Errorf("ListActions() got = %+v", actions)
+	}
+	if err := s.Actions().DeleteAction("a0"); err != nil {
+		t.Errorf("DeleteAction() error = %v, wantErr nil", err)
+	}
+	if _, err := s.Actions().GetActionByID("a0"); err == nil {
+		t.Errorf("GetActionByID() of a deleted action expected error, got nil")
+	}
+
+	if err := s.Games().UpdateGame("g1", &game.Game{GameID: "g1"}); err == nil {
+		t.Errorf("UpdateGame() of an unknown game expected error, got nil")
+	}
+	if err := s.Games().UpdateGame("g1", &game.Game{GameID: "g2"}); err == nil {
+		t.Errorf("UpdateGame() changing the ID expected error, got nil")
+	}
+	if err := s.Games().DeleteGame("g1"); err == nil {
+		t.Errorf("DeleteGame() of an unknown game expected error, got nil")
+	}
+	if _, err := s.Characters().GetCharacterByID("c1"); err == nil {
+		t.Errorf("GetCharacterByID() of an unknown character expected error, got nil")
+	}
+	if games, err := s.Games().ListGames(); err != nil || len(games) != 0 {
+		t.Errorf("ListGames() of an empty store got = %+v, %v", games, err)
+	}
+}
+
+func TestStoreForeignKeys(t *testing.T) {
+	s := openTestStore(t, ":memory:")
+	defer s.Close()
+
+	if err := s.Games().CreateGame(&game.Game{GameID: "g1", Players: []player.Player{{PlayerID: "p1"}}}); err == nil {
+		t.Errorf("CreateGame() with an unknown player expected error, got nil")
+	}
+	if _, err := s.Games().GetGameByID("g1"); err == nil {
+		t.Errorf("CreateGame() failing should store nothing")
+	}
+	if err := s.Actions().CreateActionInstance(&action.ActionInstance{InstanceID: "i1", GameID: "g1", CharacterID: "c1"}); err == nil {
+		t.Errorf("CreateActionInstance() of an unknown game expected error, got nil")
+	}
+
+	s.GameMasters().CreateGameMaster(&gamemaster.GameMaster{ID: "gm1"})
+	s.Characters().CreateCharacter(&character.Character{CharacterID: "c1"})
+	for _, id := range []string{"p1", "p2"} {
+		s.Players().CreatePlayer(&player.Player{PlayerID: id, Characters: []character.Character{{CharacterID: "c1"}}})
+	}
+	for _, id := range []string{"g1", "g2"} {
+		g := &game.Game{GameID: id, GameMaster: gamemaster.GameMaster{ID: "gm1"}, Players: []player.Player{{PlayerID: "p2"}, {PlayerID: "p1"}}}
+		if err := s.Games().CreateGame(g); err != nil {
+			t.Fatalf("CreateGame() error = %v, wantErr nil", err)
+		}
+	}
+	s.Actions().CreateActionInstance(&action.ActionInstance{InstanceID: "i1", GameID: "g1", CharacterID: "c1"})
+
+	// A player takes part in many games, and leaves them all when deleted
+	s.Players().DeletePlayer("p2")
+	for _, id := range []string{"g1", "g2"} {
+		if g, _ := s.Games().GetGameByID(id); len(g.Players) != 1 || g.Players[0].PlayerID != "p1" {
+			t.Errorf("DeletePlayer() should remove the player from game %s, got = %+v", id, g.Players)
+		}
+	}
+	s.GameMasters().DeleteGameMaster("gm1")
+	if g, _ := s.Games().GetGameByID("g1"); g.GameMaster.ID != "" {
+		t.Errorf("DeleteGameMaster() should leave the game without game master, got = %+v", g.GameMaster)
+	}
+	s.Characters().DeleteCharacter("c1")
+	if p, _ := s.Players().GetPlayerByID("p1"); len(p.Characters) != 0 {
+		t.Errorf("DeleteCharacter() should remove the character from its player, got = %+v", p.Characters)
+	}
+	if _, err := s.Actions().GetActionInstanceByID("i1"); err == nil {
+		t.Errorf("DeleteCharacter() should delete the action instances of the character")
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	s := openTestStore(t, ":memory:")
+	defer s.Close()
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatalf("Migrations() error = %v, wantErr nil", err)
+	}
+	if version, err := SchemaVersion(s.DB()); err != nil || version != len(migrations) {
+		t.Errorf("SchemaVersion() after Open() got = %d, %v, want %d", version, err, len(migrations))
+	}
+
+	if err := Migrate(s.DB(), 0); err != nil {
+		t.Fatalf("Migrate() down to 0 error = %v, wantErr nil", err)
+	}
+	var tables int
+	s.DB().QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence')`).Scan(&tables)
+	if tables != 0 {
+		t.Errorf("Migrate() down to 0 should drop every table, %d left", tables)
+	}
+	if err := Migrate(s.DB(), -1); err != nil {
+		t.Fatalf("Migrate() up again error = %v, wantErr nil", err)
+	}
+	if err := s.Games().CreateGame(&game.Game{GameID: "g1"}); err != nil {
+		t.Errorf("CreateGame() after migrating up again error = %v, wantErr nil", err)
+	}
+	if err := Migrate(s.DB(), len(migrations)+1); err == nil {
+		t.Errorf("Migrate() to an unknown version expected error, got nil")
+	}
+}
+
+func TestMigrateFailureKeepsVersion(t *testing.T) {
+	s := openTestStore(t, ":memory:")
+	defer s.Close()
+	migrations, _ := Migrations()
+	broken := append(migrations, Migration{Version: len(migrations) + 1, Name: "broken", Up: `CREATE TABLE extra (id TEXT); NOT SQL`, Down: `DROP TABLE extra`})
+
+	if err := migrate(s.DB(), broken, -1); err == nil {
+		t.Fatalf("migrate() of a broken migration expected error, got nil")
+	}
+	if version, _ := SchemaVersion(s.DB()); version != len(migrations) {
+		t.Errorf("SchemaVersion() after a failed migration got = %d, want %d", version, len(migrations))
+	}
+	var tables int
+	s.DB().QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE name = 'extra'`).Scan(&tables)
+	if tables != 0 {
+		t.Errorf("a failed migration should be rolled back")
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		wantErr bool
+	}{
+		{"complete", fstest.MapFS{"m/0001_a.up.sql": {}, "m/0001_a.down.sql": {}, "m/0002_b.up.sql": {}, "m/0002_b.down.sql": {}, "m/README": {}}, false},
+		{"missing down", fstest.MapFS{"m/0001_a.up.sql": {}}, true},
+		{"gap", fstest.MapFS{"m/0001_a.up.sql": {}, "m/0001_a.down.sql": {}, "m/0003_c.up.sql": {}, "m/0003_c.down.sql": {}}, true},
+		{"bad version", fstest.MapFS{"m/first.up.sql": {}, "m/first.down.sql": {}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, file := range tt.files {
+				file.Data = []byte("SELECT 1; -- " + name)
+			}
+			migrations, err := loadMigrations(tt.files, "m")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadMigrations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (len(migrations) != 2 || migrations[1].Version != 2 || migrations[1].Name != "b") {
+				t.Errorf("loadMigrations() got = %+v", migrations)
+			}
+		})
+	}
+}
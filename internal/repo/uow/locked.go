@@ -0,0 +1,121 @@
+package uow
+
+import (
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// The repositories returned by InMemory.Repositories read through the in-memory repositories, and write through
+// them once no unit of work runs.
+
+type lockedGames struct {
+	repogame.GameRepository
+	units *InMemory
+}
+
+func (r lockedGames) CreateGame(g *game.Game) error {
+	return r.units.write(func() error { return r.GameRepository.CreateGame(g) })
+}
+
+func (r lockedGames) UpdateGame(gameID string, g *game.Game) error {
+	return r.units.write(func() error { return r.GameRepository.UpdateGame(gameID, g) })
+}
+
+func (r lockedGames) DeleteGame(gameID string) error {
+	return r.units.write(func() error { return r.GameRepository.DeleteGame(gameID) })
+}
+
+type lockedPlayers struct {
+	repoplayer.PlayerRepository
+	units *InMemory
+}
+
+func (r lockedPlayers) CreatePlayer(p *player.Player) error {
+	return r.units.write(func() error { return r.PlayerRepository.CreatePlayer(p) })
+}
+
+func (r lockedPlayers) UpdatePlayer(playerID string, p *player.Player) error {
+	return r.units.write(func() error { return r.PlayerRepository.UpdatePlayer(playerID, p) })
+}
+
+func (r lockedPlayers) DeletePlayer(playerID string) error {
+	return r.units.write(func() error { return r.PlayerRepository.DeletePlayer(playerID) })
+}
+
+type lockedCharacters struct {
+	repocharacter.CharacterRepository
+	units *InMemory
+}
+
+func (r lockedCharacters) CreateCharacter(c *character.Character) error {
+	return r.units.write(func() error { return r.CharacterRepository.CreateCharacter(c) })
+}
+
+func (r lockedCharacters) UpdateCharacter(c *character.Character) error {
+	return r.units.write(func() error { return r.CharacterRepository.UpdateCharacter(c) })
+}
+
+func (r lockedCharacters) DeleteCharacter(characterID string) error {
+	return r.units.write(func() error { return r.CharacterRepository.DeleteCharacter(characterID) })
+}
+
+type lockedGameMasters struct {
+	repogamemaster.GameMasterRepository
+	units *InMemory
+}
+
+func (r lockedGameMasters) UpdateGameMaster(gm *gamemaster.GameMaster) error {
+	return r.units.write(func() error { return r.GameMasterRepository.UpdateGameMaster(gm) })
+}
+
+func (r lockedGameMasters) CreateGameMaster(gm *gamemaster.GameMaster) error {
+	return r.units.write(func() error { return r.GameMasterRepository.CreateGameMaster(gm) })
+}
+
+func (r lockedGameMasters) DeleteGameMaster(id string) error {
+	return r.units.write(func() error { return r.GameMasterRepository.DeleteGameMaster(id) })
+}
+
+type lockedActions struct {
+	repoaction.ActionRepository
+	units *InMemory
+}
+
+func (r lockedActions) CreateAction(a *action.Action) error {
+	return r.units.write(func() error { return r.ActionRepository.CreateAction(a) })
+}
+
+func (r lockedActions) UpdateAction(a *action.Action) error {
+	return r.units.write(func() error { return r.ActionRepository.UpdateAction(a) })
+}
+
+func (r lockedActions) DeleteAction(actionID string) error {
+	return r.units.write(func() error { return r.ActionRepository.DeleteAction(actionID) })
+}
+
+func (r lockedActions) CreateActionInstance(ai *action.ActionInstance) error {
+	return r.units.write(func() error { return r.ActionRepository.CreateActionInstance(ai) })
+}
+
+func (r lockedActions) UpdateActionInstance(ai *action.ActionInstance) error {
+	return r.units.write(func() error { return r.ActionRepository.UpdateActionInstance(ai) })
+}
+
+type lockedXP struct {
+	repoxp.XPRepository
+	units *InMemory
+}
+
+func (r lockedXP) AppendTransaction(tx *xp.Transaction) error {
+	return r.units.write(func() error { return r.XPRepository.AppendTransaction(tx) })
+}
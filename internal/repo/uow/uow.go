@@ -0,0 +1,136 @@
+// Package uow groups the writes of an operation spanning several repositories into a unit of work,
+// committed as a whole if the operation succeeds and rolled back as a whole if it fails, so that a failure
+// halfway never leaves, say, the XP of a character deducted but its action unapproved.
+package uow
+
+import (
+	"fmt"
+	"sync"
+
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// Repositories are the repositories of a backend.
+type Repositories struct {
+	Games       repogame.GameRepository
+	Players     repoplayer.PlayerRepository
+	Characters  repocharacter.CharacterRepository
+	GameMasters repogamemaster.GameMasterRepository
+	Actions     repoaction.ActionRepository
+	XP          repoxp.XPRepository
+}
+
+// UnitOfWork runs operations whose writes are committed or rolled back together.
+type UnitOfWork interface {
+	// Do runs fn with repositories writing within a new unit of work, committed if fn returns nil and
+	// rolled back if it returns an error or panics. Do returns the error of fn, or of the commit.
+	//
+	// fn must use the given repositories only: the backends may hold locks for the whole unit. The versions
+	// set on the entities written by a unit rolled back are meaningless, they have to be read again.
+	Do(fn func(r Repositories) error) error
+}
+
+// Within returns the unit of work running fn within the unit of work of the given repositories, so that
+// code opening units of its own can take part in an enclosing one. Its Do runs fn right away, and the
+// enclosing unit commits or rolls back its writes.
+func Within(r Repositories) UnitOfWork {
+	return within(r)
+}
+
+type within Repositories
+
+func (w within) Do(fn func(r Repositories) error) error {
+	return fn(Repositories(w))
+}
+
+// Checkpointer is an in-memory repository whose content can be restored.
+type Checkpointer interface {
+	// Checkpoint returns a function restoring the content of the repository as it is now.
+	Checkpoint() (restore func())
+}
+
+// InMemory is the unit of work of in-memory repositories, which all have to be Checkpointers; the nil ones
+// are left out. The units run one at a time, and a unit rolled back restores the content the repositories
+// had when it began.
+//
+// Unlike the units of the persistent backends, an InMemory unit does not isolate its writes: they are
+// visible to readers before the commit. A rollback restores whole repositories, so the writes made outside
+// of a unit have to wait for the unit running, or it would undo them: they go through Repositories.
+type InMemory struct {
+	repos Repositories
+	mutex sync.Mutex
+}
+
+// Ensure InMemory implements UnitOfWork at compile time.
+var _ UnitOfWork = &InMemory{}
+
+// NewInMemory creates the unit of work of in-memory repositories.
+func NewInMemory(repos Repositories) *InMemory {
+	return &InMemory{repos: repos}
+}
+
+// Repositories returns the repositories to use outside of the units of work. Their writes wait until
+// no unit runs, so that a unit rolled back cannot undo them; a unit must not use them, see Do.
+func (u *InMemory) Repositories() Repositories {
+	r := Repositories{}
+	if u.repos.Games != nil {
+		r.Games = lockedGames{u.repos.Games, u}
+	}
+	if u.repos.Players != nil {
+		r.Players = lockedPlayers{u.repos.Players, u}
+	}
+	if u.repos.Characters != nil {
+		r.Characters = lockedCharacters{u.repos.Characters, u}
+	}
+	if u.repos.GameMasters != nil {
+		r.GameMasters = lockedGameMasters{u.repos.GameMasters, u}
+	}
+	if u.repos.Actions != nil {
+		r.Actions = lockedActions{u.repos.Actions, u}
+	}
+	if u.repos.XP != nil {
+		r.XP = lockedXP{u.repos.XP, u}
+	}
+	return r
+}
+
+// write runs a single write outside of the units of work, once no unit runs.
+func (u *InMemory) write(fn func() error) error {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	return fn()
+}
+
+func (u *InMemory) Do(fn func(r Repositories) error) error {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	var restores []func()
+	for _, repo := range []any{u.repos.Games, u.repos.Players, u.repos.Characters, u.repos.GameMasters, u.repos.Actions, u.repos.XP} {
+		if repo == nil {
+			continue
+		}
+		c, ok := repo.(Checkpointer)
+		if !ok {
+			return fmt.Errorf("repository %T cannot be rolled back", repo)
+		}
+		restores = append(restores, c.Checkpoint())
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			for _, restore := range restores {
+				restore()
+			}
+		}
+	}()
+	if err := fn(u.repos); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
@@ -0,0 +1,29 @@
+package uow_test
+
+import (
+	"testing"
+
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+func TestInMemory(t *testing.T) {
+	repotest.UnitOfWorkContract(t, func(t *testing.T) (uow.UnitOfWork, uow.Repositories) {
+		r := uow.Repositories{
+			Games:       repogame.NewInMemoryGameRepository(),
+			Players:     repoplayer.NewInMemoryPlayerRepository(),
+			Characters:  repocharacter.NewInMemoryCharacterRepository(),
+			GameMasters: repogamemaster.NewInMemoryGameMasterRepository(),
+			Actions:     repoaction.NewInMemoryActionRepository(),
+			XP:          repoxp.NewInMemoryXPRepository(),
+		}
+		units := uow.NewInMemory(r)
+		return units, units.Repositories()
+	})
+}
@@ -0,0 +1,91 @@
+// internal/repo/xp/inmemory.go
+
+package xp
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/jerberlin/dndgame/internal/model/xp"
+)
+
+// InMemoryXPRepository keeps the ledger in insertion order, with indexes by character and by game.
+type InMemoryXPRepository struct {
+	transactions []*xp.Transaction
+	byCharacter  map[string][]int
+	byGame       map[string][]int
+	mutex        sync.RWMutex
+}
+
+func NewInMemoryXPRepository() *InMemoryXPRepository {
+	return &InMemoryXPRepository{
+		byCharacter: make(map[string][]int),
+		byGame:      make(map[string][]int),
+	}
+}
+
+// AppendTransaction stores a copy of the transaction and assigns its sequence number.
+func (r *InMemoryXPRepository) AppendTransaction(tx *xp.Transaction) error {
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if tx.Sequence != 0 {
+		return errors.New("xp transaction already recorded")
+	}
+	idx := len(r.transactions)
+	tx.Sequence = idx + 1
+	stored := *tx
+	r.transactions = append(r.transactions, &stored)
+	r.byCharacter[tx.CharacterID] = append(r.byCharacter[tx.CharacterID], idx)
+	r.byGame[tx.GameID] = append(r.byGame[tx.GameID], idx)
+	return nil
+}
+
+// ListTransactionsByCharacter retrieves all transactions of a character.
+func (r *InMemoryXPRepository) ListTransactionsByCharacter(characterID string) ([]*xp.Transaction, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.collect(r.byCharacter[characterID]), nil
+}
+
+// ListTransactionsByGame retrieves all transactions recorded in a game.
+func (r *InMemoryXPRepository) ListTransactionsByGame(gameID string) ([]*xp.Transaction, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.collect(r.byGame[gameID]), nil
+}
+
+// collect returns copies so the stored ledger cannot be rewritten by callers.
+func (r *InMemoryXPRepository) collect(indexes []int) []*xp.Transaction {
+	txs := make([]*xp.Transaction, 0, len(indexes))
+	for _, i := range indexes {
+		tx := *r.transactions[i]
+		txs = append(txs, &tx)
+	}
+	return txs
+}
+
+// Checkpoint returns a function restoring the ledger as it is now, see uow.Checkpointer.
+func (r *InMemoryXPRepository) Checkpoint() func() {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	n := len(r.transactions)
+	byCharacter, byGame := copyIndex(r.byCharacter), copyIndex(r.byGame)
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		r.transactions = r.transactions[:n]
+		r.byCharacter, r.byGame = byCharacter, byGame
+	}
+}
+
+// copyIndex copies an index. Its slices are only ever appended to, so the copy shares them.
+func copyIndex(index map[string][]int) map[string][]int {
+	clone := make(map[string][]int, len(index))
+	for key, indexes := range index {
+		clone[key] = indexes
+	}
+	return clone
+}
@@ -0,0 +1,14 @@
+package xp_test
+
+import (
+	"testing"
+
+	"github.com/jerberlin/dndgame/internal/repo/repotest"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+func TestXPRepository(t *testing.T) {
+	repotest.XPRepositoryContract(t, func(t *testing.T) repoxp.XPRepository {
+		return repoxp.NewInMemoryXPRepository()
+	})
+}
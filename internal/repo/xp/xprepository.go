@@ -0,0 +1,12 @@
+// internal/repo/xp/xprepository.go
+
+package xp
+
+import "github.com/jerberlin/dndgame/internal/model/xp"
+
+// XPRepository defines the interface for the append-only XP ledger.
+type XPRepository interface {
+	AppendTransaction(tx *xp.Transaction) error
+	ListTransactionsByCharacter(characterID string) ([]*xp.Transaction, error) // Retrieve the ledger of a character, oldest first.
+	ListTransactionsByGame(gameID string) ([]*xp.Transaction, error)           // Retrieve the ledger of a game, oldest first.
+}
@@ -0,0 +1,139 @@
+// Package command serializes the mutations of each game: a Processor runs a loop per active game applying
+// the commands of the game one at a time, in the order they were sent, and replies to each of them.
+package command
+
+import (
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	servgame "github.com/jerberlin/dndgame/internal/service/game"
+	servgamemaster "github.com/jerberlin/dndgame/internal/service/gamemaster"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
+)
+
+// Services are what the commands are applied with.
+type Services struct {
+	Actions     repoaction.ActionRepository // checks that the action instances belong to the game of their command
+	Games       servgame.GameService
+	Players     servplayer.PlayerService
+	GameMasters servgamemaster.GameMasterService
+}
+
+// Command is a mutation of a game, applied by the loop of its game.
+type Command interface {
+	Game() string
+	apply(s Services) (any, error)
+}
+
+// ProposeAction is the choice of an action by a character, see PlayerService.ProposeAction.
+// Its reply is the proposed action.ActionInstance.
+type ProposeAction struct {
+	GameID       string
+	CharacterID  string
+	ActionID     string
+	CustomXPCost int // zero for the base cost of the action
+}
+
+// ApproveAction is the approval of an action instance by the game master, see GameMasterService.ApproveActionInstance.
+type ApproveAction struct {
+	GameID        string
+	GameMasterID  string
+	InstanceID    string
+	Modified      *action.ActionInstance // the costs set by the game master, nil to approve the instance as proposed
+	Justification string
+}
+
+// RejectAction is the refusal of an action instance by the game master, see GameMasterService.RejectActionInstance.
+type RejectAction struct {
+	GameID       string
+	GameMasterID string
+	InstanceID   string
+}
+
+// ExecuteAction is the execution of an approved action instance, see PlayerService.ExecuteActionInstance.
+// Its reply is the resolved action.ActionInstance.
+type ExecuteAction struct {
+	GameID      string
+	CharacterID string
+	InstanceID  string
+	Resolver    action.OutcomeResolver
+}
+
+// GrantXP is a change of the XP of a character by the game master, see GameMasterService.UpdateCharacterXP.
+type GrantXP struct {
+	GameID       string
+	GameMasterID string
+	CharacterID  string
+	Amount       int // negative to take XP
+	Reason       string
+}
+
+// JoinGame adds a player to the game.
+type JoinGame struct {
+	GameID   string
+	PlayerID string
+}
+
+// LeaveGame removes a player from the game.
+type LeaveGame struct {
+	GameID   string
+	PlayerID string
+}
+
+func (c ProposeAction) Game() string { return c.GameID }
+func (c ApproveAction) Game() string { return c.GameID }
+func (c RejectAction) Game() string  { return c.GameID }
+func (c ExecuteAction) Game() string { return c.GameID }
+func (c GrantXP) Game() string       { return c.GameID }
+func (c JoinGame) Game() string      { return c.GameID }
+func (c LeaveGame) Game() string     { return c.GameID }
+
+func (c ProposeAction) apply(s Services) (any, error) {
+	return s.Players.ProposeAction(c.GameID, c.CharacterID, c.ActionID, c.CustomXPCost)
+}
+
+func (c ApproveAction) apply(s Services) (any, error) {
+	if err := checkInstance(s, c.GameID, c.InstanceID); err != nil {
+		return nil, err
+	}
+	return nil, s.GameMasters.ApproveActionInstance(c.GameMasterID, c.InstanceID, c.Modified, c.Justification)
+}
+
+func (c RejectAction) apply(s Services) (any, error) {
+	if err := checkInstance(s, c.GameID, c.InstanceID); err != nil {
+		return nil, err
+	}
+	return nil, s.GameMasters.RejectActionInstance(c.GameMasterID, c.InstanceID)
+}
+
+func (c ExecuteAction) apply(s Services) (any, error) {
+	if err := checkInstance(s, c.GameID, c.InstanceID); err != nil {
+		return nil, err
+	}
+	return s.Players.ExecuteActionInstance(c.CharacterID, c.InstanceID, c.Resolver)
+}
+
+func (c GrantXP) apply(s Services) (any, error) {
+	return nil, s.GameMasters.UpdateCharacterXP(c.GameID, c.GameMasterID, c.CharacterID, c.Amount, c.Reason)
+}
+
+func (c JoinGame) apply(s Services) (any, error) {
+	return nil, s.Games.AddPlayerToGame(c.GameID, c.PlayerID)
+}
+
+func (c LeaveGame) apply(s Services) (any, error) {
+	return nil, s.Games.RemovePlayerFromGame(c.GameID, c.PlayerID)
+}
+
+// checkInstance fails unless the action instance belongs to the game, so that a command only ever changes
+// the game whose loop applies it.
+func checkInstance(s Services, gameID, instanceID string) error {
+	ai, err := s.Actions.GetActionInstanceByID(instanceID)
+	if err != nil {
+		return err
+	}
+	if ai.GameID != gameID {
+		return errs.Newf(errs.ErrNotFound, errs.ActionInstance, instanceID, "is not an action instance of game %s", gameID)
+	}
+	return nil
+}
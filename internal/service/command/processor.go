@@ -0,0 +1,172 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrClosed is the error of the commands sent to a closed Processor.
+var ErrClosed = errors.New("command processor is closed")
+
+// Reply is the result of a command: the value it returns, if any, or the error it failed with.
+type Reply struct {
+	Value any
+	Err   error
+}
+
+// Processor applies the commands of each game in its own goroutine, one command at a time in the order
+// they were sent, so that the changes of a command to the game, its players, characters, action instances
+// and XP ledger never interleave with the changes of another command of the same game. Commands of
+// different games run concurrently.
+//
+// The loop of a game starts with its first command and stops once it has been idle for the idle timeout.
+// It is safe for concurrent use.
+type Processor struct {
+	services    Services
+	buffer      int
+	idleTimeout time.Duration
+	mutex       sync.Mutex
+	loops       map[string]*loop
+	closed      bool
+	done        chan struct{} // closed by Close
+	running     sync.WaitGroup
+}
+
+// loop is the goroutine applying the commands of a game.
+type loop struct {
+	gameID   string
+	requests chan request
+	pending  int // requests sent or about to be sent, not received yet; guarded by the mutex of the processor
+}
+
+type request struct {
+	command Command
+	reply   chan Reply
+}
+
+// NewProcessor creates a processor applying the commands with the given services. Up to buffer commands
+// of a game wait for its loop; beyond that the senders are slowed down. The loop of a game stops after
+// idleTimeout without commands.
+func NewProcessor(services Services, buffer int, idleTimeout time.Duration) *Processor {
+	return &Processor{
+		services:    services,
+		buffer:      buffer,
+		idleTimeout: idleTimeout,
+		loops:       make(map[string]*loop),
+		done:        make(chan struct{}),
+	}
+}
+
+// Send queues a command for the loop of its game, starting the loop if needed, and returns the channel
+// receiving its reply.
+func (p *Processor) Send(cmd Command) <-chan Reply {
+	reply := make(chan Reply, 1)
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		reply <- Reply{Err: ErrClosed}
+		return reply
+	}
+	l, exists := p.loops[cmd.Game()]
+	if !exists {
+		l = &loop{gameID: cmd.Game(), requests: make(chan request, p.buffer)}
+		p.loops[l.gameID] = l
+		p.running.Add(1)
+		go p.run(l)
+	}
+	l.pending++
+	p.mutex.Unlock()
+
+	l.requests <- request{command: cmd, reply: reply}
+	return reply
+}
+
+// Execute sends a command and waits for its reply. If ctx is done first, Execute returns its error,
+// but the command is still applied.
+func (p *Processor) Execute(ctx context.Context, cmd Command) (any, error) {
+	select {
+	case r := <-p.Send(cmd):
+		return r.Value, r.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Games returns the IDs of the games whose loop is running.
+func (p *Processor) Games() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	games := make([]string, 0, len(p.loops))
+	for gameID := range p.loops {
+		games = append(games, gameID)
+	}
+	return games
+}
+
+// Close stops accepting commands and waits until the loops applied the commands already sent.
+func (p *Processor) Close() {
+	p.mutex.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.done)
+	}
+	p.mutex.Unlock()
+	p.running.Wait()
+}
+
+func (p *Processor) run(l *loop) {
+	defer p.running.Done()
+	idle := time.NewTimer(p.idleTimeout)
+	defer idle.Stop()
+	for {
+		select {
+		case req := <-l.requests:
+			p.apply(l, req)
+			if !idle.Stop() {
+				<-idle.C
+			}
+			idle.Reset(p.idleTimeout)
+		case <-idle.C:
+			if p.retire(l) {
+				return
+			}
+			idle.Reset(p.idleTimeout)
+		case <-p.done:
+			for !p.retire(l) {
+				p.apply(l, <-l.requests)
+			}
+			return
+		}
+	}
+}
+
+// apply applies a command and replies to it. A panicking command fails without stopping the loop.
+func (p *Processor) apply(l *loop, req request) {
+	p.mutex.Lock()
+	l.pending--
+	p.mutex.Unlock()
+
+	var reply Reply
+	defer func() {
+		if r := recover(); r != nil {
+			reply = Reply{Err: fmt.Errorf("command %T of game %s panicked: %v", req.command, l.gameID, r)}
+		}
+		req.reply <- reply
+	}()
+	reply.Value, reply.Err = req.command.apply(p.services)
+}
+
+// retire removes the loop from the processor unless commands are on their way to it. It reports whether
+// the loop can stop.
+func (p *Processor) retire(l *loop) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if l.pending > 0 {
+		return false
+	}
+	delete(p.loops, l.gameID)
+	return true
+}
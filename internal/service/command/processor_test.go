@@ -0,0 +1,249 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/action"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+	servgame "github.com/jerberlin/dndgame/internal/service/game"
+	servgamemaster "github.com/jerberlin/dndgame/internal/service/gamemaster"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
+)
+
+// testProcessor is a processor on fresh in-memory repositories, with the repositories the tests set up and check.
+type testProcessor struct {
+	*Processor
+	characterRepo repocharacter.CharacterRepository
+	gameRepo      repogame.GameRepository
+	playerRepo    repoplayer.PlayerRepository
+}
+
+// newProcessor creates a processor, closed at the end of the test, with the action template action-sneak.
+func newProcessor(t *testing.T, buffer int, idleTimeout time.Duration) *testProcessor {
+	t.Helper()
+	actionRepo := repoaction.NewInMemoryActionRepository()
+	characterRepo := repocharacter.NewInMemoryCharacterRepository()
+	gameRepo := repogame.NewInMemoryGameRepository()
+	playerRepo := repoplayer.NewInMemoryPlayerRepository()
+	clk := clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC))
+	bus := event.NewBus(clk)
+	gamemasterRepo := repogamemaster.NewInMemoryGameMasterRepository()
+	units := uow.NewInMemory(uow.Repositories{Games: gameRepo, Players: playerRepo, Characters: characterRepo, GameMasters: gamemasterRepo, Actions: actionRepo, XP: repoxp.NewInMemoryXPRepository()})
+	playerService := servplayer.NewPlayerService(playerRepo, characterRepo, actionRepo, gameRepo, units, clk, bus)
+	gameService := servgame.NewGameService(gameRepo, actionRepo, playerService, units, clk, bus)
+	gmService := servgamemaster.NewGameMasterService(actionRepo, characterRepo, gameRepo, gamemasterRepo, playerRepo, gameService, playerService, units, clk, bus)
+	services := Services{Actions: actionRepo, Games: gameService, Players: playerService, GameMasters: gmService}
+
+	if err := actionRepo.CreateAction(&action.Action{ActionID: "action-sneak", Name: "Sneak", BaseXPCost: 5}); err != nil {
+		t.Fatalf("CreateAction() error = %v, wantErr nil", err)
+	}
+	p := &testProcessor{Processor: NewProcessor(services, buffer, idleTimeout), characterRepo: characterRepo, gameRepo: gameRepo, playerRepo: playerRepo}
+	t.Cleanup(p.Close)
+	return p
+}
+
+// setupGame creates an active game directed by gm1 with a player owning a character with the given XP.
+func (p *testProcessor) setupGame(gameID string, xp int) (playerID, characterID string) {
+	playerID, characterID = gameID+"-player", gameID+"-char"
+	char := character.Character{CharacterID: characterID, Name: "Rey", Status: character.Active, XP: xp}
+	p.characterRepo.CreateCharacter(&char)
+	p.playerRepo.CreatePlayer(&player.Player{PlayerID: playerID, Name: "Finn", Characters: []character.Character{char}})
+	p.gameRepo.CreateGame(&game.Game{
+		GameID:     gameID,
+		Status:     game.Active,
+		GameMaster: gamemaster.GameMaster{ID: "gm1", Name: "Anakin", Status: gamemaster.Active},
+		Rulebook:   gamemaster.DefaultRulebook(),
+	})
+	return playerID, characterID
+}
+
+func TestProcessorCommands(t *testing.T) {
+	p := newProcessor(t, 8, time.Minute)
+	playerID, characterID := p.setupGame("game-commands", 20)
+	ctx := context.Background()
+
+	if _, err := p.Execute(ctx, JoinGame{GameID: "game-commands", PlayerID: playerID}); err != nil {
+		t.Fatalf("Execute(JoinGame) error = %v, wantErr nil", err)
+	}
+	if g, _ := p.gameRepo.GetGameByID("game-commands"); len(g.Players) != 1 {
+		t.Errorf("Execute(JoinGame) should add the player, got players %+v", g.Players)
+	}
+
+	value, err := p.Execute(ctx, ProposeAction{GameID: "game-commands", CharacterID: characterID, ActionID: "action-sneak"})
+	if err != nil {
+		t.Fatalf("Execute(ProposeAction) error = %v, wantErr nil", err)
+	}
+	proposed, ok := value.(action.ActionInstance)
+	if !ok || proposed.State != action.Proposed || proposed.CustomXPCost != 5 {
+		t.Fatalf("Execute(ProposeAction) got = %+v, want a proposed instance", value)
+	}
+	approve := ApproveAction{GameID: "game-commands", GameMasterID: "gm1", InstanceID: proposed.InstanceID}
+	if _, err := p.Execute(ctx, approve); err != nil {
+		t.Fatalf("Execute(ApproveAction) error = %v, wantErr nil", err)
+	}
+	execute := ExecuteAction{GameID: "game-commands", CharacterID: characterID, InstanceID: proposed.InstanceID, Resolver: action.FixedOutcome(action.Success)}
+	value, err = p.Execute(ctx, execute)
+	if err != nil {
+		t.Fatalf("Execute(ExecuteAction) error = %v, wantErr nil", err)
+	}
+	if resolved := value.(action.ActionInstance); resolved.State != action.Resolved {
+		t.Errorf("Execute(ExecuteAction) got = %+v, want a resolved instance", resolved)
+	}
+	if _, err := p.Execute(ctx, GrantXP{GameID: "game-commands", GameMasterID: "gm1", CharacterID: characterID, Amount: 10, Reason: "bravery"}); err != nil {
+		t.Errorf("Execute(GrantXP) error = %v, wantErr nil", err)
+	}
+	if char, _ := p.characterRepo.GetCharacterByID(characterID); char.XP != 25 {
+		t.Errorf("character XP got = %d, want 25", char.XP)
+	}
+
+	if _, err := p.Execute(ctx, LeaveGame{GameID: "game-commands", PlayerID: playerID}); err != nil {
+		t.Errorf("Execute(LeaveGame) error = %v, wantErr nil", err)
+	}
+	if _, err := p.Execute(ctx, LeaveGame{GameID: "game-commands", PlayerID: playerID}); err == nil {
+		t.Errorf("Execute(LeaveGame) of a player not in the game expected error, got nil")
+	}
+}
+
+func TestProcessorOtherGameInstance(t *testing.T) {
+	p := newProcessor(t, 8, time.Minute)
+	_, characterID := p.setupGame("game-own", 20)
+	p.setupGame("game-other", 20)
+	ctx := context.Background()
+
+	value, err := p.Execute(ctx, ProposeAction{GameID: "game-own", CharacterID: characterID, ActionID: "action-sneak"})
+	if err != nil {
+		t.Fatalf("Execute(ProposeAction) error = %v, wantErr nil", err)
+	}
+	instanceID := value.(action.ActionInstance).InstanceID
+	_, err = p.Execute(ctx, ApproveAction{GameID: "game-other", GameMasterID: "gm1", InstanceID: instanceID})
+	if !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("Execute(ApproveAction) of an instance of another game error = %v, want ErrNotFound", err)
+	}
+	_, err = p.Execute(ctx, ExecuteAction{GameID: "game-other", CharacterID: characterID, InstanceID: instanceID, Resolver: action.FixedOutcome(action.Success)})
+	if !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("Execute(ExecuteAction) of an instance of another game error = %v, want ErrNotFound", err)
+	}
+	if _, err := p.Execute(ctx, RejectAction{GameID: "game-other", GameMasterID: "gm1", InstanceID: instanceID}); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("Execute(RejectAction) of an instance of another game error = %v, want ErrNotFound", err)
+	}
+	if _, err := p.Execute(ctx, RejectAction{GameID: "game-own", GameMasterID: "gm1", InstanceID: instanceID}); err != nil {
+		t.Errorf("Execute(RejectAction) error = %v, wantErr nil", err)
+	}
+}
+
+// TestProcessorSerializesGrants sends more grants than the rulebook allows at once: each grant checks the XP
+// already granted before recording its own, which only holds if the grants do not interleave.
+func TestProcessorSerializesGrants(t *testing.T) {
+	p := newProcessor(t, 4, time.Minute)
+	playerID, characterID := p.setupGame("game-grants", 0)
+	if _, err := p.Execute(context.Background(), JoinGame{GameID: "game-grants", PlayerID: playerID}); err != nil {
+		t.Fatalf("Execute(JoinGame) error = %v, wantErr nil", err)
+	}
+
+	const grants, amount = 30, 10
+	limit := gamemaster.DefaultRulebook().MaxXPGrantPerCharacter
+	var wg sync.WaitGroup
+	replies := make(chan Reply, grants)
+	for i := 0; i < grants; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			replies <- <-p.Send(GrantXP{GameID: "game-grants", GameMasterID: "gm1", CharacterID: characterID, Amount: amount, Reason: "loot"})
+		}()
+	}
+	wg.Wait()
+	close(replies)
+
+	succeeded := 0
+	for r := range replies {
+		switch {
+		case r.Err == nil:
+			succeeded++
+		case !errors.Is(r.Err, errs.ErrForbidden):
+			t.Errorf("Send(GrantXP) error = %v, want nil or ErrForbidden", r.Err)
+		}
+	}
+	if want := limit / amount; succeeded != want {
+		t.Errorf("Send(GrantXP) succeeded %d times, want %d", succeeded, want)
+	}
+	if char, _ := p.characterRepo.GetCharacterByID(characterID); char.XP != limit {
+		t.Errorf("character XP got = %d, want %d", char.XP, limit)
+	}
+}
+
+// panicking is a command failing with a panic.
+type panicking struct{ gameID string }
+
+func (c panicking) Game() string { return c.gameID }
+
+func (c panicking) apply(Services) (any, error) { panic("boom") }
+
+func TestProcessorPanic(t *testing.T) {
+	p := newProcessor(t, 8, time.Minute)
+	playerID, _ := p.setupGame("game-panic", 0)
+	ctx := context.Background()
+
+	if _, err := p.Execute(ctx, panicking{gameID: "game-panic"}); err == nil {
+		t.Errorf("Execute() of a panicking command expected error, got nil")
+	}
+	if _, err := p.Execute(ctx, JoinGame{GameID: "game-panic", PlayerID: playerID}); err != nil {
+		t.Errorf("Execute(JoinGame) after a panic error = %v, wantErr nil", err)
+	}
+}
+
+func TestProcessorIdleLoops(t *testing.T) {
+	p := newProcessor(t, 8, 10*time.Millisecond)
+	playerID, _ := p.setupGame("game-idle", 0)
+
+	if _, err := p.Execute(context.Background(), JoinGame{GameID: "game-idle", PlayerID: playerID}); err != nil {
+		t.Fatalf("Execute(JoinGame) error = %v, wantErr nil", err)
+	}
+	if games := p.Games(); len(games) != 1 || games[0] != "game-idle" {
+		t.Errorf("Games() got = %v, want [game-idle]", games)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(p.Games()) > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if games := p.Games(); len(games) != 0 {
+		t.Errorf("Games() after the idle timeout got = %v, want none", games)
+	}
+	if _, err := p.Execute(context.Background(), LeaveGame{GameID: "game-idle", PlayerID: playerID}); err != nil {
+		t.Errorf("Execute(LeaveGame) after the loop stopped error = %v, wantErr nil", err)
+	}
+}
+
+func TestProcessorClose(t *testing.T) {
+	p := newProcessor(t, 8, time.Minute)
+	playerID, _ := p.setupGame("game-close", 0)
+	sent := p.Send(JoinGame{GameID: "game-close", PlayerID: playerID})
+	p.Close()
+
+	if r := <-sent; r.Err != nil {
+		t.Errorf("command sent before Close() error = %v, wantErr nil", r.Err)
+	}
+	if games := p.Games(); len(games) != 0 {
+		t.Errorf("Games() after Close() got = %v, want none", games)
+	}
+	if _, err := p.Execute(context.Background(), LeaveGame{GameID: "game-close", PlayerID: playerID}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Execute() after Close() error = %v, want ErrClosed", err)
+	}
+	p.Close()
+}
@@ -2,111 +2,355 @@ package game
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
 	repogame "github.com/jerberlin/dndgame/internal/repo/game"
-	"github.com/jerberlin/dndgame/internal/service/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
 	servplayer "github.com/jerberlin/dndgame/internal/service/player"
 )
 
 // GameService defines the interface for game-related operations.
 type GameService interface {
+	CreateGame(gameID, name string) error
+	ScheduleGame(gameID, name string, start, end time.Time) error
+	GetGame(gameID string) (*game.Game, error)
+	UpdateSchedules() error
 	StartGame(gameID string) error
 	EndGame(gameID string) error
 	SetGameStatus(gameID string, status game.GameStatus) error
 	AddPlayerToGame(gameID string, playerID string) error
 	RemovePlayerFromGame(gameID string, playerID string) error
+	SetGameMaster(gameID string, gm gamemaster.GameMaster) error
+	SetRulebook(gameID string, rulebook gamemaster.Rulebook) error
+	SetAllowLateCharacters(gameID string, allow bool) error
+	SetPayoutRules(gameID string, rules game.PayoutRules) error
 	SetAdventure(gameID string, adventure game.Adventure) error
 	AddMissionToGame(gameID string, mission game.Mission) error
 }
 
 type service struct {
 	gameRepo   repogame.GameRepository
+	actionRepo repoaction.ActionRepository
 	playerServ servplayer.PlayerService
+	units      uow.UnitOfWork
+	clock      clock.Clock
+	events     event.Publisher
 }
 
 // Ensure service implements GameService at compile time.
 var _ GameService = &service{}
 
 // NewGameService creates a new instance of GameService.
-func NewGameService(gr game.GameRepository, ps player.PlayerService) GameService {
+// A game ends within a unit of work of units, together with the expiry of its action instances.
+func NewGameService(gr repogame.GameRepository, ar repoaction.ActionRepository, ps servplayer.PlayerService, units uow.UnitOfWork, clk clock.Clock, events event.Publisher) GameService {
 	return &service{
 		gameRepo:   gr,
+		actionRepo: ar,
 		playerServ: ps,
+		units:      units,
+		clock:      clk,
+		events:     events,
 	}
 }
 
-// StartGame starts a new game session.
-func (s *service) StartGame(gameID string) error {
-	_, err := s.gameRepo.GetGameByID(gameID)
+// inUnit runs fn with a copy of the service writing within a unit of work, whose events are published
+// once the unit is committed.
+func (s *service) inUnit(fn func(unit *service) error) error {
+	var events event.Buffer
+	err := s.units.Do(func(r uow.Repositories) error {
+		unit := *s
+		unit.gameRepo, unit.actionRepo = r.Games, r.Actions
+		unit.units, unit.events = uow.Within(r), &events
+		return fn(&unit)
+	})
 	if err == nil {
-		return errors.New("game already exists")
+		events.Flush(s.events)
+	}
+	return err
+}
+
+// CreateGame creates a game in Draft, for the game master to configure before opening the lobby.
+func (s *service) CreateGame(gameID, name string) error {
+	if _, err := s.gameRepo.GetGameByID(gameID); err == nil {
+		return errs.AlreadyExists(errs.Game, gameID)
+	} else if !errors.Is(err, errs.ErrNotFound) {
+		return err
 	}
 	newGame := &game.Game{
-		GameID: gameID,
-		Status: game.Active,
+		GameID:   gameID,
+		Name:     name,
+		Status:   game.Draft,
+		Rulebook: gamemaster.DefaultRulebook(),
+		Payout:   game.DefaultPayoutRules(),
+	}
+	return s.gameRepo.CreateGame(newGame)
+}
+
+// ScheduleGame creates a game in the lobby that becomes active at start and ends at end, see game.NewScheduledGame.
+func (s *service) ScheduleGame(gameID, name string, start, end time.Time) error {
+	if _, err := s.gameRepo.GetGameByID(gameID); err == nil {
+		return errs.AlreadyExists(errs.Game, gameID)
+	} else if !errors.Is(err, errs.ErrNotFound) {
+		return err
+	}
+	newGame, err := game.NewScheduledGame(gameID, name, start, end)
+	if err != nil {
+		return err
 	}
+	newGame.Rulebook = gamemaster.DefaultRulebook()
+	newGame.Payout = game.DefaultPayoutRules()
+	newGame.UpdateSchedule(s.clock.Now())
 	return s.gameRepo.CreateGame(newGame)
 }
 
-// EndGame ends a specific game session.
+// GetGame retrieves a game, with its status brought up to date with its schedule.
+func (s *service) GetGame(gameID string) (*game.Game, error) {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+	previous := g.Status
+	if g.UpdateSchedule(s.clock.Now()) {
+		if err := s.saveStatusChange(g, previous); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// UpdateSchedules brings the status of every game up to date with its schedule. It is meant to be called periodically.
+func (s *service) UpdateSchedules() error {
+	games, err := s.gameRepo.ListGames()
+	if err != nil {
+		return err
+	}
+	now := s.clock.Now()
+	for _, g := range games {
+		previous := g.Status
+		if g.UpdateSchedule(now) {
+			if err := s.saveStatusChange(g, previous); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// StartGame starts a game session right away. A game in the lobby is started, or a paused game resumed;
+// the game has to be created first, see CreateGame and ScheduleGame.
+func (s *service) StartGame(gameID string) error {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return err
+	}
+	previous := g.Status
+	if err := g.SetStatus(game.Active); err != nil {
+		return err
+	}
+	if previous == game.Lobby {
+		g.StartTime = s.clock.Now()
+	}
+	return s.saveStatusChange(g, previous)
+}
+
+// EndGame ends a specific game session right away.
 func (s *service) EndGame(gameID string) error {
 	g, err := s.gameRepo.GetGameByID(gameID)
 	if err != nil {
-		return errors.New("game not found")
+		return err
 	}
-	g.Status = game.Inactive
-	return s.gameRepo.UpdateGame(gameID, g)
+	previous := g.Status
+	if err := g.SetStatus(game.Ended); err != nil {
+		return err
+	}
+	g.EndTime = s.clock.Now()
+	return s.saveStatusChange(g, previous)
 }
 
-// SetGameStatus updates the game's status.
+// SetGameStatus moves the game to another status of its lifecycle.
 func (s *service) SetGameStatus(gameID string, status game.GameStatus) error {
 	g, err := s.gameRepo.GetGameByID(gameID)
 	if err != nil {
-		return errors.New("game not found")
+		return err
 	}
-	g.Status = status
-	return s.gameRepo.UpdateGame(gameID, g)
+	previous := g.Status
+	if err := g.SetStatus(status); err != nil {
+		return err
+	}
+	return s.saveStatusChange(g, previous)
+}
+
+// saveStatusChange stores a game whose status changed and publishes the change. The action instances a game
+// that ended leaves unexecuted expire with it.
+func (s *service) saveStatusChange(g *game.Game, previous game.GameStatus) error {
+	return s.inUnit(func(unit *service) error {
+		if err := unit.gameRepo.UpdateGame(g.GameID, g); err != nil {
+			return err
+		}
+		if g.Status == game.Ended {
+			if err := unit.expireActionInstances(g.GameID); err != nil {
+				return err
+			}
+		}
+		unit.events.Publish(event.GameStatusChangedEvent{GameID: g.GameID, From: previous.String(), To: g.Status.String()})
+		return nil
+	})
+}
+
+// expireActionInstances expires the action instances of a game that are still waiting to be decided or executed.
+func (s *service) expireActionInstances(gameID string) error {
+	instances, err := s.actionRepo.ListActionInstancesByGame(gameID)
+	if err != nil {
+		return err
+	}
+	for _, ai := range instances {
+		if !ai.CanTransitionTo(action.Expired) {
+			continue
+		}
+		if err := ai.Expire(); err != nil {
+			return err
+		}
+		if err := s.actionRepo.UpdateActionInstance(ai); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // AddPlayerToGame adds a player to a game.
 func (s *service) AddPlayerToGame(gameID string, playerID string) error {
 	g, err := s.gameRepo.GetGameByID(gameID)
 	if err != nil {
-		return errors.New("game not found")
+		return err
+	}
+	if err := g.Allows(game.Join); err != nil {
+		return err
 	}
 	p, err := s.playerServ.GetPlayerByID(playerID)
 	if err != nil {
-		return err // Player does not exist or other errors
+		return fmt.Errorf("adding player to game %s: %w", gameID, err)
+	}
+	if err := g.AddPlayer(*p); err != nil {
+		return err
+	}
+	if err := s.gameRepo.UpdateGame(gameID, g); err != nil {
+		return err
 	}
-	return g.AddPlayer(p)
+	s.events.Publish(event.PlayerJoinedEvent{GameID: gameID, PlayerID: playerID})
+	return nil
 }
 
 // RemovePlayerFromGame removes a player from a game.
 func (s *service) RemovePlayerFromGame(gameID string, playerID string) error {
 	g, err := s.gameRepo.GetGameByID(gameID)
 	if err != nil {
-		return errors.New("game not found")
+		return err
+	}
+	if err := g.Allows(game.Leave); err != nil {
+		return err
+	}
+	if err := g.RemovePlayer(playerID); err != nil {
+		return err
+	}
+	if err := s.gameRepo.UpdateGame(gameID, g); err != nil {
+		return err
+	}
+	s.events.Publish(event.PlayerLeftEvent{GameID: gameID, PlayerID: playerID})
+	return nil
+}
+
+// SetGameMaster sets the game master directing a specific game, before the game starts. The decisions of the
+// game master are refused until a game has one.
+func (s *service) SetGameMaster(gameID string, gm gamemaster.GameMaster) error {
+	if gm.ID == "" {
+		return errs.Invalidf("game master has no ID")
+	}
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return err
+	}
+	if err := g.Allows(game.AssignGameMaster); err != nil {
+		return err
+	}
+	g.GameMaster = gm
+	return s.gameRepo.UpdateGame(gameID, g)
+}
+
+// SetRulebook sets the limits the game master of a specific game has to abide by, before the game starts.
+func (s *service) SetRulebook(gameID string, rulebook gamemaster.Rulebook) error {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return err
+	}
+	if err := g.Allows(game.ChangeRulebook); err != nil {
+		return err
+	}
+	g.SetRulebook(rulebook)
+	return s.gameRepo.UpdateGame(gameID, g)
+}
+
+// SetAllowLateCharacters sets whether players may still create characters once the game started.
+func (s *service) SetAllowLateCharacters(gameID string, allow bool) error {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return err
+	}
+	if err := g.Allows(game.Configure); err != nil {
+		return err
+	}
+	g.AllowLateCharacters = allow
+	return s.gameRepo.UpdateGame(gameID, g)
+}
+
+// SetPayoutRules sets the bonus XP the characters of a specific game earn when an adventure ends, before the game starts.
+func (s *service) SetPayoutRules(gameID string, rules game.PayoutRules) error {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return err
+	}
+	if err := g.Allows(game.ChangePayout); err != nil {
+		return err
+	}
+	if err := g.SetPayoutRules(rules); err != nil {
+		return err
 	}
-	return g.RemovePlayer(playerID)
+	return s.gameRepo.UpdateGame(gameID, g)
 }
 
 // SetAdventure sets the adventure for a specific game.
 func (s *service) SetAdventure(gameID string, adventure game.Adventure) error {
 	g, err := s.gameRepo.GetGameByID(gameID)
 	if err != nil {
-		return errors.New("game not found")
+		return err
+	}
+	if err := g.Allows(game.Configure); err != nil {
+		return err
+	}
+	if err := g.SetAdventure(adventure); err != nil {
+		return err
 	}
-	g.SetAdventure(adventure)
 	return s.gameRepo.UpdateGame(gameID, g)
 }
 
-// AddMissionToGame adds a mission to the current adventure in the game.
+// AddMissionToGame appends a mission to the current adventure in the game.
 func (s *service) AddMissionToGame(gameID string, mission game.Mission) error {
 	g, err := s.gameRepo.GetGameByID(gameID)
 	if err != nil {
-		return errors.New("game not found")
+		return err
+	}
+	if err := g.Allows(game.Configure); err != nil {
+		return err
+	}
+	if err := g.AddMission(mission); err != nil {
+		return err
 	}
-	g.AddMission(mission)
 	return s.gameRepo.UpdateGame(gameID, g)
 }
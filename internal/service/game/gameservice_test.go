@@ -1,19 +1,43 @@
 package game
 
 import (
+	"errors"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/game"
-	"github.com/jerberlin/dndgame/internal/repo/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
 )
 
-var repo game.GameRepository
-var service GameService
+var repo repogame.GameRepository
+var actionRepo repoaction.ActionRepository
+var playerService servplayer.PlayerService
+var gameService GameService
+var fakeClock *clock.Fake
+var bus *event.Bus
 
 func TestMain(m *testing.M) {
-	repo = game.NewInMemoryGameRepository()
-	service = NewGameService(repo, nil)
+	repo = repogame.NewInMemoryGameRepository()
+	fakeClock = clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC))
+	bus = event.NewBus(fakeClock)
+	characterRepo := repocharacter.NewInMemoryCharacterRepository()
+	playerRepo := repoplayer.NewInMemoryPlayerRepository()
+	actionRepo = repoaction.NewInMemoryActionRepository()
+	units := uow.NewInMemory(uow.Repositories{Games: repo, Players: playerRepo, Characters: characterRepo, Actions: actionRepo, XP: repoxp.NewInMemoryXPRepository()})
+	playerService = servplayer.NewPlayerService(playerRepo, characterRepo, actionRepo, repo, units, fakeClock, bus)
+	gameService = NewGameService(repo, actionRepo, playerService, units, fakeClock, bus)
 
 	os.Exit(m.Run())
 }
@@ -31,28 +55,155 @@ func assertGameStatus(t *testing.T, gameID string, expectedStatus game.GameStatu
 func TestGameServiceStartAndEndGame(t *testing.T) {
 	gameID := "test-game-1"
 
+	// Test starting a game that was not created
+	if err := gameService.StartGame(gameID); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("StartGame() of a missing game error = %v, want ErrNotFound", err)
+	}
+	setupGame(repo, gameID, game.Lobby)
+
 	// Test starting the game
-	if err := service.StartGame(gameID); err != nil {
+	if err := gameService.StartGame(gameID); err != nil {
 		t.Errorf("StartGame() error = %v, wantErr false", err)
 	}
 	assertGameStatus(t, gameID, game.Active)
 
 	// Test ending the game
-	if err := service.EndGame(gameID); err != nil {
+	if err := gameService.EndGame(gameID); err != nil {
 		t.Errorf("EndGame() error = %v, wantErr false", err)
 	}
-	assertGameStatus(t, gameID, game.Inactive)
+	assertGameStatus(t, gameID, game.Ended)
+
+	g, _ := repo.GetGameByID(gameID)
+	if !g.StartTime.Equal(fakeClock.Now()) || !g.EndTime.Equal(fakeClock.Now()) {
+		t.Errorf("StartGame() and EndGame() failed to set the times, got = %v - %v", g.StartTime, g.EndTime)
+	}
+}
+
+func TestGameServiceEndGameExpiresActionInstances(t *testing.T) {
+	gameID := "test-game-expiry"
+	setupGame(repo, gameID, game.Active)
+	proposed := setupActionInstance(gameID, "expiry-proposed", action.Proposed)
+	modified := setupActionInstance(gameID, "expiry-modified", action.Modified)
+	approved := setupActionInstance(gameID, "expiry-approved", action.Approved)
+	resolved := setupActionInstance(gameID, "expiry-resolved", action.Resolved)
+	rejected := setupActionInstance(gameID, "expiry-rejected", action.Rejected)
+
+	if err := gameService.EndGame(gameID); err != nil {
+		t.Fatalf("EndGame() error = %v, wantErr false", err)
+	}
+	assertActionState(t, proposed, action.Expired)
+	assertActionState(t, modified, action.Expired)
+	assertActionState(t, approved, action.Expired)
+	assertActionState(t, resolved, action.Resolved)
+	assertActionState(t, rejected, action.Rejected)
+}
+
+func TestGameServiceScheduleGame(t *testing.T) {
+	gameID := "test-game-scheduled"
+	start := fakeClock.Now().Add(time.Hour)
+
+	if err := gameService.ScheduleGame(gameID, "Friday Night", start, start.Add(3*time.Hour)); err != nil {
+		t.Fatalf("ScheduleGame() error = %v, wantErr false", err)
+	}
+	assertGameStatus(t, gameID, game.Lobby)
+
+	fakeClock.Advance(time.Hour)
+	if g, err := gameService.GetGame(gameID); err != nil || g.Status != game.Active {
+		t.Errorf("GetGame() failed to activate the game at its start time, got = %v, %v", g, err)
+	}
+	assertGameStatus(t, gameID, game.Active)
+	proposed := setupActionInstance(gameID, "scheduled-proposed", action.Proposed)
+
+	fakeClock.Advance(3 * time.Hour)
+	if err := gameService.UpdateSchedules(); err != nil {
+		t.Errorf("UpdateSchedules() error = %v, wantErr false", err)
+	}
+	assertGameStatus(t, gameID, game.Ended)
+	assertActionState(t, proposed, action.Expired)
+
+	if err := gameService.ScheduleGame("test-game-backwards", "Backwards", start, start.Add(-time.Hour)); err == nil {
+		t.Errorf("ScheduleGame() expected error for an end time before the start time, got nil")
+	}
 }
 
 func TestGameServiceSetGameStatus(t *testing.T) {
 	gameID := "test-game-status"
 	setupGame(repo, gameID, game.Active)
 
-	// Test setting game status to inactive
-	if err := service.SetGameStatus(gameID, game.Inactive); err != nil {
+	// Test pausing the game
+	if err := gameService.SetGameStatus(gameID, game.Paused); err != nil {
 		t.Errorf("SetGameStatus() error = %v, wantErr false", err)
 	}
-	assertGameStatus(t, gameID, game.Inactive)
+	assertGameStatus(t, gameID, game.Paused)
+
+	// Test that a paused game cannot go back to the lobby
+	if err := gameService.SetGameStatus(gameID, game.Lobby); err == nil {
+		t.Errorf("SetGameStatus() expected error for an invalid transition, got nil")
+	}
+	assertGameStatus(t, gameID, game.Paused)
+
+	// Test resuming the game
+	if err := gameService.StartGame(gameID); err != nil {
+		t.Errorf("StartGame() error = %v, wantErr false", err)
+	}
+	assertGameStatus(t, gameID, game.Active)
+}
+
+func TestGameServiceLifecycle(t *testing.T) {
+	gameID := "test-game-lifecycle"
+	if err := gameService.CreateGame(gameID, "Lifecycle"); err != nil {
+		t.Fatalf("CreateGame() error = %v, wantErr false", err)
+	}
+	assertGameStatus(t, gameID, game.Draft)
+
+	playerID := "player-lifecycle"
+	playerService.CreatePlayer(playerID, "Player Lifecycle")
+	if err := gameService.AddPlayerToGame(gameID, playerID); err == nil {
+		t.Errorf("AddPlayerToGame() expected error while the game is a draft, got nil")
+	}
+	if err := gameService.SetAllowLateCharacters(gameID, true); err != nil {
+		t.Errorf("SetAllowLateCharacters() error = %v, wantErr false", err)
+	}
+
+	if err := gameService.SetGameStatus(gameID, game.Lobby); err != nil {
+		t.Fatalf("SetGameStatus() error = %v, wantErr false", err)
+	}
+	if err := gameService.AddPlayerToGame(gameID, playerID); err != nil {
+		t.Errorf("AddPlayerToGame() error = %v, wantErr false", err)
+	}
+
+	if err := gameService.StartGame(gameID); err != nil {
+		t.Fatalf("StartGame() error = %v, wantErr false", err)
+	}
+	// The game master and the rules they abide by are settled before the start
+	if err := gameService.SetRulebook(gameID, gamemaster.Rulebook{AllowNegativeBalance: true}); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("SetRulebook() of an active game error = %v, want ErrConflict", err)
+	}
+	if err := gameService.SetGameMaster(gameID, gamemaster.GameMaster{ID: "gm-usurper"}); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("SetGameMaster() of an active game error = %v, want ErrConflict", err)
+	}
+	if err := gameService.SetPayoutRules(gameID, game.PayoutRules{}); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("SetPayoutRules() of an active game error = %v, want ErrConflict", err)
+	}
+	if err := gameService.SetAllowLateCharacters(gameID, false); err != nil {
+		t.Errorf("SetAllowLateCharacters() of an active game error = %v, wantErr false", err)
+	}
+	if err := gameService.SetGameStatus(gameID, game.Paused); err != nil {
+		t.Fatalf("SetGameStatus() error = %v, wantErr false", err)
+	}
+	if err := gameService.SetRulebook(gameID, gamemaster.Rulebook{AllowNegativeBalance: true}); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("SetRulebook() of a paused game error = %v, want ErrConflict", err)
+	}
+	if err := gameService.EndGame(gameID); err != nil {
+		t.Fatalf("EndGame() error = %v, wantErr false", err)
+	}
+	if err := gameService.SetRulebook(gameID, gamemaster.DefaultRulebook()); err == nil {
+		t.Errorf("SetRulebook() expected error after the game ended, got nil")
+	}
+	if err := gameService.SetGameStatus(gameID, game.Archived); err != nil {
+		t.Errorf("SetGameStatus() error = %v, wantErr false", err)
+	}
+	assertGameStatus(t, gameID, game.Archived)
 }
 
 func TestGameServiceAddPlayerToGame(t *testing.T) {
@@ -60,9 +211,39 @@ func TestGameServiceAddPlayerToGame(t *testing.T) {
 	setupGame(repo, gameID, game.Active)
 
 	playerID := "player123"
-	if err := service.AddPlayerToGame(gameID, playerID); err != nil {
+	playerService.CreatePlayer(playerID, "Player 123")
+	if err := gameService.AddPlayerToGame(gameID, playerID); err != nil {
 		t.Errorf("AddPlayerToGame() error = %v", err)
 	}
+	if err := gameService.AddPlayerToGame(gameID, playerID); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("AddPlayerToGame() of a player already in the game error = %v, want ErrConflict", err)
+	}
+	if g, _ := repo.GetGameByID(gameID); len(g.Players) != 1 {
+		t.Errorf("AddPlayerToGame() should add the player once, got players %+v", g.Players)
+	}
+}
+
+func TestGameServiceErrors(t *testing.T) {
+	gameID := "test-game-errors"
+	setupGame(repo, gameID, game.Active)
+
+	if err := gameService.CreateGame(gameID, "Duplicate"); !errors.Is(err, errs.ErrAlreadyExists) {
+		t.Errorf("CreateGame() of an existing game error = %v, want ErrAlreadyExists", err)
+	}
+	if err := gameService.EndGame("unknown-game"); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("EndGame() of an unknown game error = %v, want ErrNotFound", err)
+	}
+	err := gameService.AddPlayerToGame(gameID, "unknown-player")
+	var entityErr *errs.EntityError
+	if !errors.Is(err, errs.ErrNotFound) || !errors.As(err, &entityErr) || entityErr.Kind != errs.Player || entityErr.ID != "unknown-player" {
+		t.Errorf("AddPlayerToGame() of an unknown player error = %v, want player unknown-player not found", err)
+	}
+	if err := gameService.RemovePlayerFromGame(gameID, "unknown-player"); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("RemovePlayerFromGame() of a player not in the game error = %v, want ErrNotFound", err)
+	}
+	if err := gameService.SetGameStatus(gameID, game.Draft); !errors.Is(err, errs.ErrInvalidTransition) {
+		t.Errorf("SetGameStatus() back to Draft error = %v, want ErrInvalidTransition", err)
+	}
 }
 
 func TestGameServiceRemovePlayerFromGame(t *testing.T) {
@@ -70,24 +251,62 @@ func TestGameServiceRemovePlayerFromGame(t *testing.T) {
 	setupGame(repo, gameID, game.Active)
 
 	playerID := "player-to-remove"
-	if err := service.RemovePlayerFromGame(gameID, playerID); err != nil {
+	playerService.CreatePlayer(playerID, "Player To Remove")
+	gameService.AddPlayerToGame(gameID, playerID)
+	if err := gameService.RemovePlayerFromGame(gameID, playerID); err != nil {
 		t.Errorf("RemovePlayerFromGame() error = %v, wantErr false", err)
 	}
 }
 
+func TestGameServiceSetRulebook(t *testing.T) {
+	gameID := "test-game-rulebook"
+	setupGame(repo, gameID, game.Lobby)
+
+	rulebook := gamemaster.Rulebook{MaxXPGrantPerCharacter: 10}
+	if err := gameService.SetRulebook(gameID, rulebook); err != nil {
+		t.Errorf("SetRulebook() error = %v, wantErr false", err)
+	}
+	g, _ := repo.GetGameByID(gameID)
+	if g.Rulebook != rulebook {
+		t.Errorf("SetRulebook() got = %+v, want %+v", g.Rulebook, rulebook)
+	}
+}
+
+func TestGameServiceSetGameMaster(t *testing.T) {
+	gameID := "test-game-gamemaster"
+	setupGame(repo, gameID, game.Draft)
+
+	gm := gamemaster.GameMaster{ID: "gm1", Name: "Anakin", Status: gamemaster.Active}
+	if err := gameService.SetGameMaster(gameID, gm); err != nil {
+		t.Errorf("SetGameMaster() error = %v, wantErr nil", err)
+	}
+	g, _ := repo.GetGameByID(gameID)
+	if g.GameMaster != gm {
+		t.Errorf("SetGameMaster() got = %+v, want %+v", g.GameMaster, gm)
+	}
+	if err := gameService.SetGameMaster(gameID, gamemaster.GameMaster{}); !errors.Is(err, errs.ErrInvalid) {
+		t.Errorf("SetGameMaster() without ID error = %v, want ErrInvalid", err)
+	}
+	setupGame(repo, "test-game-gamemaster-ended", game.Ended)
+	if err := gameService.SetGameMaster("test-game-gamemaster-ended", gm); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("SetGameMaster() of an ended game error = %v, want ErrConflict", err)
+	}
+}
+
 func TestGameServiceSetAdventure(t *testing.T) {
 	gameID := "test-game-adventure"
 	setupGame(repo, gameID, game.Active)
 
 	adventure := game.Adventure{
 		Type: game.Quests,
-		Mission: game.Mission{
+		Missions: []game.Mission{{
+			ID:          "artifact",
 			Name:        "Retrieve the Lost Artifact",
 			Description: "Players must navigate the ancient ruins to retrieve a lost artifact.",
-		},
+		}},
 	}
 
-	if err := service.SetAdventure(gameID, adventure); err != nil {
+	if err := gameService.SetAdventure(gameID, adventure); err != nil {
 		t.Errorf("SetAdventure() error = %v, wantErr false", err)
 	}
 }
@@ -101,12 +320,39 @@ func TestGameServiceAddMissionToGame(t *testing.T) {
 		Description: "Players must defend the village from a band of marauding goblins.",
 	}
 
-	if err := service.AddMissionToGame(gameID, mission); err != nil {
+	if err := gameService.AddMissionToGame(gameID, mission); err != nil {
+		t.Errorf("AddMissionToGame() error = %v, wantErr false", err)
+	}
+
+	// Test that a second mission is appended, not replacing the first one
+	followUp := game.Mission{ID: "hunt", Name: "Hunt the Goblins", Prerequisites: []string{"mission-1"}}
+	if err := gameService.AddMissionToGame(gameID, followUp); err != nil {
 		t.Errorf("AddMissionToGame() error = %v, wantErr false", err)
 	}
+	g, _ := repo.GetGameByID(gameID)
+	if len(g.Adventure.Missions) != 2 || g.Adventure.Missions[1].Status != game.Locked {
+		t.Errorf("AddMissionToGame() failed to append the mission, got = %+v", g.Adventure.Missions)
+	}
+}
+
+func setupActionInstance(gameID, instanceID string, state action.ActionState) string {
+	ai := &action.ActionInstance{InstanceID: instanceID, GameID: gameID, CharacterID: "char-" + instanceID, State: state}
+	actionRepo.CreateActionInstance(ai)
+	return instanceID
+}
+
+func assertActionState(t *testing.T, instanceID string, expectedState action.ActionState) {
+	t.Helper()
+	ai, err := actionRepo.GetActionInstanceByID(instanceID)
+	if err != nil {
+		t.Fatalf("GetActionInstanceByID() error = %v, wantErr false", err)
+	}
+	if ai.State != expectedState {
+		t.Errorf("Action instance %s state got = %v, want %v", instanceID, ai.State, expectedState)
+	}
 }
 
-func setupGame(repo game.GameRepository, gameID string, status game.GameStatus) {
+func setupGame(repo repogame.GameRepository, gameID string, status game.GameStatus) {
 	newGame := &game.Game{
 		GameID: gameID,
 		Status: status,
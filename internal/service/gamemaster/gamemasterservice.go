@@ -3,43 +3,65 @@
 package gamemaster
 
 import (
-	"errors"
+	"fmt"
+	"log"
 
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
 	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/character"
-	"github.com/jerberlin/dndgame/internal/repo/action"
-	"github.com/jerberlin/dndgame/internal/repo/character"
-	"github.com/jerberlin/dndgame/internal/repo/game"
-	"github.com/jerberlin/dndgame/internal/repo/gamemaster"
-	"github.com/jerberlin/dndgame/internal/repo/player"
-	"github.com/jerberlin/dndgame/internal/service/game"
-	"github.com/jerberlin/dndgame/internal/service/player"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	servgame "github.com/jerberlin/dndgame/internal/service/game"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
+	servxp "github.com/jerberlin/dndgame/internal/service/xp"
 )
 
 type GameMasterService interface {
 	ListPendingActionInstances() ([]action.ActionInstance, error)
-	ApproveActionInstance(instanceID string, modifiedInstance *action.ActionInstance) error
+	ApproveActionInstance(gameMasterID, instanceID string, modifiedInstance *action.ActionInstance, justification string) error
+	RejectActionInstance(gameMasterID, instanceID string) error
 	ListActions() ([]action.Action, error)
 	ModifyAction(actionID string, modifiedAction *action.Action) error
 	ListCharacters() ([]character.Character, error)
 	GetCharacter(characterID string) (*character.Character, error)
 	UpdateCharacter(c *character.Character) error
-	UpdateCharacterXP(characterID string, xpChange int) error
+	UpdateCharacterXP(gameID, gameMasterID, characterID string, xpChange int, reason string) error
+	ReviewMissionProgress(gameID string, missionID string) (game.Mission, error)
+	StartMission(gameID, gameMasterID, missionID string) error
+	CompleteObjective(gameID, gameMasterID, missionID, objectiveID string) error
+	FailMission(gameID, gameMasterID, missionID string) error
+	SetAdventureOutcome(gameID, gameMasterID string, outcome game.AdventureOutcome) error
+	EndAdventure(gameID, gameMasterID, adventureID string) (game.AdventureReport, error)
 }
 
 type service struct {
-	actionRepo     action.ActionRepository
-	characterRepo  character.CharacterRepository
-	gameRepo       game.GameRepository
-	gamemasterRepo gamemaster.GameMasterRepository
-	playerRepo     player.PlayerRepository
-	gameService    game.GameService
-	playerService  player.PlayerService
+	actionRepo     repoaction.ActionRepository
+	characterRepo  repocharacter.CharacterRepository
+	gameRepo       repogame.GameRepository
+	gamemasterRepo repogamemaster.GameMasterRepository
+	playerRepo     repoplayer.PlayerRepository
+	gameService    servgame.GameService
+	playerService  servplayer.PlayerService
+	xpService      servxp.XPService // within units of work only, see inUnit
+	units          uow.UnitOfWork
+	clock          clock.Clock
+	events         event.Publisher
+	logger         *log.Logger // records every override of the game master with its justification
 }
 
 var _ GameMasterService = &service{}
 
-func NewGameMasterService(actionRepo action.ActionRepository, characterRepo character.CharacterRepository, gameRepo game.GameRepository, gamemasterRepo gamemaster.GameMasterRepository, playerRepo player.PlayerRepository, gameService game.GameService, playerService player.PlayerService) GameMasterService {
+// NewGameMasterService creates a new instance of GameMasterService.
+// The operations changing several entities run within a unit of work of units.
+func NewGameMasterService(actionRepo repoaction.ActionRepository, characterRepo repocharacter.CharacterRepository, gameRepo repogame.GameRepository, gamemasterRepo repogamemaster.GameMasterRepository, playerRepo repoplayer.PlayerRepository, gameService servgame.GameService, playerService servplayer.PlayerService, units uow.UnitOfWork, clk clock.Clock, events event.Publisher) GameMasterService {
 	return &service{
 		actionRepo:     actionRepo,
 		characterRepo:  characterRepo,
@@ -48,30 +70,153 @@ func NewGameMasterService(actionRepo action.ActionRepository, characterRepo char
 		playerRepo:     playerRepo,
 		gameService:    gameService,
 		playerService:  playerService,
+		units:          units,
+		clock:          clk,
+		events:         events,
+		logger:         log.Default(),
 	}
 }
 
+// inUnit runs fn with a copy of the service writing within a unit of work, whose events are published
+// once the unit is committed.
+func (s *service) inUnit(fn func(unit *service) error) error {
+	var events event.Buffer
+	err := s.units.Do(func(r uow.Repositories) error {
+		unit := *s
+		unit.actionRepo, unit.characterRepo, unit.gameRepo = r.Actions, r.Characters, r.Games
+		unit.gamemasterRepo, unit.playerRepo = r.GameMasters, r.Players
+		unit.units, unit.events = uow.Within(r), &events
+		unit.xpService = servxp.NewXPService(r.XP, r.Characters, unit.units, unit.events)
+		return fn(&unit)
+	})
+	if err == nil {
+		events.Flush(s.events)
+	}
+	return err
+}
+
 // ListPendingActionInstances retrieves all action instances that haven't been approved yet.
 func (s *service) ListPendingActionInstances() ([]action.ActionInstance, error) {
-	return s.actionRepo.ListPendingInstances()
+	instances, err := s.actionRepo.ListActionInstances()
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]action.ActionInstance, 0, len(instances))
+	for _, ai := range instances {
+		if ai.IsPending() {
+			pending = append(pending, *ai)
+		}
+	}
+	return pending, nil
 }
 
 // ApproveActionInstance approves a specific action instance, with potential modifications.
-func (s *service) ApproveActionInstance(instanceID string, modifiedInstance *action.ActionInstance) error {
+// Only the XP cost, reward and penalty of the modified instance are taken over; the state always goes through the action lifecycle.
+// Modifications are overrides of the game master: they need a justification, must respect the rulebook of the game, and are logged.
+// Every approval has to keep the worst outcome of the instance, paying its cost and its penalty, within the balance rule.
+func (s *service) ApproveActionInstance(gameMasterID, instanceID string, modifiedInstance *action.ActionInstance, justification string) error {
+	var instance *action.ActionInstance
+	err := s.inUnit(func(unit *service) error {
+		var err error
+		instance, err = unit.approveActionInstance(gameMasterID, instanceID, modifiedInstance, justification)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if modifiedInstance != nil {
+		s.logOverride(instance.GameID, gameMasterID, fmt.Sprintf("modified action instance %s to cost %d, reward %d, penalty %d", instanceID, instance.CustomXPCost, instance.RewardXP, instance.PenaltyXP), justification)
+	}
+	return nil
+}
+
+// approveActionInstance approves an action instance and returns it, leaving the log of the override to the caller.
+func (s *service) approveActionInstance(gameMasterID, instanceID string, modifiedInstance *action.ActionInstance, justification string) (*action.ActionInstance, error) {
+	instance, err := s.actionRepo.GetActionInstanceByID(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.gameDirectedBy(instance.GameID, gameMasterID)
+	if err != nil {
+		return nil, err
+	}
 	if modifiedInstance != nil {
-		return s.actionRepo.UpdateActionInstance(modifiedInstance)
+		if justification == "" {
+			return nil, errs.Invalidf("modifying an action instance requires a justification")
+		}
+		if err := g.Rulebook.CheckCost(instance.Action.BaseXPCost, modifiedInstance.CustomXPCost); err != nil {
+			return nil, err
+		}
+		if err := g.Rulebook.CheckPenalty(instance.Action.PenaltyXP, modifiedInstance.PenaltyXP); err != nil {
+			return nil, err
+		}
+		// Raising the reward above the one of the action grants the character XP on the decision of the game master.
+		granted, err := s.grantedInGame(instance.GameID, instance.CharacterID)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.Rulebook.CheckXPGrant(granted, modifiedInstance.RewardXP-instance.Action.RewardXP); err != nil {
+			return nil, err
+		}
+		if err := instance.Modify(modifiedInstance.CustomXPCost, modifiedInstance.RewardXP, modifiedInstance.PenaltyXP); err != nil {
+			return nil, err
+		}
+	}
+	char, err := s.characterRepo.GetCharacterByID(instance.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+	// The worst outcome of the action, paying the cost and losing the penalty, must not break the balance rule.
+	if err := g.Rulebook.CheckBalance(char.XP, -(instance.CustomXPCost + instance.PenaltyXP)); err != nil {
+		return nil, err
+	}
+	if err := instance.Approve(); err != nil {
+		return nil, err
 	}
+	if err := s.actionRepo.UpdateActionInstance(instance); err != nil {
+		return nil, err
+	}
+	s.events.Publish(event.ActionApprovedEvent{
+		GameID:       instance.GameID,
+		GameMasterID: gameMasterID,
+		CharacterID:  instance.CharacterID,
+		InstanceID:   instanceID,
+		XPCost:       instance.CustomXPCost,
+		Modified:     modifiedInstance != nil,
+	})
+	return instance, nil
+}
+
+// RejectActionInstance refuses a pending action instance, as the game master directing its game.
+func (s *service) RejectActionInstance(gameMasterID, instanceID string) error {
 	instance, err := s.actionRepo.GetActionInstanceByID(instanceID)
 	if err != nil {
 		return err
 	}
-	instance.Approved = true
-	return s.actionRepo.UpdateActionInstance(instance)
+	if _, err := s.gameDirectedBy(instance.GameID, gameMasterID); err != nil {
+		return err
+	}
+	if err := instance.Reject(); err != nil {
+		return err
+	}
+	if err := s.actionRepo.UpdateActionInstance(instance); err != nil {
+		return err
+	}
+	s.events.Publish(event.ActionRejectedEvent{GameID: instance.GameID, CharacterID: instance.CharacterID, InstanceID: instanceID})
+	return nil
 }
 
 // ListActions lists all actions available in the game.
 func (s *service) ListActions() ([]action.Action, error) {
-	return s.actionRepo.GetAllActions()
+	actions, err := s.actionRepo.ListActions()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]action.Action, 0, len(actions))
+	for _, a := range actions {
+		result = append(result, *a)
+	}
+	return result, nil
 }
 
 // ModifyAction modifies the details of an existing action.
@@ -81,7 +226,15 @@ func (s *service) ModifyAction(actionID string, modifiedAction *action.Action) e
 
 // ListCharacters lists all characters in the game.
 func (s *service) ListCharacters() ([]character.Character, error) {
-	return s.characterRepo.GetAllCharacters()
+	characters, err := s.characterRepo.ListCharacters()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]character.Character, 0, len(characters))
+	for _, c := range characters {
+		result = append(result, *c)
+	}
+	return result, nil
 }
 
 // GetCharacter retrieves a single character by ID.
@@ -89,38 +242,143 @@ func (s *service) GetCharacter(characterID string) (*character.Character, error)
 	return s.characterRepo.GetCharacterByID(characterID)
 }
 
-// UpdateCharacter updates the details of a character.
+// UpdateCharacter updates the details of a character. Its XP is kept as stored, since only the transactions of
+// the XP ledger change it, see UpdateCharacterXP.
 func (s *service) UpdateCharacter(c *character.Character) error {
+	stored, err := s.characterRepo.GetCharacterByID(c.CharacterID)
+	if err != nil {
+		return err
+	}
+	c.XP = stored.XP
 	return s.characterRepo.UpdateCharacter(c)
 }
 
-// UpdateCharacterXP modifies the XP of a character.
-func (s *service) UpdateCharacterXP(characterID string, xpChange int) error {
-	char, err := s.characterRepo.GetCharacterByID(characterID)
+// UpdateCharacterXP grants (or takes, if negative) XP to a character independently of actions.
+// The change is an override of the game master: the reason is its justification, it must respect the rulebook of the game,
+// and it is recorded in the XP ledger and logged.
+func (s *service) UpdateCharacterXP(gameID, gameMasterID, characterID string, xpChange int, reason string) error {
+	if reason == "" {
+		return errs.Invalidf("changing the XP of a character requires a justification")
+	}
+	err := s.inUnit(func(unit *service) error {
+		return unit.updateCharacterXP(gameID, gameMasterID, characterID, xpChange, reason)
+	})
 	if err != nil {
 		return err
 	}
-	char.Attributes.XP += xpChange
-	return s.characterRepo.UpdateCharacter(char)
+	s.logOverride(gameID, gameMasterID, fmt.Sprintf("changed XP of character %s by %d", characterID, xpChange), reason)
+	return nil
 }
 
-// ReviewMissionProgress allows the Game Master to review and adjust the progress of missions within an adventure.
-func (s *service) ReviewMissionProgress(gameID string, missionID string) error {
-	// Fetch the game and its current adventure state.
-	game, err := s.gameRepo.GetGameByID(gameID)
+func (s *service) updateCharacterXP(gameID, gameMasterID, characterID string, xpChange int, reason string) error {
+	g, err := s.gameDirectedBy(gameID, gameMasterID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkParticipant(g, characterID); err != nil {
+		return err
+	}
+	char, err := s.characterRepo.GetCharacterByID(characterID)
+	if err != nil {
+		return err
+	}
+	granted, err := s.grantedInGame(gameID, characterID)
 	if err != nil {
 		return err
 	}
+	if err := g.Rulebook.CheckXPGrant(granted, xpChange); err != nil {
+		return err
+	}
+	if err := g.Rulebook.CheckBalance(char.XP, xpChange); err != nil {
+		return err
+	}
+
+	tx := xp.NewTransaction(xp.GMGrant, characterID, gameID, gameMasterID, xpChange, reason)
+	return s.xpService.RecordTransaction(tx)
+}
+
+// gameDirectedBy retrieves a game and checks that it is directed by the given game master.
+func (s *service) gameDirectedBy(gameID, gameMasterID string) (*game.Game, error) {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if g.GameMaster.ID == "" {
+		return nil, errs.Newf(errs.ErrForbidden, errs.Game, gameID, "has no game master")
+	}
+	if g.GameMaster.ID != gameMasterID {
+		return nil, errs.Newf(errs.ErrForbidden, errs.GameMaster, gameMasterID, "does not direct game %s", gameID)
+	}
+	return g, nil
+}
 
-	// Find the specific mission within the adventure to review.
-	for _, m := range game.Adventure.Missions {
-		if m.ID == missionID {
-			// Here you could implement logic to review the progress or outcomes of the mission.
-			// This might include checking if mission objectives are met or adjusting the mission status.
-			return nil // Assuming no modifications are directly made here
+// grantedInGame sums the XP the game masters have granted a character during a game. A game is played as a single
+// session, from its start to its end, so this is the total the per session limit of the rulebook applies to.
+func (s *service) grantedInGame(gameID, characterID string) (int, error) {
+	txs, err := s.xpService.ListTransactionsByGame(gameID)
+	if err != nil {
+		return 0, err
+	}
+	granted := 0
+	for _, tx := range txs {
+		if tx.CharacterID == characterID && tx.Type == xp.GMGrant && tx.Amount > 0 {
+			granted += tx.Amount
 		}
 	}
-	return errors.New("mission not found")
+	return granted, nil
+}
+
+func (s *service) logOverride(gameID, gameMasterID, override, justification string) {
+	s.logger.Printf("game %s: game master %s %s, justification: %q", gameID, gameMasterID, override, justification)
+}
+
+// ReviewMissionProgress allows the Game Master to review the progress of a mission within the adventure.
+func (s *service) ReviewMissionProgress(gameID string, missionID string) (game.Mission, error) {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return game.Mission{}, err
+	}
+	m, err := g.Adventure.FindMission(missionID)
+	if err != nil {
+		return game.Mission{}, err
+	}
+	return *m, nil
+}
+
+// StartMission lets the Game Master start an available mission of the adventure.
+func (s *service) StartMission(gameID, gameMasterID, missionID string) error {
+	return s.updateAdventure(gameID, gameMasterID, func(a *game.Adventure) error {
+		return a.StartMission(missionID)
+	})
+}
+
+// CompleteObjective lets the Game Master mark an objective of a mission complete once its criteria are met.
+func (s *service) CompleteObjective(gameID, gameMasterID, missionID, objectiveID string) error {
+	return s.updateAdventure(gameID, gameMasterID, func(a *game.Adventure) error {
+		return a.CompleteObjective(missionID, objectiveID)
+	})
+}
+
+// FailMission lets the Game Master declare a mission of the adventure failed.
+func (s *service) FailMission(gameID, gameMasterID, missionID string) error {
+	return s.updateAdventure(gameID, gameMasterID, func(a *game.Adventure) error {
+		return a.FailMission(missionID)
+	})
+}
+
+// updateAdventure applies a change of the game master to the adventure of a game they direct and stores the game.
+func (s *service) updateAdventure(gameID, gameMasterID string, change func(a *game.Adventure) error) error {
+	g, err := s.gameDirectedBy(gameID, gameMasterID)
+	if err != nil {
+		return err
+	}
+	if err := g.Allows(game.Configure); err != nil {
+		return err
+	}
+	if err := change(&g.Adventure); err != nil {
+		return err
+	}
+	return s.gameRepo.UpdateGame(gameID, g)
 }
 
 // StartAdventure initializes a new adventure within a game, setting up initial conditions and objectives.
@@ -132,26 +390,119 @@ func (s *service) StartAdventure(gameID string, adventure game.Adventure) error
 	}
 
 	// Set the adventure and initialize any required state or conditions.
-	g.Adventure = adventure
-	return s.gameRepo.UpdateGame(g)
+	if err := g.SetAdventure(adventure); err != nil {
+		return err
+	}
+	return s.gameRepo.UpdateGame(gameID, g)
 }
 
-// EndAdventure concludes an adventure within a game, potentially triggering game-end conditions or rewards.
-func (s *service) EndAdventure(gameID string, adventureID string) error {
-	// Fetch the game to end the adventure in.
-	g, err := s.gameRepo.GetGameByID(gameID)
-	if err != nil {
+// EndAdventure concludes the current adventure of a game once the Game Master recorded its outcome.
+// Every participating character earns the bonus XP of the payout rules of the game, and the final standings
+// are frozen into a report attached to the game.
+func (s *service) EndAdventure(gameID, gameMasterID, adventureID string) (game.AdventureReport, error) {
+	var report game.AdventureReport
+	err := s.inUnit(func(unit *service) error {
+		var err error
+		report, err = unit.endAdventure(gameID, gameMasterID, adventureID)
 		return err
-	}
+	})
+	return report, err
+}
 
-	// Verify the adventure to end matches the current one and perform any necessary cleanup or state updates.
+func (s *service) endAdventure(gameID, gameMasterID, adventureID string) (game.AdventureReport, error) {
+	g, err := s.gameDirectedBy(gameID, gameMasterID)
+	if err != nil {
+		return game.AdventureReport{}, err
+	}
+	if err := g.Allows(game.Configure); err != nil {
+		return game.AdventureReport{}, err
+	}
 	if g.Adventure.ID != adventureID {
-		return errors.New("adventure mismatch or already ended")
+		return game.AdventureReport{}, errs.Newf(errs.ErrConflict, errs.Adventure, adventureID, "is not the ongoing adventure of game %s", gameID)
+	}
+	if g.Adventure.Outcome == game.Undecided {
+		return game.AdventureReport{}, errs.Newf(errs.ErrConflict, errs.Adventure, adventureID, "has no recorded outcome")
 	}
 
-	// Clear or finalize the adventure state.
-	g.Adventure = game.Adventure{} // Assuming a way to clear or reset the adventure.
-	return s.gameRepo.UpdateGame(g)
+	participants, err := s.participantsOf(g)
+	if err != nil {
+		return game.AdventureReport{}, err
+	}
+	payout := g.Payout.Payout(g.Adventure)
+	reason := fmt.Sprintf("adventure %s: %s", adventureID, g.Adventure.Outcome)
+	standings := make([]game.Standing, 0, len(participants))
+	for _, characterID := range participants {
+		if payout > 0 {
+			tx := xp.NewTransaction(xp.AdventurePayout, characterID, gameID, gameMasterID, payout, reason)
+			if err := s.xpService.RecordTransaction(tx); err != nil {
+				return game.AdventureReport{}, err
+			}
+		}
+		char, err := s.characterRepo.GetCharacterByID(characterID)
+		if err != nil {
+			return game.AdventureReport{}, err
+		}
+		standings = append(standings, game.Standing{CharacterID: characterID, Name: char.Name, PayoutXP: payout, FinalXP: char.XP})
+	}
+
+	report, err := g.EndAdventure(adventureID, s.clock.Now(), standings)
+	if err != nil {
+		return game.AdventureReport{}, err
+	}
+	if err := s.gameRepo.UpdateGame(gameID, g); err != nil {
+		return game.AdventureReport{}, err
+	}
+	return report, nil
+}
+
+// participantsOf returns the IDs of the characters taking part in a game: the characters of the game
+// and every character with XP recorded in it, in order of first appearance.
+func (s *service) participantsOf(g *game.Game) ([]string, error) {
+	txs, err := s.xpService.ListTransactionsByGame(g.GameID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var participants []string
+	add := func(characterID string) {
+		if !seen[characterID] {
+			seen[characterID] = true
+			participants = append(participants, characterID)
+		}
+	}
+	for _, c := range g.Characters {
+		add(c.CharacterID)
+	}
+	for _, tx := range txs {
+		add(tx.CharacterID)
+	}
+	return participants, nil
+}
+
+// checkParticipant checks that a character takes part in a game: it is a character of one of the players of
+// the game, or one of its participants, see participantsOf.
+func (s *service) checkParticipant(g *game.Game, characterID string) error {
+	for _, member := range g.Players {
+		p, err := s.playerRepo.GetPlayerByID(member.PlayerID)
+		if err != nil {
+			return err
+		}
+		for _, c := range p.Characters {
+			if c.CharacterID == characterID {
+				return nil
+			}
+		}
+	}
+	participants, err := s.participantsOf(g)
+	if err != nil {
+		return err
+	}
+	for _, id := range participants {
+		if id == characterID {
+			return nil
+		}
+	}
+	return errs.Newf(errs.ErrForbidden, errs.Character, characterID, "does not take part in game %s", g.GameID)
 }
 
 // ManageNPCs allows for the addition, update, or removal of NPCs within a game, reflecting the GM's control.
@@ -166,38 +517,35 @@ func (s *service) ManageNPCs(gameID string, npc character.Character, operation s
 	switch operation {
 	case "add":
 		g.Characters = append(g.Characters, npc)
+		if err := s.gameRepo.UpdateGame(gameID, g); err != nil {
+			return err
+		}
+		s.events.Publish(event.NPCAddedEvent{GameID: gameID, CharacterID: npc.CharacterID, Name: npc.Name})
+		return nil
 	case "update":
 		for i, char := range g.Characters {
 			if char.CharacterID == npc.CharacterID {
 				g.Characters[i] = npc
-				return s.gameRepo.UpdateGame(g)
+				return s.gameRepo.UpdateGame(gameID, g)
 			}
 		}
-		return errors.New("NPC not found for update")
+		return errs.Newf(errs.ErrNotFound, errs.Character, npc.CharacterID, "is not an NPC of game %s", gameID)
 	case "remove":
 		for i, char := range g.Characters {
 			if char.CharacterID == npc.CharacterID {
 				g.Characters = append(g.Characters[:i], g.Characters[i+1:]...)
-				return s.gameRepo.UpdateGame(g)
+				return s.gameRepo.UpdateGame(gameID, g)
 			}
 		}
-		return errors.New("NPC not found for removal")
+		return errs.Newf(errs.ErrNotFound, errs.Character, npc.CharacterID, "is not an NPC of game %s", gameID)
 	default:
-		return errors.New("invalid operation")
+		return errs.Invalidf("invalid operation")
 	}
-
-	return s.gameRepo.UpdateGame(g)
 }
 
-// SetAdventureOutcome allows the GM to define or update the outcome of an ongoing adventure, affecting the game state.
-func (s *service) SetAdventureOutcome(gameID string, outcome string) error {
-	// Fetch the game to set the adventure outcome.
-	g, err := s.gameRepo.GetGameByID(gameID)
-	if err != nil {
-		return err
-	}
-
-	// Set or update the outcome of the current adventure.
-	g.Adventure.Outcome = outcome
-	return s.gameRepo.UpdateGame(g)
+// SetAdventureOutcome allows the GM to record or update the outcome of the ongoing adventure, before ending it.
+func (s *service) SetAdventureOutcome(gameID, gameMasterID string, outcome game.AdventureOutcome) error {
+	return s.updateAdventure(gameID, gameMasterID, func(a *game.Adventure) error {
+		return a.SetOutcome(outcome)
+	})
 }
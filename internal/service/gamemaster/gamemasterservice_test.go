@@ -1,48 +1,119 @@
 package gamemaster
 
 import (
+	"bytes"
+	"errors"
+	"log"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
 	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
 	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
 	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
+	repogamemaster "github.com/jerberlin/dndgame/internal/repo/gamemaster"
+	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
 	servgame "github.com/jerberlin/dndgame/internal/service/game"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
+	servxp "github.com/jerberlin/dndgame/internal/service/xp"
 )
 
 var actionRepo repoaction.ActionRepository
+var playerRepo repoplayer.PlayerRepository
 var characterRepo repocharacter.CharacterRepository
+var gameRepo repogame.GameRepository
 var gameService servgame.GameService
+var xpService servxp.XPService
 var gmService GameMasterService
+var bus *event.Bus
 
 func TestMain(m *testing.M) {
 	actionRepo = repoaction.NewInMemoryActionRepository()
 	characterRepo = repocharacter.NewInMemoryCharacterRepository()
-	gameService = servgame.NewGameService(nil) // Assuming dependencies are properly injected or mocked
-	gmService = servgamemaster.NewGameMasterService(actionRepo, characterRepo, gameService)
+	gameRepo = repogame.NewInMemoryGameRepository()
+	playerRepo = repoplayer.NewInMemoryPlayerRepository()
+	clk := clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC))
+	bus = event.NewBus(clk)
+	xpRepo := repoxp.NewInMemoryXPRepository()
+	gamemasterRepo := repogamemaster.NewInMemoryGameMasterRepository()
+	units := uow.NewInMemory(uow.Repositories{Games: gameRepo, Players: playerRepo, Characters: characterRepo, GameMasters: gamemasterRepo, Actions: actionRepo, XP: xpRepo})
+	xpService = servxp.NewXPService(xpRepo, characterRepo, units, bus)
+	playerService := servplayer.NewPlayerService(playerRepo, characterRepo, actionRepo, gameRepo, units, clk, bus)
+	gameService = servgame.NewGameService(gameRepo, actionRepo, playerService, units, clk, bus)
+	gmService = NewGameMasterService(actionRepo, characterRepo, gameRepo, gamemasterRepo, playerRepo, gameService, playerService, units, clk, bus)
+
+	setupGame("game1", "gm1")
 
 	os.Exit(m.Run())
 }
 
+func setupGame(gameID, gameMasterID string) {
+	gameRepo.CreateGame(&game.Game{
+		GameID:     gameID,
+		GameMaster: gamemaster.GameMaster{ID: gameMasterID, Name: "Anakin", Status: gamemaster.Active},
+		Rulebook:   gamemaster.DefaultRulebook(),
+	})
+}
+
+// joinGame makes an existing character take part in a game, through a player of its own joining the game.
+func joinGame(gameID, characterID string) {
+	char, _ := characterRepo.GetCharacterByID(characterID)
+	p := &player.Player{PlayerID: characterID + "-player", Name: "Finn", Characters: []character.Character{*char}}
+	playerRepo.CreatePlayer(p)
+	g, _ := gameRepo.GetGameByID(gameID)
+	g.Players = append(g.Players, *p)
+	gameRepo.UpdateGame(gameID, g)
+}
+
+// captureLog redirects the override log of the service and returns the buffer it writes to.
+func captureLog() *bytes.Buffer {
+	var buf bytes.Buffer
+	gmService.(*service).logger = log.New(&buf, "", 0)
+	return &buf
+}
+
 func TestGameMasterServiceApproveActionInstance(t *testing.T) {
 	instanceID := "instance1"
+	characterRepo.CreateCharacter(&character.Character{CharacterID: "char-approved", Name: "Hero", XP: 10})
+	defer characterRepo.DeleteCharacter("char-approved")
 	ai := &action.ActionInstance{
-		CharacterID:  "char1",
+		InstanceID:   instanceID,
+		GameID:       "game1",
+		CharacterID:  "char-approved",
 		CustomXPCost: 10,
-		Approved:     false,
+		State:        action.Proposed,
 	}
 	actionRepo.CreateActionInstance(ai)
 
-	if err := gmService.ApproveActionInstance(instanceID, nil); err != nil {
+	var approved []event.Envelope
+	unsubscribe := bus.Subscribe(func(env event.Envelope) { approved = append(approved, env) }, event.ActionApproved)
+	defer unsubscribe()
+	if err := gmService.ApproveActionInstance("gm1", instanceID, nil, ""); err != nil {
 		t.Errorf("ApproveActionInstance() error = %v, wantErr nil", err)
 	}
+	expected := event.ActionApprovedEvent{GameID: "game1", GameMasterID: "gm1", CharacterID: "char-approved", InstanceID: instanceID, XPCost: 10}
+	if len(approved) != 1 || approved[0].Event != expected {
+		t.Errorf("ApproveActionInstance() published = %+v, want %+v", approved, expected)
+	}
 
 	updatedAI, err := actionRepo.GetActionInstanceByID(instanceID)
 	if err != nil {
 		t.Fatalf("GetActionInstanceByID() error = %v, wantErr nil", err)
 	}
-	if !updatedAI.Approved {
+	if updatedAI.State != action.Approved {
 		t.Errorf("ApproveActionInstance() failed to approve the action instance")
 	}
 }
@@ -108,6 +179,7 @@ func TestGameMasterServiceUpdateCharacter(t *testing.T) {
 	}
 	characterRepo.CreateCharacter(newCharacter)
 	newCharacter.Name = "Hero Updated"
+	newCharacter.XP = 1000
 
 	if err := gmService.UpdateCharacter(newCharacter); err != nil {
 		t.Errorf("UpdateCharacter() error = %v, wantErr nil", err)
@@ -117,6 +189,9 @@ func TestGameMasterServiceUpdateCharacter(t *testing.T) {
 	if updatedCharacter.Name != "Hero Updated" {
 		t.Errorf("UpdateCharacter() failed to update, got = %v", updatedCharacter.Name)
 	}
+	if updatedCharacter.XP != 0 {
+		t.Errorf("UpdateCharacter() should keep the XP of the ledger, got = %v", updatedCharacter.XP)
+	}
 }
 
 func TestGameMasterServiceUpdateCharacterXP(t *testing.T) {
@@ -124,19 +199,176 @@ func TestGameMasterServiceUpdateCharacterXP(t *testing.T) {
 	newCharacter := &character.Character{
 		CharacterID: characterID,
 		Name:        "Hero",
-		Attributes: character.Attributes{
-			XP: 100,
-		},
+		XP:          100,
 	}
 	characterRepo.CreateCharacter(newCharacter)
+	if err := gmService.UpdateCharacterXP("game1", "gm1", characterID, 50, "solved the riddle"); !errors.Is(err, errs.ErrForbidden) {
+		t.Errorf("UpdateCharacterXP() of a character not in the game error = %v, want ErrForbidden", err)
+	}
+	joinGame("game1", characterID)
 
-	if err := gmService.UpdateCharacterXP(characterID, 50); err != nil {
+	if err := gmService.UpdateCharacterXP("game1", "gm1", characterID, 50, "solved the riddle"); err != nil {
 		t.Errorf("UpdateCharacterXP() error = %v, wantErr nil", err)
 	}
 
 	updatedCharacter, _ := characterRepo.GetCharacterByID(characterID)
-	if updatedCharacter.Attributes.XP != 150 {
-		t.Errorf("UpdateCharacterXP() failed to update XP, got = %v", updatedCharacter.Attributes.XP)
+	if updatedCharacter.XP != 150 {
+		t.Errorf("UpdateCharacterXP() failed to update XP, got = %v", updatedCharacter.XP)
+	}
+
+	history, _ := xpService.ListTransactionsByCharacter(characterID)
+	if len(history) != 1 || history[0].Type != xp.GMGrant || history[0].ActorID != "gm1" || history[0].Reason != "solved the riddle" {
+		t.Errorf("UpdateCharacterXP() failed to record the transaction, got = %+v", history)
+	}
+
+	if err := gmService.UpdateCharacterXP("game1", "gm1", characterID, 50, ""); err == nil {
+		t.Errorf("UpdateCharacterXP() expected error for missing reason, got nil")
+	}
+
+	gameRepo.CreateGame(&game.Game{GameID: "game-unmastered", Rulebook: gamemaster.DefaultRulebook()})
+	for _, gameMasterID := range []string{"", "gm1"} {
+		if err := gmService.UpdateCharacterXP("game-unmastered", gameMasterID, characterID, 5, "bribe"); !errors.Is(err, errs.ErrForbidden) {
+			t.Errorf("UpdateCharacterXP() by %q in a game without game master error = %v, want ErrForbidden", gameMasterID, err)
+		}
+	}
+}
+
+func TestGameMasterServiceRulebookLimits(t *testing.T) {
+	characterID := "char-limits"
+	characterRepo.CreateCharacter(&character.Character{CharacterID: characterID, Name: "Hero", XP: 20})
+	joinGame("game1", characterID)
+	var violation *gamemaster.RuleViolationError
+
+	// The default rulebook allows 100 XP of grants per character and game
+	if err := gmService.UpdateCharacterXP("game1", "gm1", characterID, 80, "epic speech"); err != nil {
+		t.Fatalf("UpdateCharacterXP() error = %v, wantErr nil", err)
+	}
+	if err := gmService.UpdateCharacterXP("game1", "gm1", characterID, 30, "another epic speech"); !errors.As(err, &violation) {
+		t.Errorf("UpdateCharacterXP() expected a rule violation for exceeding the grant limit, got = %v", err)
+	}
+	if err := gmService.UpdateCharacterXP("game1", "gm1", characterID, -101, "cheating"); !errors.As(err, &violation) {
+		t.Errorf("UpdateCharacterXP() expected a rule violation for a negative balance, got = %v", err)
+	}
+	if err := gmService.UpdateCharacterXP("game1", "gm-intruder", characterID, 5, "bribe"); err == nil {
+		t.Errorf("UpdateCharacterXP() expected error for a game master not directing the game, got nil")
+	}
+
+	template := action.Action{ActionID: "climb", Name: "Climb", BaseXPCost: 10}
+	ai := template.CreateInstance("game1", characterID, 10)
+	actionRepo.CreateActionInstance(&ai)
+	if err := gmService.ApproveActionInstance("gm1", ai.InstanceID, &action.ActionInstance{CustomXPCost: 30}, "the cliff is icy"); !errors.As(err, &violation) {
+		t.Errorf("ApproveActionInstance() expected a rule violation for exceeding the cost multiplier, got = %v", err)
+	}
+	if err := gmService.ApproveActionInstance("gm1", ai.InstanceID, &action.ActionInstance{CustomXPCost: 15}, ""); err == nil {
+		t.Errorf("ApproveActionInstance() expected error for a missing justification, got nil")
+	}
+	if err := gmService.ApproveActionInstance("gm1", ai.InstanceID, &action.ActionInstance{CustomXPCost: 15, PenaltyXP: 100}, "deadly fall"); !errors.As(err, &violation) {
+		t.Errorf("ApproveActionInstance() expected a rule violation for a possible negative balance, got = %v", err)
+	}
+	if err := gmService.ApproveActionInstance("gm1", ai.InstanceID, &action.ActionInstance{CustomXPCost: 15, PenaltyXP: 101}, "bottomless pit"); !errors.As(err, &violation) {
+		t.Errorf("ApproveActionInstance() expected a rule violation for exceeding the max penalty, got = %v", err)
+	}
+	// The 80 XP granted above leave room for a reward raised by 20 XP only
+	if err := gmService.ApproveActionInstance("gm1", ai.InstanceID, &action.ActionInstance{CustomXPCost: 15, RewardXP: 30}, "hidden treasure"); !errors.As(err, &violation) {
+		t.Errorf("ApproveActionInstance() expected a rule violation for a reward exceeding the grant limit, got = %v", err)
+	}
+
+	unchanged, _ := actionRepo.GetActionInstanceByID(ai.InstanceID)
+	if unchanged.State != action.Proposed || unchanged.CustomXPCost != 10 {
+		t.Errorf("rejected overrides must not change the instance, got = %+v", unchanged)
+	}
+
+	jump := action.Action{ActionID: "jump", Name: "Jump", BaseXPCost: 90, PenaltyXP: 20}
+	risky := jump.CreateInstance("game1", characterID, 90)
+	actionRepo.CreateActionInstance(&risky)
+	if err := gmService.ApproveActionInstance("gm1", risky.InstanceID, nil, ""); !errors.As(err, &violation) {
+		t.Errorf("ApproveActionInstance() expected a rule violation for a possible negative balance without modification, got = %v", err)
+	}
+}
+
+func TestGameMasterServiceMissionProgress(t *testing.T) {
+	setupGame("game-missions", "gm1")
+	adventure := game.Adventure{ID: "adv1", Type: game.Quests, Missions: []game.Mission{
+		{ID: "m1", Name: "Find the map", Objectives: []game.Objective{{ID: "o1", Description: "Search the library"}}},
+		{ID: "m2", Name: "Open the vault", Prerequisites: []string{"m1"}},
+	}}
+	if err := gameService.SetAdventure("game-missions", adventure); err != nil {
+		t.Fatalf("SetAdventure() error = %v, wantErr nil", err)
+	}
+
+	if err := gmService.CompleteObjective("game-missions", "gm2", "m1", "o1"); err == nil {
+		t.Errorf("CompleteObjective() expected error for a game master not directing the game, got nil")
+	}
+	if err := gmService.StartMission("game-missions", "gm1", "m1"); err != nil {
+		t.Errorf("StartMission() error = %v, wantErr nil", err)
+	}
+	if err := gmService.CompleteObjective("game-missions", "gm1", "m1", "o1"); err != nil {
+		t.Errorf("CompleteObjective() error = %v, wantErr nil", err)
+	}
+
+	m, err := gmService.ReviewMissionProgress("game-missions", "m1")
+	if err != nil || m.Status != game.Completed {
+		t.Errorf("ReviewMissionProgress() got = %+v, %v, want a completed mission", m, err)
+	}
+	if m, _ := gmService.ReviewMissionProgress("game-missions", "m2"); m.Status != game.Available {
+		t.Errorf("CompleteObjective() failed to unlock the next mission, got = %v", m.Status)
+	}
+	if err := gmService.FailMission("game-missions", "gm1", "m2"); err != nil {
+		t.Errorf("FailMission() error = %v, wantErr nil", err)
+	}
+	if _, err := gmService.ReviewMissionProgress("game-missions", "m3"); err == nil {
+		t.Errorf("ReviewMissionProgress() expected error for an unknown mission, got nil")
+	}
+}
+
+func TestGameMasterServiceEndAdventure(t *testing.T) {
+	gameID := "game-adventure-end"
+	setupGame(gameID, "gm1")
+	gameService.SetPayoutRules(gameID, game.PayoutRules{XPPerCompletedMission: 10, OutcomeBonus: map[game.AdventureOutcome]int{game.Victory: 30}})
+	adventure := game.Adventure{ID: "adv1", Type: game.Quests, Missions: []game.Mission{
+		{ID: "m1", Name: "Find the map", Objectives: []game.Objective{{ID: "o1", Description: "Search the library"}}},
+		{ID: "m2", Name: "Open the vault", Prerequisites: []string{"m1"}},
+	}}
+	gameService.SetAdventure(gameID, adventure)
+	gmService.CompleteObjective(gameID, "gm1", "m1", "o1")
+
+	for _, c := range []*character.Character{{CharacterID: "char-hero", Name: "Hero"}, {CharacterID: "char-sidekick", Name: "Sidekick"}} {
+		characterRepo.CreateCharacter(c)
+	}
+	xpService.RecordTransaction(xp.NewTransaction(xp.StartingGrant, "char-hero", gameID, "player1", 100, "starting XP"))
+	xpService.RecordTransaction(xp.NewTransaction(xp.StartingGrant, "char-sidekick", gameID, "player2", 50, "starting XP"))
+
+	if _, err := gmService.EndAdventure(gameID, "gm1", "adv1"); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("EndAdventure() before the outcome is recorded error = %v, want ErrConflict", err)
+	}
+	if _, err := gmService.EndAdventure(gameID, "gm2", "adv1"); !errors.Is(err, errs.ErrForbidden) {
+		t.Errorf("EndAdventure() by another game master error = %v, want ErrForbidden", err)
+	}
+	if err := gmService.SetAdventureOutcome(gameID, "gm1", game.Victory); err != nil {
+		t.Fatalf("SetAdventureOutcome() error = %v, wantErr nil", err)
+	}
+	report, err := gmService.EndAdventure(gameID, "gm1", "adv1")
+	if err != nil {
+		t.Fatalf("EndAdventure() error = %v, wantErr nil", err)
+	}
+
+	expected := []game.Standing{
+		{CharacterID: "char-hero", Name: "Hero", PayoutXP: 40, FinalXP: 140},
+		{CharacterID: "char-sidekick", Name: "Sidekick", PayoutXP: 40, FinalXP: 90},
+	}
+	if !reflect.DeepEqual(report.Standings, expected) {
+		t.Errorf("EndAdventure() standings got = %+v, want %+v", report.Standings, expected)
+	}
+	history, _ := xpService.ListTransactionsByCharacter("char-hero")
+	if last := history[len(history)-1]; last.Type != xp.AdventurePayout || last.Amount != 40 {
+		t.Errorf("EndAdventure() failed to record the payout, got = %+v", last)
+	}
+	g, _ := gameRepo.GetGameByID(gameID)
+	if len(g.Reports) != 1 || g.Reports[0].Outcome != game.Victory || g.Adventure.ID != "" {
+		t.Errorf("EndAdventure() failed to attach the report to the game, got = %+v", g.Reports)
+	}
+	if _, err := gmService.EndAdventure(gameID, "gm1", "adv1"); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("EndAdventure() of an adventure already ended error = %v, want ErrConflict", err)
 	}
 }
 
@@ -159,3 +391,47 @@ func TestGameMasterServiceModifyAction(t *testing.T) {
 		t.Errorf("ModifyAction() failed to update BaseXPCost, got = %v", updatedAction.BaseXPCost)
 	}
 }
+
+func TestGameMasterServiceModifyAndRejectActionInstances(t *testing.T) {
+	previous := action.SetIDGenerator(action.SequentialIDGenerator("gm-test"))
+	defer action.SetIDGenerator(previous)
+
+	characterRepo.CreateCharacter(&character.Character{CharacterID: "char-many", Name: "Busy Hero", XP: 50})
+	template := action.Action{ActionID: "sneak", Name: "Sneak", BaseXPCost: 10}
+	first := template.CreateInstance("game1", "char-many", 10)
+	second := template.CreateInstance("game1", "char-many", 12)
+	actionRepo.CreateActionInstance(&first)
+	actionRepo.CreateActionInstance(&second)
+
+	logged := captureLog()
+	if err := gmService.ApproveActionInstance("gm1", first.InstanceID, &action.ActionInstance{CustomXPCost: 8, RewardXP: 20, PenaltyXP: 5}, "the guards are asleep"); err != nil {
+		t.Errorf("ApproveActionInstance() error = %v, wantErr nil", err)
+	}
+	if err := gmService.RejectActionInstance("gm2", second.InstanceID); !errors.Is(err, errs.ErrForbidden) {
+		t.Errorf("RejectActionInstance() by a game master not directing the game error = %v, want ErrForbidden", err)
+	}
+	if err := gmService.RejectActionInstance("gm1", second.InstanceID); err != nil {
+		t.Errorf("RejectActionInstance() error = %v, wantErr nil", err)
+	}
+
+	approved, _ := actionRepo.GetActionInstanceByID(first.InstanceID)
+	if approved.State != action.Approved || approved.CustomXPCost != 8 || approved.RewardXP != 20 || approved.PenaltyXP != 5 {
+		t.Errorf("ApproveActionInstance() failed to apply modification, got = %+v", approved)
+	}
+	rejected, _ := actionRepo.GetActionInstanceByID(second.InstanceID)
+	if rejected.State != action.Rejected {
+		t.Errorf("RejectActionInstance() failed to reject, got = %+v", rejected)
+	}
+	if !strings.Contains(logged.String(), "the guards are asleep") {
+		t.Errorf("ApproveActionInstance() failed to log the justification, got = %q", logged.String())
+	}
+
+	if err := gmService.ApproveActionInstance("gm1", second.InstanceID, nil, ""); err == nil {
+		t.Errorf("ApproveActionInstance() expected error for rejected instance, got nil")
+	}
+
+	instances, _ := actionRepo.ListActionInstancesByCharacter("char-many")
+	if len(instances) != 2 {
+		t.Errorf("ListActionInstancesByCharacter() got %v instances, want 2", len(instances))
+	}
+}
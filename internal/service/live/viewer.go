@@ -0,0 +1,109 @@
+// internal/service/live/viewer.go
+
+// Package live decides what the players and the game master of a game see of its events as they happen,
+// whatever carries them to the clients.
+package live
+
+import (
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	servgame "github.com/jerberlin/dndgame/internal/service/game"
+	servplayer "github.com/jerberlin/dndgame/internal/service/player"
+)
+
+// Access is what a viewer may see of an event.
+type Access int
+
+const (
+	Hidden Access = iota // nothing
+	Public               // the event without the details private to the player of its character, e.g. an XP change
+	Full                 // the whole event
+)
+
+// Viewer is who watches the events of a game: its game master, who sees every event, or one of its players,
+// who sees the events of the table, such as the players joining and the actions executed, and the proposals,
+// decisions and XP changes of their own characters only.
+type Viewer struct {
+	players  servplayer.PlayerService
+	playerID string // empty for the game master
+}
+
+// NewViewer checks that exactly one of a player and a game master is given, and that they may watch the game:
+// the player has to have joined it, and the game master to direct it.
+func NewViewer(games servgame.GameService, players servplayer.PlayerService, gameID, playerID, gameMasterID string) (*Viewer, error) {
+	if (playerID == "") == (gameMasterID == "") {
+		return nil, errs.Invalidf("exactly one of a player and a game master watches a game")
+	}
+	g, err := games.GetGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if gameMasterID != "" {
+		if g.GameMaster.ID != gameMasterID {
+			return nil, errs.Newf(errs.ErrForbidden, errs.GameMaster, gameMasterID, "does not direct game %s", gameID)
+		}
+		return &Viewer{players: players}, nil
+	}
+	for _, pl := range g.Players {
+		if pl.PlayerID == playerID {
+			return &Viewer{players: players, playerID: playerID}, nil
+		}
+	}
+	return nil, errs.Newf(errs.ErrForbidden, errs.Player, playerID, "has not joined game %s", gameID)
+}
+
+// Access returns what the viewer may see of an event of the game.
+func (v *Viewer) Access(env event.Envelope) (Access, error) {
+	if v.playerID == "" {
+		return Full, nil
+	}
+	var characterID string
+	switch e := env.Event.(type) {
+	case event.ActionProposedEvent:
+		characterID = e.CharacterID
+	case event.ActionApprovedEvent:
+		characterID = e.CharacterID
+	case event.ActionRejectedEvent:
+		characterID = e.CharacterID
+	case event.XPChangedEvent:
+		characterID = e.CharacterID
+	case event.ActionExecutedEvent:
+		owned, err := v.owns(e.CharacterID)
+		if err != nil {
+			return Hidden, err
+		}
+		if owned {
+			return Full, nil
+		}
+		return Public, nil
+	case event.PlayerJoinedEvent, event.PlayerLeftEvent, event.GameStatusChangedEvent, event.NPCAddedEvent:
+		return Full, nil
+	default:
+		return Hidden, nil // the new kinds of events are for the game master until decided otherwise
+	}
+	owned, err := v.owns(characterID)
+	if err != nil || !owned {
+		return Hidden, err
+	}
+	return Full, nil
+}
+
+// owns reports whether the character belongs to the player watching.
+func (v *Viewer) owns(characterID string) (bool, error) {
+	pl, err := v.players.GetPlayerByID(v.playerID)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range pl.Characters {
+		if c.CharacterID == characterID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Left reports whether the event is the player watching leaving the game, after which they may not watch it.
+func (v *Viewer) Left(env event.Envelope) bool {
+	e, ok := env.Event.(event.PlayerLeftEvent)
+	return ok && v.playerID != "" && e.PlayerID == v.playerID
+}
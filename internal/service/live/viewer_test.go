@@ -0,0 +1,128 @@
+package live
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/app"
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
+	"github.com/jerberlin/dndgame/internal/model/gamemaster"
+)
+
+// setupGame sets up game g1, directed by gm1, with player p1 playing character c1, and player p2 not in the game.
+func setupGame(t *testing.T) *app.App {
+	t.Helper()
+	a := app.NewInMemory(clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)))
+	t.Cleanup(a.Close)
+	steps := []func() error{
+		func() error { return a.Games.CreateGame("g1", "The Sunless Citadel") },
+		func() error { return a.Games.SetGameMaster("g1", gamemaster.GameMaster{ID: "gm1", Name: "Dana"}) },
+		func() error { return a.Games.SetGameStatus("g1", game.Lobby) },
+		func() error { return a.Players.CreatePlayer("p1", "Alex") },
+		func() error { return a.Players.CreatePlayer("p2", "Sam") },
+		func() error { return a.Games.AddPlayerToGame("g1", "p1") },
+		func() error {
+			b := character.NewBuilder("c1", "Merric", character.Warrior, character.Dwarf).
+				AssignAttributes(character.StandardArrayMethod, character.Attributes{Strength: 15, Dexterity: 14, Constitution: 13, Intelligence: 12, Wisdom: 10, Charisma: 8})
+			_, err := a.Players.CreateCharacter("p1", "g1", b)
+			return err
+		},
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("setup step %d error = %v, wantErr nil", i, err)
+		}
+	}
+	return a
+}
+
+func TestNewViewer(t *testing.T) {
+	a := setupGame(t)
+
+	tests := []struct {
+		name         string
+		playerID     string
+		gameMasterID string
+		wantErr      error
+	}{
+		{"game master", "", "gm1", nil},
+		{"player", "p1", "", nil},
+		{"no viewer", "", "", errs.ErrInvalid},
+		{"both viewers", "p1", "gm1", errs.ErrInvalid},
+		{"other game master", "", "gm2", errs.ErrForbidden},
+		{"player not in the game", "p2", "", errs.ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewViewer(a.Games, a.Players, "g1", tt.playerID, tt.gameMasterID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewViewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if _, err := NewViewer(a.Games, a.Players, "g2", "p1", ""); !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("NewViewer() error = %v, wantErr %v", err, errs.ErrNotFound)
+	}
+}
+
+func TestViewerAccess(t *testing.T) {
+	a := setupGame(t)
+	gm, err := NewViewer(a.Games, a.Players, "g1", "", "gm1")
+	if err != nil {
+		t.Fatalf("NewViewer() error = %v, wantErr nil", err)
+	}
+	p1, err := NewViewer(a.Games, a.Players, "g1", "p1", "")
+	if err != nil {
+		t.Fatalf("NewViewer() error = %v, wantErr nil", err)
+	}
+
+	tests := []struct {
+		name       string
+		event      event.Event
+		wantPlayer Access
+	}{
+		{"own proposal", event.ActionProposedEvent{GameID: "g1", CharacterID: "c1"}, Full},
+		{"other proposal", event.ActionProposedEvent{GameID: "g1", CharacterID: "c2"}, Hidden},
+		{"other approval", event.ActionApprovedEvent{GameID: "g1", CharacterID: "c2"}, Hidden},
+		{"other rejection", event.ActionRejectedEvent{GameID: "g1", CharacterID: "c2"}, Hidden},
+		{"own XP change", event.XPChangedEvent{GameID: "g1", CharacterID: "c1"}, Full},
+		{"other XP change", event.XPChangedEvent{GameID: "g1", CharacterID: "c2"}, Hidden},
+		{"own execution", event.ActionExecutedEvent{GameID: "g1", CharacterID: "c1"}, Full},
+		{"other execution", event.ActionExecutedEvent{GameID: "g1", CharacterID: "c2"}, Public},
+		{"player joining", event.PlayerJoinedEvent{GameID: "g1", PlayerID: "p2"}, Full},
+		{"status change", event.GameStatusChangedEvent{GameID: "g1"}, Full},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := event.Envelope{Event: tt.event}
+			if got, err := gm.Access(env); err != nil || got != Full {
+				t.Errorf("game master Access() = %v, %v, want %v", got, err, Full)
+			}
+			if got, err := p1.Access(env); err != nil || got != tt.wantPlayer {
+				t.Errorf("player Access() = %v, %v, want %v", got, err, tt.wantPlayer)
+			}
+		})
+	}
+}
+
+func TestViewerLeft(t *testing.T) {
+	a := setupGame(t)
+	gm, _ := NewViewer(a.Games, a.Players, "g1", "", "gm1")
+	p1, _ := NewViewer(a.Games, a.Players, "g1", "p1", "")
+
+	left := event.Envelope{Event: event.PlayerLeftEvent{GameID: "g1", PlayerID: "p1"}}
+	if !p1.Left(left) {
+		t.Error("Left() = false for the player leaving, want true")
+	}
+	if gm.Left(left) {
+		t.Error("Left() = true for the game master, want false")
+	}
+	if p1.Left(event.Envelope{Event: event.PlayerLeftEvent{GameID: "g1", PlayerID: "p2"}}) {
+		t.Error("Left() = true for another player leaving, want false")
+	}
+}
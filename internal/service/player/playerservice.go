@@ -2,36 +2,87 @@ package player
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/game"
 	"github.com/jerberlin/dndgame/internal/model/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repoaction "github.com/jerberlin/dndgame/internal/repo/action"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	repogame "github.com/jerberlin/dndgame/internal/repo/game"
 	repoplayer "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	servxp "github.com/jerberlin/dndgame/internal/service/xp"
 )
 
 // PlayerService defines the operations available to manage players.
 type PlayerService interface {
 	CreatePlayer(playerID, playerName string) error
 	DeletePlayer(playerID string) error
+	GetPlayerByID(playerID string) (*player.Player, error)
+	CreateCharacter(playerID, gameID string, builder *character.Builder) (*character.Character, error)
 	AddCharacterToPlayer(playerID string, character character.Character) error
 	RemoveCharacterFromPlayer(playerID, characterID string) error
-	PerformActionByCharacter(characterID, actionID string) error
+	ProposeAction(gameID, characterID, actionID string, customXPCost int) (action.ActionInstance, error)
+	ExecuteActionInstance(characterID, instanceID string, resolver action.OutcomeResolver) (action.ActionInstance, error)
 }
 
 type service struct {
-	repo repoplayer.PlayerRepository
+	repo          repoplayer.PlayerRepository
+	characterRepo repocharacter.CharacterRepository
+	actionRepo    repoaction.ActionRepository
+	gameRepo      repogame.GameRepository
+	xpService     servxp.XPService // within units of work only, see inUnit
+	units         uow.UnitOfWork
+	clock         clock.Clock
+	events        event.Publisher
 }
 
 // Ensure service implements PlayerService at compile time.
 var _ PlayerService = &service{}
 
 // NewPlayerService creates a new instance of PlayerService.
-func NewPlayerService(repo repoplayer.PlayerRepository) PlayerService {
-	return &service{repo: repo}
+// The operations changing several entities run within a unit of work of units.
+func NewPlayerService(repo repoplayer.PlayerRepository, characterRepo repocharacter.CharacterRepository, actionRepo repoaction.ActionRepository, gameRepo repogame.GameRepository, units uow.UnitOfWork, clk clock.Clock, events event.Publisher) PlayerService {
+	return &service{
+		repo:          repo,
+		characterRepo: characterRepo,
+		actionRepo:    actionRepo,
+		gameRepo:      gameRepo,
+		units:         units,
+		clock:         clk,
+		events:        events,
+	}
+}
+
+// inUnit runs fn with a copy of the service writing within a unit of work, whose events are published
+// once the unit is committed.
+func (s *service) inUnit(fn func(unit *service) error) error {
+	var events event.Buffer
+	err := s.units.Do(func(r uow.Repositories) error {
+		unit := *s
+		unit.repo, unit.characterRepo, unit.actionRepo, unit.gameRepo = r.Players, r.Characters, r.Actions, r.Games
+		unit.units, unit.events = uow.Within(r), &events
+		unit.xpService = servxp.NewXPService(r.XP, r.Characters, unit.units, unit.events)
+		return fn(&unit)
+	})
+	if err == nil {
+		events.Flush(s.events)
+	}
+	return err
 }
 
 func (s *service) CreatePlayer(playerID, playerName string) error {
 	if _, err := s.repo.GetPlayerByID(playerID); err == nil {
-		return errors.New("player already exists")
+		return errs.AlreadyExists(errs.Player, playerID)
+	} else if !errors.Is(err, errs.ErrNotFound) {
+		return err
 	}
 	newPlayer := &player.Player{
 		PlayerID: playerID,
@@ -44,6 +95,59 @@ func (s *service) DeletePlayer(playerID string) error {
 	return s.repo.DeletePlayer(playerID)
 }
 
+func (s *service) GetPlayerByID(playerID string) (*player.Player, error) {
+	return s.repo.GetPlayerByID(playerID)
+}
+
+// CreateCharacter builds a new character for a player following the creation rules,
+// stores it, grants it the starting XP in the given game and assigns it to the player.
+// The player has to be a player of the game, and the game has to allow character creation in its current status.
+func (s *service) CreateCharacter(playerID, gameID string, builder *character.Builder) (*character.Character, error) {
+	if err := s.catchUpSchedule(gameID); err != nil {
+		return nil, err
+	}
+	var created *character.Character
+	err := s.inUnit(func(unit *service) error {
+		var err error
+		created, err = unit.createCharacter(playerID, gameID, builder)
+		return err
+	})
+	return created, err
+}
+
+func (s *service) createCharacter(playerID, gameID string, builder *character.Builder) (*character.Character, error) {
+	if _, err := s.repo.GetPlayerByID(playerID); err != nil {
+		return nil, err
+	}
+	g, err := s.currentGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.Allows(game.CreateCharacter); err != nil {
+		return nil, err
+	}
+	if !g.HasPlayer(playerID) {
+		return nil, errs.Newf(errs.ErrForbidden, errs.Player, playerID, "is not a player of game %s", gameID)
+	}
+	char, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.characterRepo.CreateCharacter(char); err != nil {
+		return nil, err
+	}
+	if builder.StartingXP() > 0 {
+		grant := xp.NewTransaction(xp.StartingGrant, char.CharacterID, gameID, playerID, builder.StartingXP(), "starting XP")
+		if err := s.xpService.RecordTransaction(grant); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.AddCharacterToPlayer(playerID, *char); err != nil {
+		return nil, err
+	}
+	return s.characterRepo.GetCharacterByID(char.CharacterID)
+}
+
 func (s *service) AddCharacterToPlayer(playerID string, character character.Character) error {
 	p, err := s.repo.GetPlayerByID(playerID)
 	if err != nil {
@@ -51,7 +155,7 @@ func (s *service) AddCharacterToPlayer(playerID string, character character.Char
 	}
 	for _, ch := range p.Characters {
 		if ch.CharacterID == character.CharacterID {
-			return errors.New("character already assigned to this player")
+			return errs.Newf(errs.ErrAlreadyExists, errs.Character, character.CharacterID, "is already a character of player %s", playerID)
 		}
 	}
 	p.Characters = append(p.Characters, character)
@@ -69,10 +173,229 @@ func (s *service) RemoveCharacterFromPlayer(playerID, characterID string) error
 			return s.repo.UpdatePlayer(playerID, p)
 		}
 	}
-	return errors.New("character not found in player's list")
+	return errs.Newf(errs.ErrNotFound, errs.Character, characterID, "is not a character of player %s", playerID)
+}
+
+// ProposeAction lets a player choose an action template for their character in a game. A zero custom XP cost
+// takes the base cost of the template. The game has to be active and inside its scheduled time, and the new
+// instance waits for the approval of the game master.
+func (s *service) ProposeAction(gameID, characterID, actionID string, customXPCost int) (action.ActionInstance, error) {
+	if err := s.catchUpSchedule(gameID); err != nil {
+		return action.ActionInstance{}, err
+	}
+	var proposed action.ActionInstance
+	err := s.inUnit(func(unit *service) error {
+		var err error
+		proposed, err = unit.proposeAction(gameID, characterID, actionID, customXPCost)
+		return err
+	})
+	return proposed, err
+}
+
+func (s *service) proposeAction(gameID, characterID, actionID string, customXPCost int) (action.ActionInstance, error) {
+	g, err := s.currentGame(gameID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	if err := g.Allows(game.ProposeAction); err != nil {
+		return action.ActionInstance{}, err
+	}
+	if now := s.clock.Now(); !g.IsActiveAt(now) {
+		return action.ActionInstance{}, errs.Newf(errs.ErrConflict, errs.Game, gameID, "does not accept actions at %s", now.Format(time.RFC3339))
+	}
+	if _, err := s.findOwner(characterID); err != nil {
+		return action.ActionInstance{}, err
+	}
+	template, err := s.actionRepo.GetActionByID(actionID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	if customXPCost < 0 {
+		return action.ActionInstance{}, errs.Invalidf("action XP cost must not be negative")
+	}
+	if customXPCost == 0 {
+		customXPCost = template.BaseXPCost
+	}
+
+	char, err := s.characterRepo.GetCharacterByID(characterID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	ai, err := char.ChooseAction(gameID, *template, customXPCost)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	if err := s.actionRepo.CreateActionInstance(&ai); err != nil {
+		return action.ActionInstance{}, err
+	}
+	if err := s.characterRepo.UpdateCharacter(char); err != nil {
+		return action.ActionInstance{}, err
+	}
+	s.events.Publish(event.ActionProposedEvent{
+		GameID:      gameID,
+		CharacterID: characterID,
+		InstanceID:  ai.InstanceID,
+		ActionID:    ai.Action.ActionID,
+		XPCost:      ai.CustomXPCost,
+	})
+	return ai, nil
+}
+
+// ExecuteActionInstance is the decision of a player to execute an action approved by the game master,
+// while the game is active and inside its scheduled time as when proposing.
+// The approved XP cost is deducted from the character, the resolver decides the outcome,
+// and the reward or penalty of the instance is applied. The resolved instance is returned.
+func (s *service) ExecuteActionInstance(characterID, instanceID string, resolver action.OutcomeResolver) (action.ActionInstance, error) {
+	if resolver == nil {
+		return action.ActionInstance{}, errs.Invalidf("an outcome resolver is required")
+	}
+	if ai, err := s.actionRepo.GetActionInstanceByID(instanceID); err == nil {
+		if err := s.catchUpSchedule(ai.GameID); err != nil {
+			return action.ActionInstance{}, err
+		}
+	}
+	var executed action.ActionInstance
+	err := s.inUnit(func(unit *service) error {
+		var err error
+		executed, err = unit.executeActionInstance(characterID, instanceID, resolver)
+		return err
+	})
+	return executed, err
+}
+
+func (s *service) executeActionInstance(characterID, instanceID string, resolver action.OutcomeResolver) (action.ActionInstance, error) {
+	owner, err := s.findOwner(characterID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	ai, err := s.actionRepo.GetActionInstanceByID(instanceID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	if ai.CharacterID != characterID {
+		return action.ActionInstance{}, errs.Newf(errs.ErrForbidden, errs.ActionInstance, instanceID, "does not belong to character %s", characterID)
+	}
+	g, err := s.currentGame(ai.GameID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	if err := g.Allows(game.ExecuteAction); err != nil {
+		return action.ActionInstance{}, err
+	}
+	if now := s.clock.Now(); !g.IsActiveAt(now) {
+		return action.ActionInstance{}, errs.Newf(errs.ErrConflict, errs.Game, ai.GameID, "does not accept actions at %s", now.Format(time.RFC3339))
+	}
+	char, err := s.characterRepo.GetCharacterByID(characterID)
+	if err != nil {
+		return action.ActionInstance{}, err
+	}
+	if char.XP < ai.CustomXPCost {
+		return action.ActionInstance{}, &errs.InsufficientXPError{CharacterID: characterID, Required: ai.CustomXPCost, Available: char.XP}
+	}
+	// The balance may have dropped since the approval, so the worst outcome is checked against the rulebook again.
+	if err := g.Rulebook.CheckBalance(char.XP, -(ai.CustomXPCost + ai.PenaltyXP)); err != nil {
+		return action.ActionInstance{}, err
+	}
+
+	if err := ai.Execute(); err != nil {
+		return action.ActionInstance{}, err
+	}
+	if ai.CustomXPCost > 0 {
+		cost := xp.NewTransaction(xp.ActionCost, characterID, ai.GameID, owner.PlayerID, -ai.CustomXPCost, ai.Action.Name)
+		if err := s.xpService.RecordTransaction(cost); err != nil {
+			return action.ActionInstance{}, err
+		}
+	}
+
+	if err := ai.Resolve(resolver.ResolveOutcome(*ai)); err != nil {
+		return action.ActionInstance{}, err
+	}
+	if change := ai.OutcomeXP(); change != 0 {
+		txType := xp.ActionReward
+		if change < 0 {
+			txType = xp.ActionPenalty
+		}
+		reason := fmt.Sprintf("%s: %s", ai.Action.Name, ai.Outcome)
+		if err := s.xpService.RecordTransaction(xp.NewTransaction(txType, characterID, ai.GameID, owner.PlayerID, change, reason)); err != nil {
+			return action.ActionInstance{}, err
+		}
+	}
+	if err := s.actionRepo.UpdateActionInstance(ai); err != nil {
+		return action.ActionInstance{}, err
+	}
+	s.events.Publish(event.ActionExecutedEvent{
+		GameID:      ai.GameID,
+		CharacterID: characterID,
+		InstanceID:  ai.InstanceID,
+		Outcome:     ai.Outcome.String(),
+		XPChange:    ai.OutcomeXP() - ai.CustomXPCost,
+	})
+	return *ai, nil
 }
 
-func (s *service) PerformActionByCharacter(characterID, actionID string) error {
-	// Implementation depends on GameMasterService for action approval.
-	return errors.New("method not implemented")
+// catchUpSchedule brings the status of a game up to date in a unit of work of its own, before an operation runs
+// within a unit of work, so that the new status holds even if the operation fails.
+func (s *service) catchUpSchedule(gameID string) error {
+	return s.inUnit(func(unit *service) error {
+		_, err := unit.currentGame(gameID)
+		return err
+	})
+}
+
+// currentGame retrieves a game, with its status brought up to date with its schedule. The action instances
+// of a game that ended on schedule expire with it.
+func (s *service) currentGame(gameID string) (*game.Game, error) {
+	g, err := s.gameRepo.GetGameByID(gameID)
+	if err != nil {
+		return nil, err
+	}
+	previous := g.Status
+	if g.UpdateSchedule(s.clock.Now()) {
+		if err := s.gameRepo.UpdateGame(gameID, g); err != nil {
+			return nil, err
+		}
+		if g.Status == game.Ended {
+			if err := s.expireActionInstances(gameID); err != nil {
+				return nil, err
+			}
+		}
+		s.events.Publish(event.GameStatusChangedEvent{GameID: gameID, From: previous.String(), To: g.Status.String()})
+	}
+	return g, nil
+}
+
+// expireActionInstances expires the action instances of a game that are still waiting to be decided or executed.
+func (s *service) expireActionInstances(gameID string) error {
+	instances, err := s.actionRepo.ListActionInstancesByGame(gameID)
+	if err != nil {
+		return err
+	}
+	for _, ai := range instances {
+		if !ai.CanTransitionTo(action.Expired) {
+			continue
+		}
+		if err := ai.Expire(); err != nil {
+			return err
+		}
+		if err := s.actionRepo.UpdateActionInstance(ai); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findOwner returns the player the character is assigned to.
+func (s *service) findOwner(characterID string) (*player.Player, error) {
+	players, err := s.repo.ListPlayers()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range players {
+		for _, ch := range p.Characters {
+			if ch.CharacterID == characterID {
+				return p, nil
+			}
+		}
+	}
+	return nil, errs.Newf(errs.ErrNotFound, errs.Character, characterID, "is not assigned to any player")
 }
@@ -1,26 +1,55 @@
 package player
 
 import (
+	"errors"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/errs"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/action"
 	"github.com/jerberlin/dndgame/internal/model/character"
+	gamemodel "github.com/jerberlin/dndgame/internal/model/game"
 	playermodel "github.com/jerberlin/dndgame/internal/model/player"
-	"github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	actionrepo "github.com/jerberlin/dndgame/internal/repo/action"
+	characterrepo "github.com/jerberlin/dndgame/internal/repo/character"
+	gamerepo "github.com/jerberlin/dndgame/internal/repo/game"
 	playerrepo "github.com/jerberlin/dndgame/internal/repo/player"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	xprepo "github.com/jerberlin/dndgame/internal/repo/xp"
+	servxp "github.com/jerberlin/dndgame/internal/service/xp"
 )
 
 var repo playerrepo.PlayerRepository
-var service PlayerService
+var characterRepo characterrepo.CharacterRepository
+var actionRepo actionrepo.ActionRepository
+var gameRepo gamerepo.GameRepository
+var fakeClock *clock.Fake
+var bus *event.Bus
+var xpService servxp.XPService
+var playerService PlayerService
 
 func TestMain(m *testing.M) {
 	repo = playerrepo.NewInMemoryPlayerRepository()
-	service = NewPlayerService(repo)
+	characterRepo = characterrepo.NewInMemoryCharacterRepository()
+	actionRepo = actionrepo.NewInMemoryActionRepository()
+	gameRepo = gamerepo.NewInMemoryGameRepository()
+	fakeClock = clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC))
+	bus = event.NewBus(fakeClock)
+	xpRepo := xprepo.NewInMemoryXPRepository()
+	units := uow.NewInMemory(uow.Repositories{Games: gameRepo, Players: repo, Characters: characterRepo, Actions: actionRepo, XP: xpRepo})
+	xpService = servxp.NewXPService(xpRepo, characterRepo, units, bus)
+	playerService = NewPlayerService(repo, characterRepo, actionRepo, gameRepo, units, fakeClock, bus)
+	gameRepo.CreateGame(&gamemodel.Game{GameID: "game1", Status: gamemodel.Active, AllowLateCharacters: true})
 
 	os.Exit(m.Run())
 }
 
-func setupPlayer(repo player.PlayerRepository, playerID, playerName string) *player.Player {
+func setupPlayer(repo playerrepo.PlayerRepository, playerID, playerName string) *playermodel.Player {
 	newPlayer := &playermodel.Player{
 		PlayerID: playerID,
 		Name:     playerName,
@@ -29,6 +58,13 @@ func setupPlayer(repo player.PlayerRepository, playerID, playerName string) *pla
 	return newPlayer
 }
 
+func joinGame(gameID, playerID string) {
+	g, _ := gameRepo.GetGameByID(gameID)
+	p, _ := repo.GetPlayerByID(playerID)
+	g.AddPlayer(*p)
+	gameRepo.UpdateGame(gameID, g)
+}
+
 func assertPlayerExistence(t *testing.T, playerID string, shouldExist bool) {
 	_, err := repo.GetPlayerByID(playerID)
 	if shouldExist && err != nil {
@@ -42,7 +78,7 @@ func TestCreatePlayer(t *testing.T) {
 	playerID := "test-player-1"
 	playerName := "Test Player"
 
-	err := service.CreatePlayer(playerID, playerName)
+	err := playerService.CreatePlayer(playerID, playerName)
 	if err != nil {
 		t.Errorf("CreatePlayer() error = %v, wantErr nil", err)
 	}
@@ -56,7 +92,7 @@ func TestDeletePlayer(t *testing.T) {
 	playerName := "Test Player 2"
 	setupPlayer(repo, playerID, playerName)
 
-	err := service.DeletePlayer(playerID)
+	err := playerService.DeletePlayer(playerID)
 	if err != nil {
 		t.Errorf("DeletePlayer() error = %v, wantErr nil", err)
 	}
@@ -71,7 +107,7 @@ func TestAddCharacterToPlayer(t *testing.T) {
 	setupPlayer(repo, playerID, playerName)
 
 	character := character.Character{CharacterID: "char1", Name: "Hero"}
-	err := service.AddCharacterToPlayer(playerID, character)
+	err := playerService.AddCharacterToPlayer(playerID, character)
 	if err != nil {
 		t.Errorf("AddCharacterToPlayer() error = %v, wantErr nil", err)
 	}
@@ -83,6 +119,56 @@ func TestAddCharacterToPlayer(t *testing.T) {
 	}
 }
 
+func TestCreateCharacter(t *testing.T) {
+	playerID := "test-player-6"
+	setupPlayer(repo, playerID, "Test Player 6")
+
+	attrs := character.Attributes{Strength: 14, Dexterity: 15, Constitution: 13, Intelligence: 12, Wisdom: 10, Charisma: 8}
+	builder := character.NewBuilder("char-created", "Lysias", character.Ranger, character.Human).AssignAttributes(character.StandardArrayMethod, attrs)
+
+	// Test creating a character in a game the player did not join
+	if _, err := playerService.CreateCharacter(playerID, "game1", builder); !errors.Is(err, errs.ErrForbidden) {
+		t.Errorf("CreateCharacter() in a game the player did not join error = %v, want ErrForbidden", err)
+	}
+	if _, err := characterRepo.GetCharacterByID("char-created"); err == nil {
+		t.Errorf("CreateCharacter() must not store a character of a player outside the game")
+	}
+	joinGame("game1", playerID)
+
+	char, err := playerService.CreateCharacter(playerID, "game1", builder)
+	if err != nil {
+		t.Fatalf("CreateCharacter() error = %v, wantErr nil", err)
+	}
+	if char.XP != character.DefaultStartingXP {
+		t.Errorf("CreateCharacter() failed to grant starting XP, got = %v", char.XP)
+	}
+
+	history, _ := xpService.ListTransactionsByCharacter("char-created")
+	if len(history) != 1 || history[0].Type != xp.StartingGrant {
+		t.Errorf("CreateCharacter() failed to record the starting grant, got: %+v", history)
+	}
+	p, _ := repo.GetPlayerByID(playerID)
+	if len(p.Characters) != 1 || p.Characters[0].CharacterID != "char-created" {
+		t.Errorf("CreateCharacter() failed to assign character, characters found: %v", p.Characters)
+	}
+
+	// Test creating a character breaking the rules
+	invalid := character.NewBuilder("char-invalid", "Cheater", character.Warrior, character.Orc).AssignAttributes(character.PointBuyMethod, character.Attributes{Strength: 99})
+	if _, err := playerService.CreateCharacter(playerID, "game1", invalid); err == nil {
+		t.Errorf("CreateCharacter() expected error for invalid attributes, got nil")
+	}
+	if _, err := characterRepo.GetCharacterByID("char-invalid"); err == nil {
+		t.Errorf("CreateCharacter() must not store an invalid character")
+	}
+
+	// Test creating a character once the game started, without the game master allowing it
+	gameRepo.CreateGame(&gamemodel.Game{GameID: "game-started", Status: gamemodel.Active})
+	late := character.NewBuilder("char-late", "Latecomer", character.Ranger, character.Human).AssignAttributes(character.StandardArrayMethod, attrs)
+	if _, err := playerService.CreateCharacter(playerID, "game-started", late); err == nil {
+		t.Errorf("CreateCharacter() expected error for a game already started, got nil")
+	}
+}
+
 func TestRemoveCharacterFromPlayer(t *testing.T) {
 	playerID := "test-player-4"
 	playerName := "Test Player 4"
@@ -91,7 +177,7 @@ func TestRemoveCharacterFromPlayer(t *testing.T) {
 	p.Characters = append(p.Characters, character)
 	repo.UpdatePlayer(playerID, p)
 
-	err := service.RemoveCharacterFromPlayer(playerID, "char2")
+	err := playerService.RemoveCharacterFromPlayer(playerID, "char2")
 	if err != nil {
 		t.Errorf("RemoveCharacterFromPlayer() error = %v, wantErr nil", err)
 	}
@@ -103,7 +189,7 @@ func TestRemoveCharacterFromPlayer(t *testing.T) {
 	}
 }
 
-func TestPerformActionByCharacter(t *testing.T) {
+func TestExecuteActionInstance(t *testing.T) {
 	playerID := "test-player-5"
 	playerName := "Test Player 5"
 	p := setupPlayer(repo, playerID, playerName) // Correctly set up the player once
@@ -111,26 +197,166 @@ func TestPerformActionByCharacter(t *testing.T) {
 	character := character.Character{CharacterID: "char3", Name: "Adventurer"}
 	p.Characters = append(p.Characters, character) // Add character directly to the fetched player object
 	repo.UpdatePlayer(playerID, p)                 // Update the player in the repository with the new character
+	characterRepo.CreateCharacter(&character)
+	xpService.RecordTransaction(xp.NewTransaction(xp.StartingGrant, "char3", "game1", "gm1", 100, "starting XP"))
 
-	actionID := "action1"
+	template := action.Action{ActionID: "action1", Name: "Pick Lock", BaseXPCost: 20, RewardXP: 30, PenaltyXP: 15}
+	approved := setupInstance(template, "char3", true)
 
-	// Test performing an action by the character
-	err := service.PerformActionByCharacter("char3", actionID)
+	// Test executing an approved action by the character
+	ai, err := playerService.ExecuteActionInstance("char3", approved.InstanceID, action.FixedOutcome(action.Success))
 	if err != nil {
-		t.Errorf("PerformActionByCharacter() error = %v, wantErr nil", err)
+		t.Fatalf("ExecuteActionInstance() error = %v, wantErr nil", err)
+	}
+	if ai.State != action.Resolved || ai.Outcome != action.Success {
+		t.Errorf("ExecuteActionInstance() failed to resolve the instance, got: %+v", ai)
+	}
+	assertXP(t, "char3", 110)
+
+	// Test a failed action applies the penalty
+	failed := setupInstance(template, "char3", true)
+	if _, err := playerService.ExecuteActionInstance("char3", failed.InstanceID, action.FixedOutcome(action.Failure)); err != nil {
+		t.Fatalf("ExecuteActionInstance() error = %v, wantErr nil", err)
+	}
+	assertXP(t, "char3", 75)
+
+	history, _ := xpService.ListTransactionsByCharacter("char3")
+	if len(history) != 5 || history[1].Type != xp.ActionCost || history[2].Type != xp.ActionReward || history[4].Type != xp.ActionPenalty {
+		t.Errorf("ExecuteActionInstance() did not record the expected ledger, got: %+v", history)
+	}
+
+	// Test executing an action that was not approved
+	pending := setupInstance(template, "char3", false)
+	if _, err := playerService.ExecuteActionInstance("char3", pending.InstanceID, action.FixedOutcome(action.Success)); err == nil {
+		t.Errorf("ExecuteActionInstance() expected error for unapproved instance, got nil")
+	}
+
+	// Test executing an action the character cannot afford
+	expensive := setupInstance(action.Action{ActionID: "action2", Name: "Summon Dragon", BaseXPCost: 500}, "char3", true)
+	_, err = playerService.ExecuteActionInstance("char3", expensive.InstanceID, action.FixedOutcome(action.Success))
+	var insufficient *errs.InsufficientXPError
+	if !errors.Is(err, errs.ErrInsufficientXP) || !errors.As(err, &insufficient) || insufficient.Required != 500 || insufficient.Available != 75 {
+		t.Errorf("ExecuteActionInstance() error = %v, want an InsufficientXPError of 500 XP with 75 available", err)
+	}
+	assertXP(t, "char3", 75)
+
+	// Test executing an action whose penalty could break the balance rule since its approval
+	risky := setupInstance(action.Action{ActionID: "action3", Name: "Leap", BaseXPCost: 70, PenaltyXP: 10}, "char3", true)
+	if _, err := playerService.ExecuteActionInstance("char3", risky.InstanceID, action.FixedOutcome(action.Success)); !errors.Is(err, errs.ErrForbidden) {
+		t.Errorf("ExecuteActionInstance() of a possible negative balance error = %v, want ErrForbidden", err)
+	}
+	assertXP(t, "char3", 75)
+
+	// Test an execution failing after the cost was paid is rolled back as a whole
+	var published []event.Event
+	unsubscribe := bus.Subscribe(func(env event.Envelope) { published = append(published, env.Event) })
+	unresolved := setupInstance(template, "char3", true)
+	if _, err := playerService.ExecuteActionInstance("char3", unresolved.InstanceID, action.FixedOutcome(action.NoOutcome)); err == nil {
+		t.Errorf("ExecuteActionInstance() expected error for a resolver without outcome, got nil")
+	}
+	unsubscribe()
+	assertXP(t, "char3", 75)
+	if history, _ := xpService.ListTransactionsByCharacter("char3"); len(history) != 5 {
+		t.Errorf("ExecuteActionInstance() should roll back the XP cost, got ledger: %+v", history)
+	}
+	if stored, _ := actionRepo.GetActionInstanceByID(unresolved.InstanceID); stored.State != action.Approved {
+		t.Errorf("ExecuteActionInstance() should roll back the instance, got state: %v", stored.State)
+	}
+	if len(published) != 0 {
+		t.Errorf("ExecuteActionInstance() rolled back should publish nothing, published = %+v", published)
+	}
+
+	// Test executing without an outcome resolver
+	if _, err := playerService.ExecuteActionInstance("char3", unresolved.InstanceID, nil); !errors.Is(err, errs.ErrInvalid) {
+		t.Errorf("ExecuteActionInstance() without a resolver error = %v, want ErrInvalid", err)
 	}
+	assertXP(t, "char3", 75)
 
-	// Additional checks can be added here if needed to verify the effects of the action
+	// Test executing an action outside the scheduled time of its game
+	gameRepo.CreateGame(&gamemodel.Game{GameID: "game-early", Status: gamemodel.Active, StartTime: fakeClock.Now().Add(time.Hour)})
+	early := template.CreateInstance("game-early", "char3", template.BaseXPCost)
+	early.Approve()
+	actionRepo.CreateActionInstance(&early)
+	if _, err := playerService.ExecuteActionInstance("char3", early.InstanceID, action.FixedOutcome(action.Success)); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("ExecuteActionInstance() before the game starts error = %v, want ErrConflict", err)
+	}
+	assertXP(t, "char3", 75)
 
-	// Test performing an action by a non-existent character
-	err = service.PerformActionByCharacter("char-nonexistent", actionID)
+	_, err = playerService.ExecuteActionInstance("char-nonexistent", approved.InstanceID, action.FixedOutcome(action.Success))
 	if err == nil {
-		t.Errorf("PerformActionByCharacter() expected error for non-existent character, got nil")
+		t.Errorf("ExecuteActionInstance() expected error for non-existent character, got nil")
 	}
 
 	// Test performing a non-existent action by an existing character
-	err = service.PerformActionByCharacter("char3", "non-existent-action")
-	if err == nil {
-		t.Errorf("PerformActionByCharacter() expected error for non-existent action, got nil")
+	_, err = playerService.ExecuteActionInstance("char3", "non-existent-action", action.FixedOutcome(action.Success))
+	if !errors.Is(err, errs.ErrNotFound) {
+		t.Errorf("ExecuteActionInstance() of a non-existent action error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestProposeAction(t *testing.T) {
+	playerID := "test-player-7"
+	p := setupPlayer(repo, playerID, "Test Player 7")
+	char := character.Character{CharacterID: "char-proposer", Name: "Proposer", Status: character.Active}
+	p.Characters = append(p.Characters, char)
+	repo.UpdatePlayer(playerID, p)
+	characterRepo.CreateCharacter(&char)
+	actionRepo.CreateAction(&action.Action{ActionID: "action-hide", Name: "Hide", BaseXPCost: 5})
+
+	start := fakeClock.Now().Add(time.Hour)
+	g, _ := gamemodel.NewScheduledGame("game-scheduled", "Friday Night", start, start.Add(2*time.Hour))
+	gameRepo.CreateGame(g)
+
+	// Test proposing before the game starts
+	if _, err := playerService.ProposeAction("game-scheduled", "char-proposer", "action-hide", 0); !errors.Is(err, errs.ErrConflict) {
+		t.Errorf("ProposeAction() before the game starts error = %v, want ErrConflict", err)
+	}
+
+	// Test proposing while the game is active
+	var published []event.Event
+	unsubscribe := bus.Subscribe(func(env event.Envelope) { published = append(published, env.Event) })
+	defer unsubscribe()
+	fakeClock.Advance(time.Hour)
+	ai, err := playerService.ProposeAction("game-scheduled", "char-proposer", "action-hide", 0)
+	if err != nil {
+		t.Fatalf("ProposeAction() error = %v, wantErr nil", err)
+	}
+	expected := []event.Event{
+		event.GameStatusChangedEvent{GameID: "game-scheduled", From: "Lobby", To: "Active"},
+		event.ActionProposedEvent{GameID: "game-scheduled", CharacterID: "char-proposer", InstanceID: ai.InstanceID, ActionID: "action-hide", XPCost: 5},
+	}
+	if !reflect.DeepEqual(published, expected) {
+		t.Errorf("ProposeAction() published = %+v, want %+v", published, expected)
+	}
+	if ai.State != action.Proposed || ai.CustomXPCost != 5 || ai.GameID != "game-scheduled" {
+		t.Errorf("ProposeAction() did not create the expected instance, got: %+v", ai)
+	}
+	if stored, err := actionRepo.GetActionInstanceByID(ai.InstanceID); err != nil || stored.CharacterID != "char-proposer" {
+		t.Errorf("ProposeAction() failed to store the instance, got: %+v, %v", stored, err)
+	}
+
+	// Test proposing after the game ends
+	fakeClock.Advance(2 * time.Hour)
+	if _, err := playerService.ProposeAction("game-scheduled", "char-proposer", "action-hide", 0); err == nil {
+		t.Errorf("ProposeAction() expected error after the game ends, got nil")
+	}
+	if stored, _ := gameRepo.GetGameByID("game-scheduled"); stored.Status != gamemodel.Ended {
+		t.Errorf("ProposeAction() should bring the game status up to date, got: %v", stored.Status)
+	}
+}
+
+func setupInstance(template action.Action, characterID string, approve bool) action.ActionInstance {
+	ai := template.CreateInstance("game1", characterID, template.BaseXPCost)
+	if approve {
+		ai.Approve()
+	}
+	actionRepo.CreateActionInstance(&ai)
+	return ai
+}
+
+func assertXP(t *testing.T, characterID string, expected int) {
+	c, _ := characterRepo.GetCharacterByID(characterID)
+	if c.XP != expected {
+		t.Errorf("Character %s XP got = %v, want %v", characterID, c.XP, expected)
 	}
 }
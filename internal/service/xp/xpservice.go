@@ -0,0 +1,115 @@
+// internal/service/xp/xpservice.go
+
+package xp
+
+import (
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+// XPService is the only way to change the XP balance of a character. Every change is recorded in the ledger.
+type XPService interface {
+	RecordTransaction(tx xp.Transaction) error
+	ListTransactionsByCharacter(characterID string) ([]xp.Transaction, error)
+	ListTransactionsByGame(gameID string) ([]xp.Transaction, error)
+}
+
+type service struct {
+	xpRepo        repoxp.XPRepository
+	characterRepo repocharacter.CharacterRepository
+	units         uow.UnitOfWork
+	events        event.Publisher
+}
+
+// Ensure service implements XPService at compile time.
+var _ XPService = &service{}
+
+// NewXPService creates a new instance of XPService.
+// The balance and the ledger are changed within a unit of work of units.
+func NewXPService(xpRepo repoxp.XPRepository, characterRepo repocharacter.CharacterRepository, units uow.UnitOfWork, events event.Publisher) XPService {
+	return &service{
+		xpRepo:        xpRepo,
+		characterRepo: characterRepo,
+		units:         units,
+		events:        events,
+	}
+}
+
+// inUnit runs fn with a copy of the service writing within a unit of work, whose events are published
+// once the unit is committed.
+func (s *service) inUnit(fn func(unit *service) error) error {
+	var events event.Buffer
+	err := s.units.Do(func(r uow.Repositories) error {
+		unit := *s
+		unit.xpRepo, unit.characterRepo, unit.units, unit.events = r.XP, r.Characters, uow.Within(r), &events
+		return fn(&unit)
+	})
+	if err == nil {
+		events.Flush(s.events)
+	}
+	return err
+}
+
+// RecordTransaction applies the transaction to the balance of the character, appends it to the ledger
+// and publishes the change.
+func (s *service) RecordTransaction(tx xp.Transaction) error {
+	if err := tx.Validate(); err != nil {
+		return err
+	}
+	return s.inUnit(func(unit *service) error {
+		return unit.recordTransaction(tx)
+	})
+}
+
+func (s *service) recordTransaction(tx xp.Transaction) error {
+	char, err := s.characterRepo.GetCharacterByID(tx.CharacterID)
+	if err != nil {
+		return err
+	}
+	char.AdjustXP(tx.Amount)
+	if err := s.characterRepo.UpdateCharacter(char); err != nil {
+		return err
+	}
+	if err := s.xpRepo.AppendTransaction(&tx); err != nil {
+		return err
+	}
+	s.events.Publish(event.XPChangedEvent{
+		GameID:      tx.GameID,
+		CharacterID: tx.CharacterID,
+		ActorID:     tx.ActorID,
+		Type:        tx.Type.String(),
+		Amount:      tx.Amount,
+		Balance:     char.XP,
+		Reason:      tx.Reason,
+	})
+	return nil
+}
+
+// ListTransactionsByCharacter retrieves the XP history of a character, oldest first.
+func (s *service) ListTransactionsByCharacter(characterID string) ([]xp.Transaction, error) {
+	txs, err := s.xpRepo.ListTransactionsByCharacter(characterID)
+	if err != nil {
+		return nil, err
+	}
+	return dereference(txs), nil
+}
+
+// ListTransactionsByGame retrieves the XP history of all characters in a game, oldest first.
+func (s *service) ListTransactionsByGame(gameID string) ([]xp.Transaction, error) {
+	txs, err := s.xpRepo.ListTransactionsByGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+	return dereference(txs), nil
+}
+
+func dereference(txs []*xp.Transaction) []xp.Transaction {
+	result := make([]xp.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, *tx)
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package xp
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jerberlin/dndgame/internal/clock"
+	"github.com/jerberlin/dndgame/internal/event"
+	"github.com/jerberlin/dndgame/internal/model/character"
+	"github.com/jerberlin/dndgame/internal/model/xp"
+	repocharacter "github.com/jerberlin/dndgame/internal/repo/character"
+	"github.com/jerberlin/dndgame/internal/repo/uow"
+	repoxp "github.com/jerberlin/dndgame/internal/repo/xp"
+)
+
+var characterRepo repocharacter.CharacterRepository
+var xpService XPService
+var bus *event.Bus
+
+func TestMain(m *testing.M) {
+	characterRepo = repocharacter.NewInMemoryCharacterRepository()
+	bus = event.NewBus(clock.NewFake(time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)))
+	xpRepo := repoxp.NewInMemoryXPRepository()
+	xpService = NewXPService(xpRepo, characterRepo, uow.NewInMemory(uow.Repositories{XP: xpRepo, Characters: characterRepo}), bus)
+
+	os.Exit(m.Run())
+}
+
+func setupCharacter(characterID string) {
+	_ = characterRepo.CreateCharacter(&character.Character{CharacterID: characterID, Name: "Hero"})
+}
+
+func TestXPServiceRecordTransaction(t *testing.T) {
+	characterID := "xp-char-1"
+	setupCharacter(characterID)
+
+	txs := []xp.Transaction{
+		xp.NewTransaction(xp.StartingGrant, characterID, "xp-game-1", "gm1", 100, "starting XP"),
+		xp.NewTransaction(xp.ActionCost, characterID, "xp-game-1", "player1", -40, "Fireball"),
+	}
+	for _, tx := range txs {
+		if err := xpService.RecordTransaction(tx); err != nil {
+			t.Fatalf("RecordTransaction() error = %v, wantErr nil", err)
+		}
+	}
+
+	char, _ := characterRepo.GetCharacterByID(characterID)
+	if char.XP != 60 {
+		t.Errorf("RecordTransaction() failed to update balance, got = %v, want 60", char.XP)
+	}
+
+	history, err := xpService.ListTransactionsByCharacter(characterID)
+	if err != nil {
+		t.Fatalf("ListTransactionsByCharacter() error = %v, wantErr nil", err)
+	}
+	if len(history) != 2 || history[0].Type != xp.StartingGrant || history[1].Type != xp.ActionCost {
+		t.Errorf("ListTransactionsByCharacter() got = %+v", history)
+	}
+	if xp.Balance(history) != char.XP {
+		t.Errorf("ledger balance %d does not match character balance %d", xp.Balance(history), char.XP)
+	}
+}
+
+func TestXPServiceRecordInvalidTransaction(t *testing.T) {
+	characterID := "xp-char-2"
+	setupCharacter(characterID)
+
+	if err := xpService.RecordTransaction(xp.NewTransaction(xp.GMGrant, characterID, "xp-game-2", "gm1", 10, "")); err == nil {
+		t.Errorf("RecordTransaction() expected error for missing reason, got nil")
+	}
+	if err := xpService.RecordTransaction(xp.NewTransaction(xp.GMGrant, "nonexistent", "xp-game-2", "gm1", 10, "bonus")); err == nil {
+		t.Errorf("RecordTransaction() expected error for non-existent character, got nil")
+	}
+
+	history, _ := xpService.ListTransactionsByCharacter(characterID)
+	if len(history) != 0 {
+		t.Errorf("invalid transactions must not be recorded, got = %+v", history)
+	}
+}
+
+func TestXPServiceListTransactionsByGame(t *testing.T) {
+	gameID := "xp-game-3"
+	setupCharacter("xp-char-3")
+	setupCharacter("xp-char-4")
+
+	_ = xpService.RecordTransaction(xp.NewTransaction(xp.GMGrant, "xp-char-3", gameID, "gm1", 10, "bonus"))
+	_ = xpService.RecordTransaction(xp.NewTransaction(xp.GMGrant, "xp-char-4", gameID, "gm1", 20, "bonus"))
+	_ = xpService.RecordTransaction(xp.NewTransaction(xp.GMGrant, "xp-char-4", "other-game", "gm2", 5, "bonus"))
+
+	history, err := xpService.ListTransactionsByGame(gameID)
+	if err != nil {
+		t.Fatalf("ListTransactionsByGame() error = %v, wantErr nil", err)
+	}
+	if len(history) != 2 || history[0].CharacterID != "xp-char-3" || history[1].CharacterID != "xp-char-4" {
+		t.Errorf("ListTransactionsByGame() got = %+v", history)
+	}
+}